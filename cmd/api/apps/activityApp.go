@@ -0,0 +1,29 @@
+package apps
+
+import (
+	ActivityAdapter "autobill-service/internal/adapters/inbound/http/activity"
+	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
+	ActivityApp "autobill-service/internal/application/activity"
+	DB "autobill-service/internal/infrastructure/db"
+	JWTUtil "autobill-service/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CreateActivityApp(util JWTUtil.JWTUtil, db DB.PostgresDB) ActivityAdapter.ActivityRouter {
+	activityAppFiber := fiber.New(fiber.Config{
+		AppName: "autobill-activity-service",
+	})
+
+	activityRepo := RepositoryAdapters.CreateActivityRepository(db)
+	groupRepo := RepositoryAdapters.CreateGroupRepository(db)
+
+	activityService := ActivityApp.CreateActivityService(activityRepo, groupRepo)
+
+	activityHandler := ActivityAdapter.CreateActivityHandler(activityService)
+
+	router := ActivityAdapter.CreateActivityRouter(activityAppFiber, activityHandler, util)
+	router.RegisterRoutes()
+
+	return router
+}
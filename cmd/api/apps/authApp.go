@@ -5,19 +5,22 @@ import (
 	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
 	AuthApp "autobill-service/internal/application/auth"
 	DB "autobill-service/internal/infrastructure/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	JWTUtil "autobill-service/pkg/jwt"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func CreateAuthApp(util JWTUtil.JWTUtil, db DB.PostgresDB) AuthAdapter.AuthRouter {
+func CreateAuthApp(util JWTUtil.JWTUtil, db DB.PostgresDB, events EventPorts.EventPublisher) AuthAdapter.AuthRouter {
 	authAppFiber := fiber.New(fiber.Config{
 		AppName: "autobill-auth-service",
 	})
 
 	authRepo := RepositoryAdapters.CreateAuthRepository(db)
+	groupRepo := RepositoryAdapters.CreateGroupRepository(db)
+	inviteRepo := RepositoryAdapters.CreateInviteRepository(db)
 
-	authService := AuthApp.CreateAuthService(authRepo, util)
+	authService := AuthApp.CreateAuthService(authRepo, groupRepo, inviteRepo, events, util)
 
 	authHandler := AuthAdapter.CreateAuthHandler(authService)
 
@@ -4,21 +4,23 @@ import (
 	BalanceAdapter "autobill-service/internal/adapters/inbound/http/balance"
 	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
 	BalanceApp "autobill-service/internal/application/balance"
+	Config "autobill-service/internal/infrastructure/config"
 	DB "autobill-service/internal/infrastructure/db"
 	JWTUtil "autobill-service/pkg/jwt"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func CreateBalanceApp(util JWTUtil.JWTUtil, db DB.PostgresDB) BalanceAdapter.BalanceRouter {
+func CreateBalanceApp(util JWTUtil.JWTUtil, db DB.PostgresDB, rateConfig Config.ExchangeRateConfig) BalanceAdapter.BalanceRouter {
 	balanceAppFiber := fiber.New(fiber.Config{
 		AppName: "autobill-balance-service",
 	})
 
 	balanceRepo := RepositoryAdapters.CreateBalanceRepository(db)
 	groupRepo := RepositoryAdapters.CreateGroupRepository(db)
+	rateProvider := CreateExchangeRateProvider(db, rateConfig)
 
-	balanceService := BalanceApp.CreateBalanceService(balanceRepo, groupRepo)
+	balanceService := BalanceApp.CreateBalanceService(balanceRepo, groupRepo, rateProvider)
 
 	balanceHandler := BalanceAdapter.CreateBalanceHandler(balanceService)
 
@@ -0,0 +1,23 @@
+package apps
+
+import (
+	CurrencyAdapter "autobill-service/internal/adapters/inbound/http/currency"
+	CurrencyApp "autobill-service/internal/application/currency"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CreateCurrencyApp() CurrencyAdapter.CurrencyRouter {
+	currencyAppFiber := fiber.New(fiber.Config{
+		AppName: "autobill-currency-service",
+	})
+
+	currencyService := CurrencyApp.CreateCurrencyService()
+
+	currencyHandler := CurrencyAdapter.CreateCurrencyHandler(currencyService)
+
+	router := CurrencyAdapter.CreateCurrencyRouter(currencyAppFiber, currencyHandler)
+	router.RegisterRoutes()
+
+	return router
+}
@@ -0,0 +1,27 @@
+package apps
+
+import (
+	"time"
+
+	EventAdapter "autobill-service/internal/adapters/inbound/http/events"
+	EventApp "autobill-service/internal/application/events"
+	EventPorts "autobill-service/internal/ports/outbound/events"
+	JWTUtil "autobill-service/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CreateEventApp(util JWTUtil.JWTUtil, bus EventPorts.EventSubscriber, heartbeat time.Duration) EventAdapter.EventRouter {
+	eventAppFiber := fiber.New(fiber.Config{
+		AppName: "autobill-event-service",
+	})
+
+	eventService := EventApp.CreateEventService(bus)
+
+	eventHandler := EventAdapter.CreateEventHandler(eventService, heartbeat)
+
+	router := EventAdapter.CreateEventRouter(eventAppFiber, eventHandler, util)
+	router.RegisterRoutes()
+
+	return router
+}
@@ -0,0 +1,50 @@
+package apps
+
+import (
+	ExchangeRateAdapter "autobill-service/internal/adapters/inbound/http/exchange"
+	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
+	ExchangeAdapters "autobill-service/internal/adapters/outbound/exchange"
+	ExchangeRateApp "autobill-service/internal/application/exchange"
+	Config "autobill-service/internal/infrastructure/config"
+	DB "autobill-service/internal/infrastructure/db"
+	ExchangePorts "autobill-service/internal/ports/outbound/exchange"
+	JWTUtil "autobill-service/pkg/jwt"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func CreateExchangeRateApp(util JWTUtil.JWTUtil, db DB.PostgresDB, rateConfig Config.ExchangeRateConfig, adminConfig Config.AdminConfig) ExchangeRateAdapter.ExchangeRateRouter {
+	exchangeRateAppFiber := fiber.New(fiber.Config{
+		AppName: "autobill-exchange-rate-service",
+	})
+
+	rateProvider := CreateExchangeRateProvider(db, rateConfig)
+
+	var adminUserIds []uuid.UUID
+	for _, id := range adminConfig.UserIDs {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			Logger.Warn().Str("adminUserId", id).Msg("Ignoring invalid admin user ID")
+			continue
+		}
+		adminUserIds = append(adminUserIds, parsed)
+	}
+
+	exchangeRateService := ExchangeRateApp.CreateExchangeRateService(rateProvider, adminUserIds)
+
+	exchangeRateHandler := ExchangeRateAdapter.CreateExchangeRateHandler(exchangeRateService)
+
+	router := ExchangeRateAdapter.CreateExchangeRateRouter(exchangeRateAppFiber, exchangeRateHandler, util)
+	router.RegisterRoutes()
+
+	return router
+}
+
+func CreateExchangeRateProvider(db DB.PostgresDB, rateConfig Config.ExchangeRateConfig) ExchangePorts.ExchangeRateProvider {
+	if rateConfig.Provider == "file" {
+		return ExchangeAdapters.CreateFileExchangeRateProvider(rateConfig.FilePath)
+	}
+	return RepositoryAdapters.CreateExchangeRateRepository(db)
+}
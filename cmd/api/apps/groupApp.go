@@ -1,28 +1,45 @@
 package apps
 
 import (
+	ActivityAdapter "autobill-service/internal/adapters/inbound/http/activity"
+	CategoryAdapter "autobill-service/internal/adapters/inbound/http/category"
 	GroupAdapter "autobill-service/internal/adapters/inbound/http/group"
+	InviteAdapter "autobill-service/internal/adapters/inbound/http/invite"
 	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
+	ActivityApp "autobill-service/internal/application/activity"
+	CategoryApp "autobill-service/internal/application/category"
 	GroupApp "autobill-service/internal/application/group"
+	InviteApp "autobill-service/internal/application/invite"
 	DB "autobill-service/internal/infrastructure/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	JWTUtil "autobill-service/pkg/jwt"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func CreateGroupApp(util JWTUtil.JWTUtil, db DB.PostgresDB) GroupAdapter.GroupRouter {
+func CreateGroupApp(util JWTUtil.JWTUtil, db DB.PostgresDB, events EventPorts.EventPublisher) GroupAdapter.GroupRouter {
 	groupAppFiber := fiber.New(fiber.Config{
 		AppName: "autobill-group-service",
 	})
 
 	groupRepo := RepositoryAdapters.CreateGroupRepository(db)
 	splitRepo := RepositoryAdapters.CreateSplitRepository(db)
+	activityRepo := RepositoryAdapters.CreateActivityRepository(db)
+	inviteRepo := RepositoryAdapters.CreateInviteRepository(db)
+	userRepo := RepositoryAdapters.CreateUserRepository(db)
+	categoryRepo := RepositoryAdapters.CreateCategoryRepository(db)
 
-	groupService := GroupApp.CreateGroupService(groupRepo, splitRepo)
+	groupService := GroupApp.CreateGroupService(groupRepo, splitRepo, userRepo, events)
+	activityService := ActivityApp.CreateActivityService(activityRepo, groupRepo)
+	inviteService := InviteApp.CreateInviteService(inviteRepo, groupRepo, userRepo, events)
+	categoryService := CategoryApp.CreateCategoryService(categoryRepo, groupRepo)
 
 	groupHandler := GroupAdapter.CreateGroupHandler(groupService)
+	activityHandler := ActivityAdapter.CreateActivityHandler(activityService)
+	inviteHandler := InviteAdapter.CreateInviteHandler(inviteService)
+	categoryHandler := CategoryAdapter.CreateCategoryHandler(categoryService)
 
-	router := GroupAdapter.CreateGroupRouter(groupAppFiber, groupHandler, util)
+	router := GroupAdapter.CreateGroupRouter(groupAppFiber, groupHandler, activityHandler, inviteHandler, categoryHandler, util)
 	router.RegisterRoutes()
 
 	return router
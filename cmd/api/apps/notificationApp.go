@@ -0,0 +1,24 @@
+package apps
+
+import (
+	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
+	NotificationAdapters "autobill-service/internal/adapters/outbound/notification"
+	NotificationApp "autobill-service/internal/application/notification"
+	Config "autobill-service/internal/infrastructure/config"
+	DB "autobill-service/internal/infrastructure/db"
+	NotificationPorts "autobill-service/internal/ports/outbound/notification"
+)
+
+func CreateNotificationDispatcher(db DB.PostgresDB, config Config.NotificationConfig) *NotificationApp.NotificationDispatcher {
+	notificationRepo := RepositoryAdapters.CreateNotificationRepository(db)
+
+	return NotificationApp.CreateNotificationDispatcher(notificationRepo, CreateNotificationSender(config), config.DispatchInterval, config.DigestHour)
+}
+
+func CreateNotificationSender(config Config.NotificationConfig) NotificationPorts.NotificationSender {
+	if config.Sender == "smtp" {
+		smtp := config.SMTP
+		return NotificationAdapters.CreateSMTPNotificationSender(smtp.Host, smtp.Port, smtp.Username, smtp.Password, smtp.From, smtp.Timeout)
+	}
+	return NotificationAdapters.CreateLogNotificationSender(config.FilePath, config.SMTP.From)
+}
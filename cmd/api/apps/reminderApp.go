@@ -0,0 +1,37 @@
+package apps
+
+import (
+	ReminderAdapter "autobill-service/internal/adapters/inbound/http/reminder"
+	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
+	ReminderApp "autobill-service/internal/application/reminder"
+	Config "autobill-service/internal/infrastructure/config"
+	DB "autobill-service/internal/infrastructure/db"
+	JWTUtil "autobill-service/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CreateReminderApp(util JWTUtil.JWTUtil, db DB.PostgresDB, config Config.ReminderConfig) ReminderAdapter.ReminderRouter {
+	reminderAppFiber := fiber.New(fiber.Config{
+		AppName: "autobill-reminder-service",
+	})
+
+	reminderRepo := RepositoryAdapters.CreateReminderRepository(db)
+	splitRepo := RepositoryAdapters.CreateSplitRepository(db)
+	groupRepo := RepositoryAdapters.CreateGroupRepository(db)
+
+	reminderService := ReminderApp.CreateReminderService(reminderRepo, splitRepo, groupRepo, config.Cooldown)
+
+	reminderHandler := ReminderAdapter.CreateReminderHandler(reminderService)
+
+	router := ReminderAdapter.CreateReminderRouter(reminderAppFiber, reminderHandler, util)
+	router.RegisterRoutes()
+
+	return router
+}
+
+func CreateReminderScheduler(db DB.PostgresDB, config Config.ReminderConfig) *ReminderApp.ReminderScheduler {
+	reminderRepo := RepositoryAdapters.CreateReminderRepository(db)
+
+	return ReminderApp.CreateReminderScheduler(reminderRepo, config.AutoAfterDays, config.Interval)
+}
@@ -1,28 +1,37 @@
 package apps
 
 import (
+	CommentAdapter "autobill-service/internal/adapters/inbound/http/comment"
 	SettlementAdapter "autobill-service/internal/adapters/inbound/http/settlement"
 	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
+	CommentApp "autobill-service/internal/application/comment"
 	SettlementApp "autobill-service/internal/application/settlement"
 	DB "autobill-service/internal/infrastructure/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	JWTUtil "autobill-service/pkg/jwt"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func CreateSettlementApp(util JWTUtil.JWTUtil, db DB.PostgresDB) SettlementAdapter.SettlementRouter {
+func CreateSettlementApp(util JWTUtil.JWTUtil, db DB.PostgresDB, events EventPorts.EventPublisher) SettlementAdapter.SettlementRouter {
 	settlementAppFiber := fiber.New(fiber.Config{
 		AppName: "autobill-settlement-service",
 	})
 
 	settlementRepo := RepositoryAdapters.CreateSettlementRepository(db)
 	splitRepo := RepositoryAdapters.CreateSplitRepository(db)
+	groupRepo := RepositoryAdapters.CreateGroupRepository(db)
+	userRepo := RepositoryAdapters.CreateUserRepository(db)
+	commentRepo := RepositoryAdapters.CreateCommentRepository(db)
 
-	settlementService := SettlementApp.CreateSettlementService(settlementRepo, splitRepo)
+	settlementService := SettlementApp.CreateSettlementService(settlementRepo, splitRepo, groupRepo, userRepo, events)
+	settlementThreads := SettlementApp.CreateSettlementThreadResolver(settlementRepo, splitRepo, userRepo)
+	commentService := CommentApp.CreateCommentService(commentRepo, groupRepo, settlementThreads, events)
 
 	settlementHandler := SettlementAdapter.CreateSettlementHandler(settlementService)
+	commentHandler := CommentAdapter.CreateCommentHandler(commentService, "settlementId")
 
-	router := SettlementAdapter.CreateSettlementRouter(settlementAppFiber, settlementHandler, util)
+	router := SettlementAdapter.CreateSettlementRouter(settlementAppFiber, settlementHandler, commentHandler, util)
 	router.RegisterRoutes()
 
 	return router
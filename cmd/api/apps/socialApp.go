@@ -5,19 +5,20 @@ import (
 	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
 	SocialApp "autobill-service/internal/application/social"
 	DB "autobill-service/internal/infrastructure/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	JWTUtil "autobill-service/pkg/jwt"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func CreateSocialApp(util JWTUtil.JWTUtil, db DB.PostgresDB) SocialAdapter.SocialRouter {
+func CreateSocialApp(util JWTUtil.JWTUtil, db DB.PostgresDB, events EventPorts.EventPublisher) SocialAdapter.SocialRouter {
 	socialAppFiber := fiber.New(fiber.Config{
 		AppName: "autobill-social-service",
 	})
 
 	socialRepo := RepositoryAdapters.CreateSocialRepository(db)
 
-	socialService := SocialApp.CreateSocialService(socialRepo)
+	socialService := SocialApp.CreateSocialService(socialRepo, events)
 
 	socialHandler := SocialAdapter.CreateSocialHandler(socialService)
 
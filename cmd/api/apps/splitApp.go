@@ -1,29 +1,77 @@
 package apps
 
 import (
+	"time"
+
+	CategoryAdapter "autobill-service/internal/adapters/inbound/http/category"
+	CommentAdapter "autobill-service/internal/adapters/inbound/http/comment"
 	SplitAdapter "autobill-service/internal/adapters/inbound/http/split"
 	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
+	StorageAdapters "autobill-service/internal/adapters/outbound/storage"
+	CategoryApp "autobill-service/internal/application/category"
+	CommentApp "autobill-service/internal/application/comment"
 	SplitApp "autobill-service/internal/application/split"
+	Config "autobill-service/internal/infrastructure/config"
 	DB "autobill-service/internal/infrastructure/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
+	StoragePorts "autobill-service/internal/ports/outbound/storage"
 	JWTUtil "autobill-service/pkg/jwt"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func CreateSplitApp(util JWTUtil.JWTUtil, db DB.PostgresDB) SplitAdapter.SplitRouter {
+func CreateSplitApp(util JWTUtil.JWTUtil, db DB.PostgresDB, events EventPorts.EventPublisher, config Config.StorageConfig) SplitAdapter.SplitRouter {
 	splitAppFiber := fiber.New(fiber.Config{
 		AppName: "autobill-split-service",
 	})
 
 	splitRepo := RepositoryAdapters.CreateSplitRepository(db)
 	groupRepo := RepositoryAdapters.CreateGroupRepository(db)
+	categoryRepo := RepositoryAdapters.CreateCategoryRepository(db)
+
+	recurringSplitRepo := RepositoryAdapters.CreateRecurringSplitRepository(db)
+	attachmentRepo := RepositoryAdapters.CreateSplitAttachmentRepository(db)
+	commentRepo := RepositoryAdapters.CreateCommentRepository(db)
+	exportRepo := RepositoryAdapters.CreateExportRepository(db)
 
-	splitService := SplitApp.CreateSplitService(splitRepo, groupRepo)
+	splitService := SplitApp.CreateSplitService(splitRepo, groupRepo, categoryRepo, events)
+	recurringSplitService := SplitApp.CreateRecurringSplitService(recurringSplitRepo, splitService)
+	categoryService := CategoryApp.CreateCategoryService(categoryRepo, groupRepo)
+	attachmentService := SplitApp.CreateSplitAttachmentService(attachmentRepo, splitService, CreateBlobStore(config), int64(config.MaxAttachmentSize))
+	splitThreads := SplitApp.CreateSplitThreadResolver(splitService)
+	commentService := CommentApp.CreateCommentService(commentRepo, groupRepo, splitThreads, events)
+	exportService := SplitApp.CreateSplitExportService(exportRepo, groupRepo)
+	importService := SplitApp.CreateSplitImportService(splitService, splitRepo, groupRepo, categoryRepo)
 
 	splitHandler := SplitAdapter.CreateSplitHandler(splitService)
+	recurringSplitHandler := SplitAdapter.CreateRecurringSplitHandler(recurringSplitService)
+	categoryHandler := CategoryAdapter.CreateCategoryHandler(categoryService)
+	attachmentHandler := SplitAdapter.CreateSplitAttachmentHandler(attachmentService)
+	commentHandler := CommentAdapter.CreateCommentHandler(commentService, "splitId")
+	exportHandler := SplitAdapter.CreateSplitExportHandler(exportService)
+	importHandler := SplitAdapter.CreateSplitImportHandler(importService)
 
-	router := SplitAdapter.CreateSplitRouter(splitAppFiber, splitHandler, util)
+	router := SplitAdapter.CreateSplitRouter(splitAppFiber, splitHandler, recurringSplitHandler, categoryHandler, attachmentHandler, commentHandler, exportHandler, importHandler, util)
 	router.RegisterRoutes()
 
 	return router
 }
+
+func CreateBlobStore(config Config.StorageConfig) StoragePorts.BlobStore {
+	if config.Provider == "s3" {
+		s3 := config.S3
+		return StorageAdapters.CreateS3BlobStore(s3.Endpoint, s3.Region, s3.Bucket, s3.AccessKey, s3.SecretKey, s3.Timeout)
+	}
+	return StorageAdapters.CreateLocalBlobStore(config.LocalPath)
+}
+
+func CreateRecurringSplitScheduler(db DB.PostgresDB, events EventPorts.EventPublisher, interval time.Duration) *SplitApp.RecurringSplitScheduler {
+	splitRepo := RepositoryAdapters.CreateSplitRepository(db)
+	groupRepo := RepositoryAdapters.CreateGroupRepository(db)
+	categoryRepo := RepositoryAdapters.CreateCategoryRepository(db)
+	recurringSplitRepo := RepositoryAdapters.CreateRecurringSplitRepository(db)
+
+	splitService := SplitApp.CreateSplitService(splitRepo, groupRepo, categoryRepo, events)
+
+	return SplitApp.CreateRecurringSplitScheduler(recurringSplitRepo, splitService, interval)
+}
@@ -0,0 +1,38 @@
+package apps
+
+import (
+	WebhookAdapter "autobill-service/internal/adapters/inbound/http/webhook"
+	RepositoryAdapters "autobill-service/internal/adapters/outbound/db"
+	WebhookAdapters "autobill-service/internal/adapters/outbound/webhook"
+	WebhookApp "autobill-service/internal/application/webhook"
+	Config "autobill-service/internal/infrastructure/config"
+	DB "autobill-service/internal/infrastructure/db"
+	JWTUtil "autobill-service/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CreateWebhookApp(util JWTUtil.JWTUtil, db DB.PostgresDB) WebhookAdapter.WebhookRouter {
+	webhookAppFiber := fiber.New(fiber.Config{
+		AppName: "autobill-webhook-service",
+	})
+
+	webhookRepo := RepositoryAdapters.CreateWebhookRepository(db)
+	groupRepo := RepositoryAdapters.CreateGroupRepository(db)
+
+	webhookService := WebhookApp.CreateWebhookService(webhookRepo, groupRepo)
+
+	webhookHandler := WebhookAdapter.CreateWebhookHandler(webhookService)
+
+	router := WebhookAdapter.CreateWebhookRouter(webhookAppFiber, webhookHandler, util)
+	router.RegisterRoutes()
+
+	return router
+}
+
+func CreateWebhookDispatcher(db DB.PostgresDB, config Config.WebhookConfig) *WebhookApp.WebhookDispatcher {
+	webhookRepo := RepositoryAdapters.CreateWebhookRepository(db)
+	sender := WebhookAdapters.CreateHTTPWebhookSender(config.Timeout)
+
+	return WebhookApp.CreateWebhookDispatcher(webhookRepo, sender, config.DispatchInterval, config.MaxAttempts)
+}
@@ -3,8 +3,14 @@ package main
 import (
 	"autobill-service/cmd/api/apps"
 	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	EventAdapters "autobill-service/internal/adapters/outbound/events"
+	NotificationApp "autobill-service/internal/application/notification"
+	ReminderApp "autobill-service/internal/application/reminder"
+	SplitApp "autobill-service/internal/application/split"
+	WebhookApp "autobill-service/internal/application/webhook"
 	Config "autobill-service/internal/infrastructure/config"
 	DB "autobill-service/internal/infrastructure/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	JWTUtil "autobill-service/pkg/jwt"
 	Logger "autobill-service/pkg/logger"
 	"os"
@@ -32,14 +38,30 @@ func main() {
 
 	util := JWTUtil.CreateJwtUtil(config.JWT.Secret, config.JWT.Expiration, config.JWT.RefreshTokenExpiration)
 
+	// Leave room for the multipart framing around the largest attachment.
 	app := fiber.New(fiber.Config{
 		AppName:      "autobill-service",
 		ErrorHandler: Middlewares.GlobalErrorHandler,
+		BodyLimit:    max(config.Storage.MaxAttachmentSize, fiber.DefaultBodyLimit) + 1<<20,
 	})
 
 	registerMiddleware(app, config)
 
-	MountApps(app, util, *db)
+	eventBus := EventAdapters.CreateMemoryEventBus(config.Events.HistorySize)
+
+	MountApps(app, util, *db, eventBus, config)
+
+	recurringSplitScheduler := apps.CreateRecurringSplitScheduler(*db, eventBus, config.Scheduler.RecurringSplitInterval)
+	recurringSplitScheduler.Start()
+
+	webhookDispatcher := apps.CreateWebhookDispatcher(*db, config.Webhook)
+	webhookDispatcher.Start()
+
+	notificationDispatcher := apps.CreateNotificationDispatcher(*db, config.Notification)
+	notificationDispatcher.Start()
+
+	reminderScheduler := apps.CreateReminderScheduler(*db, config.Reminder)
+	reminderScheduler.Start()
 
 	Logger.Info().
 		Str("app", app.Config().AppName).
@@ -56,7 +78,7 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	gracefulShutdown(app, 30*time.Second)
+	gracefulShutdown(app, recurringSplitScheduler, webhookDispatcher, notificationDispatcher, reminderScheduler, eventBus, 30*time.Second)
 }
 
 func registerMiddleware(app *fiber.App, config Config.Config) {
@@ -78,18 +100,30 @@ func registerMiddleware(app *fiber.App, config Config.Config) {
 	}))
 }
 
-func MountApps(app *fiber.App, util JWTUtil.JWTUtil, db DB.PostgresDB) {
-	app.Mount("/auth", apps.CreateAuthApp(util, db).App)
+func MountApps(app *fiber.App, util JWTUtil.JWTUtil, db DB.PostgresDB, eventBus EventPorts.EventBus, config Config.Config) {
+	app.Mount("/auth", apps.CreateAuthApp(util, db, eventBus).App)
 	app.Mount("/user", apps.CreateUserApp(util, db).App)
-	app.Mount("/social", apps.CreateSocialApp(util, db).App)
-	app.Mount("/groups", apps.CreateGroupApp(util, db).App)
-	app.Mount("/splits", apps.CreateSplitApp(util, db).App)
-	app.Mount("/settlements", apps.CreateSettlementApp(util, db).App)
-	app.Mount("/balances", apps.CreateBalanceApp(util, db).App)
+	app.Mount("/social", apps.CreateSocialApp(util, db, eventBus).App)
+	app.Mount("/groups", apps.CreateGroupApp(util, db, eventBus).App)
+	app.Mount("/splits", apps.CreateSplitApp(util, db, eventBus, config.Storage).App)
+	app.Mount("/settlements", apps.CreateSettlementApp(util, db, eventBus).App)
+	app.Mount("/balances", apps.CreateBalanceApp(util, db, config.ExchangeRate).App)
+	app.Mount("/currencies", apps.CreateCurrencyApp().App)
+	app.Mount("/activity", apps.CreateActivityApp(util, db).App)
+	app.Mount("/events", apps.CreateEventApp(util, eventBus, config.Events.HeartbeatInterval).App)
+	app.Mount("/webhooks", apps.CreateWebhookApp(util, db).App)
+	app.Mount("/reminders", apps.CreateReminderApp(util, db, config.Reminder).App)
+	app.Mount("/exchange-rates", apps.CreateExchangeRateApp(util, db, config.ExchangeRate, config.Admin).App)
 }
 
-func gracefulShutdown(app *fiber.App, timeout time.Duration) {
+func gracefulShutdown(app *fiber.App, recurringSplitScheduler *SplitApp.RecurringSplitScheduler, webhookDispatcher *WebhookApp.WebhookDispatcher, notificationDispatcher *NotificationApp.NotificationDispatcher, reminderScheduler *ReminderApp.ReminderScheduler, eventBus EventPorts.EventBus, timeout time.Duration) {
 	Logger.Info().Msg("Shutting down server...")
+	recurringSplitScheduler.Stop()
+	webhookDispatcher.Stop()
+	notificationDispatcher.Stop()
+	reminderScheduler.Stop()
+	// Closing the bus ends open event streams so they don't hold up shutdown.
+	eventBus.Close()
 	if err := app.ShutdownWithTimeout(timeout); err != nil {
 		Logger.Error().Err(err).Msg("Error during shutdown")
 	}
@@ -0,0 +1,24 @@
+package ActivityDtos
+
+import "time"
+
+type ActivityResponseDto struct {
+	ID         string                 `json:"id"`
+	ActorID    string                 `json:"actor_id"`
+	ActorName  string                 `json:"actor_name"`
+	Action     string                 `json:"action"`
+	TargetType string                 `json:"target_type"`
+	TargetID   string                 `json:"target_id"`
+	GroupID    *string                `json:"group_id,omitempty"`
+	Before     map[string]interface{} `json:"before,omitempty"`
+	After      map[string]interface{} `json:"after,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+type ActivityListResponseDto struct {
+	Activities []ActivityResponseDto `json:"activities"`
+	Page       int                   `json:"page"`
+	PageSize   int                   `json:"page_size"`
+	TotalItems int64                 `json:"total_items"`
+	TotalPages int                   `json:"total_pages"`
+}
@@ -0,0 +1,54 @@
+package ActivityAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ActivityHandler struct {
+	service HttpPorts.ActivityUseCase
+}
+
+func CreateActivityHandler(service HttpPorts.ActivityUseCase) ActivityHandler {
+	return ActivityHandler{service: service}
+}
+
+func (h *ActivityHandler) GetMyActivityHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	pagination := Helpers.ParsePagination(c)
+	result, err := h.service.GetMyActivity(ctx, userId, pagination)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToActivityListResponseDto(result))
+}
+
+func (h *ActivityHandler) GetGroupActivityHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+
+	pagination := Helpers.ParsePagination(c)
+	result, err := h.service.GetGroupActivity(ctx, userId, groupId, pagination)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToActivityListResponseDto(result))
+}
@@ -0,0 +1,33 @@
+package ActivityAdapter
+
+import (
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/activity/dtos"
+	ServiceDtos "autobill-service/internal/application/activity/dtos"
+	Helpers "autobill-service/pkg/helpers"
+)
+
+func ToActivityListResponseDto(result *ServiceDtos.ActivityListResult) AdapterDtos.ActivityListResponseDto {
+	activities := make([]AdapterDtos.ActivityResponseDto, len(result.Activities))
+	for i, a := range result.Activities {
+		activities[i] = AdapterDtos.ActivityResponseDto{
+			ID:         a.ID,
+			ActorID:    a.ActorID,
+			ActorName:  a.ActorName,
+			Action:     a.Action,
+			TargetType: a.TargetType,
+			TargetID:   a.TargetID,
+			GroupID:    a.GroupID,
+			Before:     a.Before,
+			After:      a.After,
+			CreatedAt:  a.CreatedAt,
+		}
+	}
+
+	return AdapterDtos.ActivityListResponseDto{
+		Activities: activities,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
+		TotalPages: Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
+	}
+}
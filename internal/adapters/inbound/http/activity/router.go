@@ -0,0 +1,28 @@
+package ActivityAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	JWTUtil "autobill-service/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ActivityRouter struct {
+	App     *fiber.App
+	handler ActivityHandler
+	util    JWTUtil.JWTUtil
+}
+
+func CreateActivityRouter(app *fiber.App, handler ActivityHandler, util JWTUtil.JWTUtil) ActivityRouter {
+	return ActivityRouter{
+		App:     app,
+		handler: handler,
+		util:    util,
+	}
+}
+
+func (r ActivityRouter) RegisterRoutes() {
+	r.App.Use(Middlewares.AuthMiddleware(r.util))
+
+	r.App.Get("/", r.handler.GetMyActivityHandler).Name("getMyActivity")
+}
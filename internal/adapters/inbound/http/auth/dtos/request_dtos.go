@@ -32,3 +32,9 @@ type ReactivateUserRequestDto struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"min=8"`
 }
+
+type MergeAccountRequestDto struct {
+	Password       string `json:"password" validate:"min=8"`
+	SourceEmail    string `json:"source_email" validate:"required,email"`
+	SourcePassword string `json:"source_password" validate:"min=8"`
+}
@@ -103,6 +103,29 @@ func (h *AuthHandler) DeactivateUserHandler(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
+func (h *AuthHandler) MergeAccountHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	reqBody := new(Dtos.MergeAccountRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	err = h.service.MergeAccount(ctx, userId, ToMergeAccountInput(reqBody))
+	if err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
 func (h *AuthHandler) ReactivateUserHandler(c *fiber.Ctx) error {
 	ctx := Middlewares.GetContext(c)
 	reqBody := new(Dtos.ReactivateUserRequestDto)
@@ -26,6 +26,14 @@ func ToRefreshTokenInput(dto *AdapterDtos.RefreshTokenRequestDto) ServiceDtos.Re
 	}
 }
 
+func ToMergeAccountInput(dto *AdapterDtos.MergeAccountRequestDto) ServiceDtos.MergeAccountInput {
+	return ServiceDtos.MergeAccountInput{
+		Password:       dto.Password,
+		SourceEmail:    dto.SourceEmail,
+		SourcePassword: dto.SourcePassword,
+	}
+}
+
 func ToUserLoginResponseDto(result *ServiceDtos.AuthResult) AdapterDtos.UserLoginResponseDto {
 	return AdapterDtos.UserLoginResponseDto{
 		Id:           result.ID,
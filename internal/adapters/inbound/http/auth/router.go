@@ -31,4 +31,5 @@ func (ar AuthRouter) RegisterRoutes() {
 	ar.App.Put("/password", ar.handler.UpdatePasswordHandler).Name("updatePassword")
 	ar.App.Post("/logout-all", ar.handler.LogoutAllHandler).Name("logoutAllDevices")
 	ar.App.Delete("/deactivate", ar.handler.DeactivateUserHandler).Name("deactivateUser")
+	ar.App.Post("/merge", ar.handler.MergeAccountHandler).Name("mergeAccount")
 }
@@ -1,40 +1,126 @@
 package BalanceDtos
 
+import "time"
+
 type UserBalanceItemDto struct {
-	OtherUserID   string `json:"other_user_id"`
-	OtherUserName string `json:"other_user_name"`
-	NetAmount     int64  `json:"net_amount"`
-	Currency      string `json:"currency"`
+	OtherUserID              string  `json:"other_user_id"`
+	OtherUserName            string  `json:"other_user_name"`
+	NetAmount                int64   `json:"net_amount"`
+	FormattedNetAmount       *string `json:"formatted_net_amount,omitempty"`
+	Currency                 string  `json:"currency"`
+	ConvertedAmount          *int64  `json:"converted_amount,omitempty"`
+	FormattedConvertedAmount *string `json:"formatted_converted_amount,omitempty"`
 }
 
 type UserBalanceResponseDto struct {
-	UserID   string               `json:"user_id"`
-	Balances []UserBalanceItemDto `json:"balances"`
+	UserID     string               `json:"user_id"`
+	Balances   []UserBalanceItemDto `json:"balances"`
+	Conversion *ConversionDto       `json:"conversion,omitempty"`
 }
 
 type GroupBalanceItemDto struct {
-	UserID    string `json:"user_id"`
-	UserName  string `json:"user_name"`
-	NetAmount int64  `json:"net_amount"`
-	Currency  string `json:"currency"`
+	UserID                   string  `json:"user_id"`
+	UserName                 string  `json:"user_name"`
+	NetAmount                int64   `json:"net_amount"`
+	FormattedNetAmount       *string `json:"formatted_net_amount,omitempty"`
+	Currency                 string  `json:"currency"`
+	ConvertedAmount          *int64  `json:"converted_amount,omitempty"`
+	FormattedConvertedAmount *string `json:"formatted_converted_amount,omitempty"`
 }
 
 type GroupBalanceResponseDto struct {
-	GroupID   string                `json:"group_id"`
-	GroupName string                `json:"group_name"`
-	Balances  []GroupBalanceItemDto `json:"balances"`
+	GroupID    string                `json:"group_id"`
+	GroupName  string                `json:"group_name"`
+	Balances   []GroupBalanceItemDto `json:"balances"`
+	Conversion *ConversionDto        `json:"conversion,omitempty"`
 }
 
 type SimplifiedDebtDto struct {
-	FromUserID   string `json:"from_user_id"`
-	FromUserName string `json:"from_user_name"`
-	ToUserID     string `json:"to_user_id"`
-	ToUserName   string `json:"to_user_name"`
-	Amount       int64  `json:"amount"`
-	Currency     string `json:"currency"`
+	FromUserID      string  `json:"from_user_id"`
+	FromUserName    string  `json:"from_user_name"`
+	ToUserID        string  `json:"to_user_id"`
+	ToUserName      string  `json:"to_user_name"`
+	Amount          int64   `json:"amount"`
+	FormattedAmount *string `json:"formatted_amount,omitempty"`
+	Currency        string  `json:"currency"`
 }
 
 type SimplifiedDebtsResponseDto struct {
-	GroupID string              `json:"group_id"`
-	Debts   []SimplifiedDebtDto `json:"debts"`
+	GroupID    string              `json:"group_id"`
+	Debts      []SimplifiedDebtDto `json:"debts"`
+	Conversion *ConversionDto      `json:"conversion,omitempty"`
+}
+
+type ConversionDto struct {
+	Currency             string                `json:"currency"`
+	AsOf                 time.Time             `json:"as_of"`
+	TotalAmount          int64                 `json:"total_amount"`
+	FormattedTotalAmount *string               `json:"formatted_total_amount,omitempty"`
+	Totals               []ConvertedTotalDto   `json:"totals"`
+	Rates                []ExchangeRateUsedDto `json:"rates"`
+}
+
+type ConvertedTotalDto struct {
+	UserID             string  `json:"user_id"`
+	UserName           string  `json:"user_name"`
+	NetAmount          int64   `json:"net_amount"`
+	FormattedNetAmount *string `json:"formatted_net_amount,omitempty"`
+}
+
+type ExchangeRateUsedDto struct {
+	BaseCurrency  string  `json:"base_currency"`
+	QuoteCurrency string  `json:"quote_currency"`
+	Rate          float64 `json:"rate"`
+	EffectiveDate string  `json:"effective_date"`
+	Source        string  `json:"source"`
+}
+
+type BalanceMismatchDto struct {
+	UserID          string  `json:"user_id"`
+	OtherUserID     *string `json:"other_user_id,omitempty"`
+	GroupID         *string `json:"group_id,omitempty"`
+	Currency        string  `json:"currency"`
+	LedgerAmount    int64   `json:"ledger_amount"`
+	ProjectedAmount int64   `json:"projected_amount"`
+}
+
+type UnbalancedTransactionDto struct {
+	TransactionID string `json:"transaction_id"`
+	Account       string `json:"account"`
+	Currency      string `json:"currency"`
+	Total         int64  `json:"total"`
+}
+
+type LedgerVerificationResponseDto struct {
+	Consistent             bool                       `json:"consistent"`
+	Mismatches             []BalanceMismatchDto       `json:"mismatches"`
+	UnbalancedTransactions []UnbalancedTransactionDto `json:"unbalanced_transactions"`
+}
+
+type OverdueReceivableDto struct {
+	SplitID         string    `json:"split_id"`
+	Description     string    `json:"description"`
+	GroupID         *string   `json:"group_id,omitempty"`
+	DebtorID        string    `json:"debtor_id"`
+	DebtorName      string    `json:"debtor_name"`
+	Amount          int64     `json:"amount"`
+	FormattedAmount *string   `json:"formatted_amount,omitempty"`
+	Currency        string    `json:"currency"`
+	SplitCreatedAt  time.Time `json:"split_created_at"`
+	AgeDays         int       `json:"age_days"`
+	Bucket          string    `json:"bucket"`
+}
+
+type AgingBucketDto struct {
+	Bucket          string  `json:"bucket"`
+	Currency        string  `json:"currency"`
+	Amount          int64   `json:"amount"`
+	FormattedAmount *string `json:"formatted_amount,omitempty"`
+	Count           int     `json:"count"`
+}
+
+type OverdueReceivablesResponseDto struct {
+	UserID      string                 `json:"user_id"`
+	Receivables []OverdueReceivableDto `json:"receivables"`
+	Buckets     []AgingBucketDto       `json:"buckets"`
 }
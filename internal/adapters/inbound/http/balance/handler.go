@@ -23,12 +23,12 @@ func (h *BalanceHandler) GetMyBalanceHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	result, err := h.service.GetMyBalance(ctx, userId)
+	result, err := h.service.GetMyBalance(ctx, userId, c.Query("convert_to"))
 	if err != nil {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ToUserBalanceResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToUserBalanceResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
 
 func (h *BalanceHandler) GetBalanceWithUserHandler(c *fiber.Ctx) error {
@@ -42,12 +42,42 @@ func (h *BalanceHandler) GetBalanceWithUserHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	result, err := h.service.GetBalanceWithUser(ctx, userId, otherUserId)
+	result, err := h.service.GetBalanceWithUser(ctx, userId, otherUserId, c.Query("convert_to"))
 	if err != nil {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ToUserBalanceResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToUserBalanceResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *BalanceHandler) RecalculateMyBalanceHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.RecalculateMyBalance(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToUserBalanceResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *BalanceHandler) VerifyMyBalanceHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.VerifyMyBalance(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToLedgerVerificationResponseDto(result))
 }
 
 func (h *BalanceHandler) GetGroupBalanceHandler(c *fiber.Ctx) error {
@@ -61,12 +91,12 @@ func (h *BalanceHandler) GetGroupBalanceHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	result, err := h.service.GetGroupBalance(ctx, userId, groupId)
+	result, err := h.service.GetGroupBalance(ctx, userId, groupId, c.Query("convert_to"))
 	if err != nil {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ToGroupBalanceResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToGroupBalanceResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
 
 func (h *BalanceHandler) RecalculateGroupBalanceHandler(c *fiber.Ctx) error {
@@ -85,7 +115,26 @@ func (h *BalanceHandler) RecalculateGroupBalanceHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ToGroupBalanceResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToGroupBalanceResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *BalanceHandler) VerifyGroupBalanceHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.VerifyGroupBalance(ctx, userId, groupId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToLedgerVerificationResponseDto(result))
 }
 
 func (h *BalanceHandler) GetSimplifiedDebtsHandler(c *fiber.Ctx) error {
@@ -104,5 +153,20 @@ func (h *BalanceHandler) GetSimplifiedDebtsHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ToSimplifiedDebtsResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToSimplifiedDebtsResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *BalanceHandler) GetOverdueReceivablesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetOverdueReceivables(ctx, userId, c.QueryInt("min_days", 0))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToOverdueReceivablesResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
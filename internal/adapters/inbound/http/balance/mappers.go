@@ -3,79 +3,207 @@ package BalanceAdapter
 import (
 	AdapterDtos "autobill-service/internal/adapters/inbound/http/balance/dtos"
 	ServiceDtos "autobill-service/internal/application/balance/dtos"
+	Domain "autobill-service/internal/domain"
 )
 
-func ToUserBalanceItemDto(result *ServiceDtos.UserBalanceItemResult) AdapterDtos.UserBalanceItemDto {
+func ToUserBalanceItemDto(result *ServiceDtos.UserBalanceItemResult, conversion *ServiceDtos.ConversionResult, formatted bool) AdapterDtos.UserBalanceItemDto {
 	return AdapterDtos.UserBalanceItemDto{
-		OtherUserID:   result.OtherUserID,
-		OtherUserName: result.OtherUserName,
-		NetAmount:     result.NetAmount,
-		Currency:      result.Currency,
+		OtherUserID:              result.OtherUserID,
+		OtherUserName:            result.OtherUserName,
+		NetAmount:                result.NetAmount,
+		FormattedNetAmount:       formatAmount(formatted, result.NetAmount, result.Currency),
+		Currency:                 result.Currency,
+		ConvertedAmount:          result.ConvertedAmount,
+		FormattedConvertedAmount: formatConvertedAmount(formatted, result.ConvertedAmount, conversion),
 	}
 }
 
-func ToUserBalanceItemDtoList(results []ServiceDtos.UserBalanceItemResult) []AdapterDtos.UserBalanceItemDto {
+func ToUserBalanceItemDtoList(results []ServiceDtos.UserBalanceItemResult, conversion *ServiceDtos.ConversionResult, formatted bool) []AdapterDtos.UserBalanceItemDto {
 	items := make([]AdapterDtos.UserBalanceItemDto, len(results))
 	for i, b := range results {
-		items[i] = ToUserBalanceItemDto(&b)
+		items[i] = ToUserBalanceItemDto(&b, conversion, formatted)
 	}
 	return items
 }
 
-func ToUserBalanceResponseDto(result *ServiceDtos.UserBalanceResult) AdapterDtos.UserBalanceResponseDto {
+func ToUserBalanceResponseDto(result *ServiceDtos.UserBalanceResult, formatted bool) AdapterDtos.UserBalanceResponseDto {
 	return AdapterDtos.UserBalanceResponseDto{
-		UserID:   result.UserID,
-		Balances: ToUserBalanceItemDtoList(result.Balances),
+		UserID:     result.UserID,
+		Balances:   ToUserBalanceItemDtoList(result.Balances, result.Conversion, formatted),
+		Conversion: ToConversionDto(result.Conversion, formatted),
 	}
 }
 
-func ToGroupBalanceItemDto(result *ServiceDtos.GroupBalanceItemResult) AdapterDtos.GroupBalanceItemDto {
+func ToGroupBalanceItemDto(result *ServiceDtos.GroupBalanceItemResult, conversion *ServiceDtos.ConversionResult, formatted bool) AdapterDtos.GroupBalanceItemDto {
 	return AdapterDtos.GroupBalanceItemDto{
-		UserID:    result.UserID,
-		UserName:  result.UserName,
-		NetAmount: result.NetAmount,
-		Currency:  result.Currency,
+		UserID:                   result.UserID,
+		UserName:                 result.UserName,
+		NetAmount:                result.NetAmount,
+		FormattedNetAmount:       formatAmount(formatted, result.NetAmount, result.Currency),
+		Currency:                 result.Currency,
+		ConvertedAmount:          result.ConvertedAmount,
+		FormattedConvertedAmount: formatConvertedAmount(formatted, result.ConvertedAmount, conversion),
 	}
 }
 
-func ToGroupBalanceItemDtoList(results []ServiceDtos.GroupBalanceItemResult) []AdapterDtos.GroupBalanceItemDto {
+func ToGroupBalanceItemDtoList(results []ServiceDtos.GroupBalanceItemResult, conversion *ServiceDtos.ConversionResult, formatted bool) []AdapterDtos.GroupBalanceItemDto {
 	items := make([]AdapterDtos.GroupBalanceItemDto, len(results))
 	for i, b := range results {
-		items[i] = ToGroupBalanceItemDto(&b)
+		items[i] = ToGroupBalanceItemDto(&b, conversion, formatted)
 	}
 	return items
 }
 
-func ToGroupBalanceResponseDto(result *ServiceDtos.GroupBalanceResult) AdapterDtos.GroupBalanceResponseDto {
+func ToGroupBalanceResponseDto(result *ServiceDtos.GroupBalanceResult, formatted bool) AdapterDtos.GroupBalanceResponseDto {
 	return AdapterDtos.GroupBalanceResponseDto{
-		GroupID:   result.GroupID,
-		GroupName: result.GroupName,
-		Balances:  ToGroupBalanceItemDtoList(result.Balances),
+		GroupID:    result.GroupID,
+		GroupName:  result.GroupName,
+		Balances:   ToGroupBalanceItemDtoList(result.Balances, result.Conversion, formatted),
+		Conversion: ToConversionDto(result.Conversion, formatted),
 	}
 }
 
-func ToSimplifiedDebtDto(result *ServiceDtos.SimplifiedDebtResult) AdapterDtos.SimplifiedDebtDto {
+func ToSimplifiedDebtDto(result *ServiceDtos.SimplifiedDebtResult, formatted bool) AdapterDtos.SimplifiedDebtDto {
 	return AdapterDtos.SimplifiedDebtDto{
-		FromUserID:   result.FromUserID,
-		FromUserName: result.FromUserName,
-		ToUserID:     result.ToUserID,
-		ToUserName:   result.ToUserName,
-		Amount:       result.Amount,
-		Currency:     result.Currency,
+		FromUserID:      result.FromUserID,
+		FromUserName:    result.FromUserName,
+		ToUserID:        result.ToUserID,
+		ToUserName:      result.ToUserName,
+		Amount:          result.Amount,
+		FormattedAmount: formatAmount(formatted, result.Amount, result.Currency),
+		Currency:        result.Currency,
 	}
 }
 
-func ToSimplifiedDebtDtoList(results []ServiceDtos.SimplifiedDebtResult) []AdapterDtos.SimplifiedDebtDto {
+func ToSimplifiedDebtDtoList(results []ServiceDtos.SimplifiedDebtResult, formatted bool) []AdapterDtos.SimplifiedDebtDto {
 	items := make([]AdapterDtos.SimplifiedDebtDto, len(results))
 	for i, d := range results {
-		items[i] = ToSimplifiedDebtDto(&d)
+		items[i] = ToSimplifiedDebtDto(&d, formatted)
 	}
 	return items
 }
 
-func ToSimplifiedDebtsResponseDto(result *ServiceDtos.SimplifiedDebtsResult) AdapterDtos.SimplifiedDebtsResponseDto {
+func ToSimplifiedDebtsResponseDto(result *ServiceDtos.SimplifiedDebtsResult, formatted bool) AdapterDtos.SimplifiedDebtsResponseDto {
 	return AdapterDtos.SimplifiedDebtsResponseDto{
-		GroupID: result.GroupID,
-		Debts:   ToSimplifiedDebtDtoList(result.Debts),
+		GroupID:    result.GroupID,
+		Debts:      ToSimplifiedDebtDtoList(result.Debts, formatted),
+		Conversion: ToConversionDto(result.Conversion, formatted),
+	}
+}
+
+func ToConversionDto(result *ServiceDtos.ConversionResult, formatted bool) *AdapterDtos.ConversionDto {
+	if result == nil {
+		return nil
+	}
+
+	totals := make([]AdapterDtos.ConvertedTotalDto, len(result.Totals))
+	for i, t := range result.Totals {
+		totals[i] = AdapterDtos.ConvertedTotalDto{
+			UserID:             t.UserID,
+			UserName:           t.UserName,
+			NetAmount:          t.NetAmount,
+			FormattedNetAmount: formatAmount(formatted, t.NetAmount, result.Currency),
+		}
+	}
+
+	rates := make([]AdapterDtos.ExchangeRateUsedDto, len(result.Rates))
+	for i, r := range result.Rates {
+		rates[i] = AdapterDtos.ExchangeRateUsedDto{
+			BaseCurrency:  r.BaseCurrency,
+			QuoteCurrency: r.QuoteCurrency,
+			Rate:          r.Rate,
+			EffectiveDate: r.EffectiveDate.Format("2006-01-02"),
+			Source:        r.Source,
+		}
+	}
+
+	return &AdapterDtos.ConversionDto{
+		Currency:             result.Currency,
+		AsOf:                 result.AsOf,
+		TotalAmount:          result.TotalAmount,
+		FormattedTotalAmount: formatAmount(formatted, result.TotalAmount, result.Currency),
+		Totals:               totals,
+		Rates:                rates,
+	}
+}
+
+func ToLedgerVerificationResponseDto(result *ServiceDtos.LedgerVerificationResult) AdapterDtos.LedgerVerificationResponseDto {
+	mismatches := make([]AdapterDtos.BalanceMismatchDto, len(result.Mismatches))
+	for i, m := range result.Mismatches {
+		mismatches[i] = AdapterDtos.BalanceMismatchDto{
+			UserID:          m.UserID,
+			OtherUserID:     m.OtherUserID,
+			GroupID:         m.GroupID,
+			Currency:        m.Currency,
+			LedgerAmount:    m.LedgerAmount,
+			ProjectedAmount: m.ProjectedAmount,
+		}
+	}
+
+	transactions := make([]AdapterDtos.UnbalancedTransactionDto, len(result.UnbalancedTransactions))
+	for i, t := range result.UnbalancedTransactions {
+		transactions[i] = AdapterDtos.UnbalancedTransactionDto{
+			TransactionID: t.TransactionID,
+			Account:       t.Account,
+			Currency:      t.Currency,
+			Total:         t.Total,
+		}
+	}
+
+	return AdapterDtos.LedgerVerificationResponseDto{
+		Consistent:             result.Consistent,
+		Mismatches:             mismatches,
+		UnbalancedTransactions: transactions,
+	}
+}
+
+func formatAmount(formatted bool, amount int64, currency string) *string {
+	if !formatted {
+		return nil
+	}
+	value := Domain.FormatAmount(amount, Domain.Currency(currency))
+	return &value
+}
+
+func formatConvertedAmount(formatted bool, amount *int64, conversion *ServiceDtos.ConversionResult) *string {
+	if amount == nil || conversion == nil {
+		return nil
+	}
+	return formatAmount(formatted, *amount, conversion.Currency)
+}
+
+func ToOverdueReceivablesResponseDto(result *ServiceDtos.OverdueReceivablesResult, formatted bool) AdapterDtos.OverdueReceivablesResponseDto {
+	receivables := make([]AdapterDtos.OverdueReceivableDto, len(result.Receivables))
+	for i, r := range result.Receivables {
+		receivables[i] = AdapterDtos.OverdueReceivableDto{
+			SplitID:         r.SplitID,
+			Description:     r.Description,
+			GroupID:         r.GroupID,
+			DebtorID:        r.DebtorID,
+			DebtorName:      r.DebtorName,
+			Amount:          r.Amount,
+			FormattedAmount: formatAmount(formatted, r.Amount, r.Currency),
+			Currency:        r.Currency,
+			SplitCreatedAt:  r.SplitCreatedAt,
+			AgeDays:         r.AgeDays,
+			Bucket:          r.Bucket,
+		}
+	}
+
+	buckets := make([]AdapterDtos.AgingBucketDto, len(result.Buckets))
+	for i, b := range result.Buckets {
+		buckets[i] = AdapterDtos.AgingBucketDto{
+			Bucket:          b.Bucket,
+			Currency:        b.Currency,
+			Amount:          b.Amount,
+			FormattedAmount: formatAmount(formatted, b.Amount, b.Currency),
+			Count:           b.Count,
+		}
+	}
+
+	return AdapterDtos.OverdueReceivablesResponseDto{
+		UserID:      result.UserID,
+		Receivables: receivables,
+		Buckets:     buckets,
 	}
 }
@@ -25,9 +25,13 @@ func (r BalanceRouter) RegisterRoutes() {
 	r.App.Use(Middlewares.AuthMiddleware(r.util))
 
 	r.App.Get("/me", r.handler.GetMyBalanceHandler).Name("getMyBalance")
+	r.App.Post("/me/recalculate", r.handler.RecalculateMyBalanceHandler).Name("recalculateMyBalance")
+	r.App.Get("/me/verify", r.handler.VerifyMyBalanceHandler).Name("verifyMyBalance")
+	r.App.Get("/overdue", r.handler.GetOverdueReceivablesHandler).Name("getOverdueReceivables")
 	r.App.Get("/users/:userId", r.handler.GetBalanceWithUserHandler).Name("getBalanceWithUser")
 
 	r.App.Get("/groups/:groupId", r.handler.GetGroupBalanceHandler).Name("getGroupBalance")
 	r.App.Post("/groups/:groupId/recalculate", r.handler.RecalculateGroupBalanceHandler).Name("recalculateGroupBalance")
+	r.App.Get("/groups/:groupId/verify", r.handler.VerifyGroupBalanceHandler).Name("verifyGroupBalance")
 	r.App.Get("/groups/:groupId/simplify", r.handler.GetSimplifiedDebtsHandler).Name("getSimplifiedDebts")
 }
@@ -0,0 +1,5 @@
+package CategoryDtos
+
+type CreateCategoryRequestDto struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
@@ -0,0 +1,15 @@
+package CategoryDtos
+
+import "time"
+
+type CategoryResponseDto struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	GroupID   *string   `json:"group_id,omitempty"`
+	IsSystem  bool      `json:"is_system"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CategoryListResponseDto struct {
+	Categories []CategoryResponseDto `json:"categories"`
+}
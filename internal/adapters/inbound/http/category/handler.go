@@ -0,0 +1,98 @@
+package CategoryAdapter
+
+import (
+	CategoryDtos "autobill-service/internal/adapters/inbound/http/category/dtos"
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CategoryHandler struct {
+	service HttpPorts.CategoryUseCase
+}
+
+func CreateCategoryHandler(service HttpPorts.CategoryUseCase) CategoryHandler {
+	return CategoryHandler{service: service}
+}
+
+func (h *CategoryHandler) GetSystemCategoriesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+
+	result, err := h.service.GetSystemCategories(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToCategoryListResponseDto(result))
+}
+
+func (h *CategoryHandler) GetGroupCategoriesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetGroupCategories(ctx, userId, groupId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToCategoryListResponseDto(result))
+}
+
+func (h *CategoryHandler) CreateCategoryHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(CategoryDtos.CreateCategoryRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.CreateCategory(ctx, userId, groupId, ToCreateCategoryInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ToCategoryResponseDto(result))
+}
+
+func (h *CategoryHandler) DeleteCategoryHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+	categoryId, err := Helpers.ParseUUID(c.Params("categoryId"))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteCategory(ctx, userId, groupId, categoryId); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,30 @@
+package CategoryAdapter
+
+import (
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/category/dtos"
+	ServiceDtos "autobill-service/internal/application/category/dtos"
+)
+
+func ToCreateCategoryInput(dto *AdapterDtos.CreateCategoryRequestDto) ServiceDtos.CreateCategoryInput {
+	return ServiceDtos.CreateCategoryInput{
+		Name: dto.Name,
+	}
+}
+
+func ToCategoryResponseDto(result *ServiceDtos.CategoryResult) AdapterDtos.CategoryResponseDto {
+	return AdapterDtos.CategoryResponseDto{
+		ID:        result.ID,
+		Name:      result.Name,
+		GroupID:   result.GroupID,
+		IsSystem:  result.IsSystem,
+		CreatedAt: result.CreatedAt,
+	}
+}
+
+func ToCategoryListResponseDto(result *ServiceDtos.CategoryListResult) AdapterDtos.CategoryListResponseDto {
+	categories := make([]AdapterDtos.CategoryResponseDto, len(result.Categories))
+	for i := range result.Categories {
+		categories[i] = ToCategoryResponseDto(&result.Categories[i])
+	}
+	return AdapterDtos.CategoryListResponseDto{Categories: categories}
+}
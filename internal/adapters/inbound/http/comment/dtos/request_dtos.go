@@ -0,0 +1,10 @@
+package CommentDtos
+
+type CreateCommentRequestDto struct {
+	Body     string `json:"body" validate:"required,max=2000"`
+	ParentID string `json:"parent_id" validate:"omitempty,uuid"`
+}
+
+type UpdateCommentRequestDto struct {
+	Body string `json:"body" validate:"required,max=2000"`
+}
@@ -0,0 +1,29 @@
+package CommentDtos
+
+import "time"
+
+type MentionResponseDto struct {
+	UserID   string `json:"user_id"`
+	UserName string `json:"user_name"`
+}
+
+type CommentResponseDto struct {
+	ID         string               `json:"id"`
+	ParentID   *string              `json:"parent_id,omitempty"`
+	AuthorID   string               `json:"author_id"`
+	AuthorName string               `json:"author_name"`
+	Body       string               `json:"body"`
+	Mentions   []MentionResponseDto `json:"mentions"`
+	IsDeleted  bool                 `json:"is_deleted"`
+	EditedAt   *time.Time           `json:"edited_at,omitempty"`
+	CreatedAt  time.Time            `json:"created_at"`
+	Replies    []CommentResponseDto `json:"replies,omitempty"`
+}
+
+type CommentListResponseDto struct {
+	Comments   []CommentResponseDto `json:"comments"`
+	Page       int                  `json:"page"`
+	PageSize   int                  `json:"page_size"`
+	TotalItems int64                `json:"total_items"`
+	TotalPages int                  `json:"total_pages"`
+}
@@ -0,0 +1,123 @@
+package CommentAdapter
+
+import (
+	CommentDtos "autobill-service/internal/adapters/inbound/http/comment/dtos"
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CommentHandler serves the comments of one kind of thread. targetParam names
+// the route parameter holding the split or settlement ID.
+type CommentHandler struct {
+	service     HttpPorts.CommentUseCase
+	targetParam string
+}
+
+func CreateCommentHandler(service HttpPorts.CommentUseCase, targetParam string) CommentHandler {
+	return CommentHandler{service: service, targetParam: targetParam}
+}
+
+func (h *CommentHandler) GetCommentsHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	targetId, err := Helpers.ParseUUID(c.Params(h.targetParam))
+	if err != nil {
+		return err
+	}
+
+	pagination := Helpers.ParsePagination(c)
+	result, err := h.service.GetComments(ctx, userId, targetId, pagination)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToCommentListResponseDto(result))
+}
+
+func (h *CommentHandler) CreateCommentHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	targetId, err := Helpers.ParseUUID(c.Params(h.targetParam))
+	if err != nil {
+		return err
+	}
+	reqBody := new(CommentDtos.CreateCommentRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.CreateComment(ctx, userId, targetId, ToCreateCommentInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ToCommentResponseDto(result))
+}
+
+func (h *CommentHandler) UpdateCommentHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	targetId, err := Helpers.ParseUUID(c.Params(h.targetParam))
+	if err != nil {
+		return err
+	}
+	commentId, err := Helpers.ParseUUID(c.Params("commentId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(CommentDtos.UpdateCommentRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.UpdateComment(ctx, userId, targetId, commentId, ToUpdateCommentInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToCommentResponseDto(result))
+}
+
+func (h *CommentHandler) DeleteCommentHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	targetId, err := Helpers.ParseUUID(c.Params(h.targetParam))
+	if err != nil {
+		return err
+	}
+	commentId, err := Helpers.ParseUUID(c.Params("commentId"))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteComment(ctx, userId, targetId, commentId); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
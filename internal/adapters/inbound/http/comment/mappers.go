@@ -0,0 +1,63 @@
+package CommentAdapter
+
+import (
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/comment/dtos"
+	ServiceDtos "autobill-service/internal/application/comment/dtos"
+	Helpers "autobill-service/pkg/helpers"
+)
+
+func ToCreateCommentInput(dto *AdapterDtos.CreateCommentRequestDto) ServiceDtos.CreateCommentInput {
+	return ServiceDtos.CreateCommentInput{
+		Body:     dto.Body,
+		ParentID: dto.ParentID,
+	}
+}
+
+func ToUpdateCommentInput(dto *AdapterDtos.UpdateCommentRequestDto) ServiceDtos.UpdateCommentInput {
+	return ServiceDtos.UpdateCommentInput{
+		Body: dto.Body,
+	}
+}
+
+func ToCommentResponseDto(result *ServiceDtos.CommentResult) AdapterDtos.CommentResponseDto {
+	mentions := make([]AdapterDtos.MentionResponseDto, len(result.Mentions))
+	for i, mention := range result.Mentions {
+		mentions[i] = AdapterDtos.MentionResponseDto{
+			UserID:   mention.UserID,
+			UserName: mention.UserName,
+		}
+	}
+
+	dto := AdapterDtos.CommentResponseDto{
+		ID:         result.ID,
+		ParentID:   result.ParentID,
+		AuthorID:   result.AuthorID,
+		AuthorName: result.AuthorName,
+		Body:       result.Body,
+		Mentions:   mentions,
+		IsDeleted:  result.IsDeleted,
+		EditedAt:   result.EditedAt,
+		CreatedAt:  result.CreatedAt,
+	}
+	if result.Replies != nil {
+		dto.Replies = make([]AdapterDtos.CommentResponseDto, len(result.Replies))
+		for i := range result.Replies {
+			dto.Replies[i] = ToCommentResponseDto(&result.Replies[i])
+		}
+	}
+	return dto
+}
+
+func ToCommentListResponseDto(result *ServiceDtos.CommentListResult) AdapterDtos.CommentListResponseDto {
+	comments := make([]AdapterDtos.CommentResponseDto, len(result.Comments))
+	for i := range result.Comments {
+		comments[i] = ToCommentResponseDto(&result.Comments[i])
+	}
+	return AdapterDtos.CommentListResponseDto{
+		Comments:   comments,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
+		TotalPages: Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
+	}
+}
@@ -0,0 +1,11 @@
+package CurrencyDtos
+
+type CurrencyResponseDto struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Exponent int    `json:"exponent"`
+}
+
+type CurrencyListResponseDto struct {
+	Currencies []CurrencyResponseDto `json:"currencies"`
+}
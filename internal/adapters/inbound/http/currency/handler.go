@@ -0,0 +1,27 @@
+package CurrencyAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CurrencyHandler struct {
+	service HttpPorts.CurrencyUseCase
+}
+
+func CreateCurrencyHandler(service HttpPorts.CurrencyUseCase) CurrencyHandler {
+	return CurrencyHandler{service: service}
+}
+
+func (h *CurrencyHandler) GetCurrenciesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+
+	result, err := h.service.GetCurrencies(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToCurrencyListResponseDto(result))
+}
@@ -0,0 +1,22 @@
+package CurrencyAdapter
+
+import (
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/currency/dtos"
+	ServiceDtos "autobill-service/internal/application/currency/dtos"
+)
+
+func ToCurrencyResponseDto(result *ServiceDtos.CurrencyResult) AdapterDtos.CurrencyResponseDto {
+	return AdapterDtos.CurrencyResponseDto{
+		Code:     result.Code,
+		Name:     result.Name,
+		Exponent: result.Exponent,
+	}
+}
+
+func ToCurrencyListResponseDto(result *ServiceDtos.CurrencyListResult) AdapterDtos.CurrencyListResponseDto {
+	currencies := make([]AdapterDtos.CurrencyResponseDto, len(result.Currencies))
+	for i, c := range result.Currencies {
+		currencies[i] = ToCurrencyResponseDto(&c)
+	}
+	return AdapterDtos.CurrencyListResponseDto{Currencies: currencies}
+}
@@ -0,0 +1,21 @@
+package CurrencyAdapter
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+type CurrencyRouter struct {
+	App     *fiber.App
+	handler CurrencyHandler
+}
+
+func CreateCurrencyRouter(app *fiber.App, handler CurrencyHandler) CurrencyRouter {
+	return CurrencyRouter{
+		App:     app,
+		handler: handler,
+	}
+}
+
+func (r CurrencyRouter) RegisterRoutes() {
+	r.App.Get("/", r.handler.GetCurrenciesHandler).Name("getCurrencies")
+}
@@ -0,0 +1,15 @@
+package EventDtos
+
+import "time"
+
+type EventResponseDto struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	Action     string                 `json:"action"`
+	ActorID    string                 `json:"actor_id"`
+	TargetType string                 `json:"target_type"`
+	TargetID   string                 `json:"target_id"`
+	GroupID    *string                `json:"group_id,omitempty"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+	OccurredAt time.Time              `json:"occurred_at"`
+}
@@ -0,0 +1,101 @@
+package EventAdapter
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const reconnectDelay = 3 * time.Second
+
+type EventHandler struct {
+	service   HttpPorts.EventUseCase
+	heartbeat time.Duration
+}
+
+func CreateEventHandler(service HttpPorts.EventUseCase, heartbeat time.Duration) EventHandler {
+	return EventHandler{
+		service:   service,
+		heartbeat: heartbeat,
+	}
+}
+
+func (h *EventHandler) StreamEventsHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	lastEventId := c.Get("Last-Event-ID")
+	if lastEventId == "" {
+		lastEventId = c.Query("last_event_id")
+	}
+
+	subscription, err := h.service.Subscribe(ctx, userId, lastEventId)
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	heartbeat := h.heartbeat
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer subscription.Close()
+
+		fmt.Fprintf(w, "retry: %d\n\n", reconnectDelay.Milliseconds())
+		for _, event := range subscription.Backlog {
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		ticker := time.NewTicker(heartbeat)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case event, ok := <-subscription.Events:
+				if !ok {
+					return
+				}
+				if err := writeEvent(w, event); err != nil {
+					return
+				}
+			case <-ticker.C:
+				if _, err := w.WriteString(": ping\n\n"); err != nil {
+					return
+				}
+			}
+			// A failed flush means the client has gone away.
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+func writeEvent(w *bufio.Writer, event Domain.Event) error {
+	payload, err := json.Marshal(ToEventResponseDto(event))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, payload)
+	return err
+}
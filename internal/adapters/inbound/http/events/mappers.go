@@ -0,0 +1,26 @@
+package EventAdapter
+
+import (
+	"strconv"
+
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/events/dtos"
+	Domain "autobill-service/internal/domain"
+)
+
+func ToEventResponseDto(event Domain.Event) AdapterDtos.EventResponseDto {
+	dto := AdapterDtos.EventResponseDto{
+		ID:         strconv.FormatUint(event.ID, 10),
+		Type:       string(event.Type),
+		Action:     string(event.Action),
+		ActorID:    event.ActorID.String(),
+		TargetType: string(event.TargetType),
+		TargetID:   event.TargetID.String(),
+		Data:       event.Data,
+		OccurredAt: event.OccurredAt,
+	}
+	if event.GroupID != nil {
+		groupId := event.GroupID.String()
+		dto.GroupID = &groupId
+	}
+	return dto
+}
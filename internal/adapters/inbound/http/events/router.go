@@ -0,0 +1,28 @@
+package EventAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	JWTUtil "autobill-service/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EventRouter struct {
+	App     *fiber.App
+	handler EventHandler
+	util    JWTUtil.JWTUtil
+}
+
+func CreateEventRouter(app *fiber.App, handler EventHandler, util JWTUtil.JWTUtil) EventRouter {
+	return EventRouter{
+		App:     app,
+		handler: handler,
+		util:    util,
+	}
+}
+
+func (r EventRouter) RegisterRoutes() {
+	r.App.Use(Middlewares.AuthMiddleware(r.util))
+
+	r.App.Get("/", r.handler.StreamEventsHandler).Name("streamEvents")
+}
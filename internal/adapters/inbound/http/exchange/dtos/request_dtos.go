@@ -0,0 +1,13 @@
+package ExchangeRateDtos
+
+type ExchangeRateInput struct {
+	BaseCurrency  string  `json:"base_currency" validate:"required"`
+	QuoteCurrency string  `json:"quote_currency" validate:"required"`
+	Rate          float64 `json:"rate" validate:"required,gt=0"`
+	EffectiveDate string  `json:"effective_date" validate:"required,datetime=2006-01-02"`
+}
+
+type UploadExchangeRatesRequestDto struct {
+	Source string              `json:"source" validate:"max=100"`
+	Rates  []ExchangeRateInput `json:"rates" validate:"required,min=1,dive"`
+}
@@ -0,0 +1,17 @@
+package ExchangeRateDtos
+
+import "time"
+
+type ExchangeRateResponseDto struct {
+	BaseCurrency  string    `json:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency"`
+	Rate          float64   `json:"rate"`
+	EffectiveDate string    `json:"effective_date"`
+	Source        string    `json:"source"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type ExchangeRateListResponseDto struct {
+	AsOf  time.Time                 `json:"as_of"`
+	Rates []ExchangeRateResponseDto `json:"rates"`
+}
@@ -0,0 +1,65 @@
+package ExchangeRateAdapter
+
+import (
+	"time"
+
+	ExchangeRateDtos "autobill-service/internal/adapters/inbound/http/exchange/dtos"
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ExchangeRateHandler struct {
+	service HttpPorts.ExchangeRateUseCase
+}
+
+func CreateExchangeRateHandler(service HttpPorts.ExchangeRateUseCase) ExchangeRateHandler {
+	return ExchangeRateHandler{service: service}
+}
+
+func (h *ExchangeRateHandler) GetExchangeRatesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+
+	var asOf *time.Time
+	if asOfParam := c.Query("as_of"); asOfParam != "" {
+		parsed, err := time.Parse(dateLayout, asOfParam)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidAsOfDate)
+		}
+		asOf = &parsed
+	}
+
+	result, err := h.service.GetExchangeRates(ctx, asOf)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToExchangeRateListResponseDto(result))
+}
+
+func (h *ExchangeRateHandler) UploadExchangeRatesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	reqBody := new(ExchangeRateDtos.UploadExchangeRatesRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.UploadExchangeRates(ctx, userId, ToUploadExchangeRatesInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToExchangeRateListResponseDto(result))
+}
@@ -0,0 +1,51 @@
+package ExchangeRateAdapter
+
+import (
+	"time"
+
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/exchange/dtos"
+	ServiceDtos "autobill-service/internal/application/exchange/dtos"
+)
+
+const dateLayout = "2006-01-02"
+
+func ToUploadExchangeRatesInput(dto *AdapterDtos.UploadExchangeRatesRequestDto) ServiceDtos.UploadExchangeRatesInput {
+	rates := make([]ServiceDtos.ExchangeRateInput, len(dto.Rates))
+	for i, r := range dto.Rates {
+		effectiveDate, _ := time.Parse(dateLayout, r.EffectiveDate)
+		rates[i] = ServiceDtos.ExchangeRateInput{
+			BaseCurrency:  r.BaseCurrency,
+			QuoteCurrency: r.QuoteCurrency,
+			Rate:          r.Rate,
+			EffectiveDate: effectiveDate,
+		}
+	}
+
+	return ServiceDtos.UploadExchangeRatesInput{
+		Source: dto.Source,
+		Rates:  rates,
+	}
+}
+
+func ToExchangeRateResponseDto(result *ServiceDtos.ExchangeRateResult) AdapterDtos.ExchangeRateResponseDto {
+	return AdapterDtos.ExchangeRateResponseDto{
+		BaseCurrency:  result.BaseCurrency,
+		QuoteCurrency: result.QuoteCurrency,
+		Rate:          result.Rate,
+		EffectiveDate: result.EffectiveDate.Format(dateLayout),
+		Source:        result.Source,
+		UpdatedAt:     result.UpdatedAt,
+	}
+}
+
+func ToExchangeRateListResponseDto(result *ServiceDtos.ExchangeRateListResult) AdapterDtos.ExchangeRateListResponseDto {
+	rates := make([]AdapterDtos.ExchangeRateResponseDto, len(result.Rates))
+	for i, r := range result.Rates {
+		rates[i] = ToExchangeRateResponseDto(&r)
+	}
+
+	return AdapterDtos.ExchangeRateListResponseDto{
+		AsOf:  result.AsOf,
+		Rates: rates,
+	}
+}
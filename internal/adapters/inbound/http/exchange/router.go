@@ -0,0 +1,29 @@
+package ExchangeRateAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	JWTUtil "autobill-service/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ExchangeRateRouter struct {
+	App     *fiber.App
+	handler ExchangeRateHandler
+	util    JWTUtil.JWTUtil
+}
+
+func CreateExchangeRateRouter(app *fiber.App, handler ExchangeRateHandler, util JWTUtil.JWTUtil) ExchangeRateRouter {
+	return ExchangeRateRouter{
+		App:     app,
+		handler: handler,
+		util:    util,
+	}
+}
+
+func (r ExchangeRateRouter) RegisterRoutes() {
+	r.App.Use(Middlewares.AuthMiddleware(r.util))
+
+	r.App.Get("/", r.handler.GetExchangeRatesHandler).Name("getExchangeRates")
+	r.App.Put("/", r.handler.UploadExchangeRatesHandler).Name("uploadExchangeRates")
+}
@@ -1,13 +1,15 @@
 package GroupDtos
 
 type CreateGroupRequestDto struct {
-	Name          string `json:"name" validate:"required"`
-	SimplifyDebts *bool  `json:"simplify_debts"`
+	Name               string  `json:"name" validate:"required"`
+	SimplifyDebts      *bool   `json:"simplify_debts"`
+	SettlementCurrency *string `json:"settlement_currency"`
 }
 
 type UpdateGroupRequestDto struct {
-	Name          *string `json:"name"`
-	SimplifyDebts *bool   `json:"simplify_debts"`
+	Name               *string `json:"name"`
+	SimplifyDebts      *bool   `json:"simplify_debts"`
+	SettlementCurrency *string `json:"settlement_currency"`
 }
 
 type AddMemberRequestDto struct {
@@ -15,6 +17,11 @@ type AddMemberRequestDto struct {
 	Role   string `json:"role" validate:"required,oneof=ADMIN MEMBER"`
 }
 
+type AddGuestRequestDto struct {
+	Name  string `json:"name" validate:"required,max=255"`
+	Email string `json:"email" validate:"omitempty,email"`
+}
+
 type UpdateMemberRoleRequestDto struct {
 	Role string `json:"role" validate:"required,oneof=OWNER ADMIN MEMBER"`
 }
@@ -3,25 +3,28 @@ package GroupDtos
 import "time"
 
 type GroupResponseDto struct {
-	ID            string    `json:"id"`
-	Name          string    `json:"name"`
-	SimplifyDebts bool      `json:"simplify_debts"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	SimplifyDebts      bool      `json:"simplify_debts"`
+	SettlementCurrency *string   `json:"settlement_currency"`
+	CreatedAt          time.Time `json:"created_at"`
 }
 
 type GroupDetailResponseDto struct {
-	ID            string              `json:"id"`
-	Name          string              `json:"name"`
-	SimplifyDebts bool                `json:"simplify_debts"`
-	CreatedAt     time.Time           `json:"created_at"`
-	Members       []MemberResponseDto `json:"members"`
+	ID                 string              `json:"id"`
+	Name               string              `json:"name"`
+	SimplifyDebts      bool                `json:"simplify_debts"`
+	SettlementCurrency *string             `json:"settlement_currency"`
+	CreatedAt          time.Time           `json:"created_at"`
+	Members            []MemberResponseDto `json:"members"`
 }
 
 type MemberResponseDto struct {
-	UserID string `json:"user_id"`
-	Name   string `json:"name"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
+	UserID  string `json:"user_id"`
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Role    string `json:"role"`
+	IsGuest bool   `json:"is_guest"`
 }
 
 type GroupListResponseDto struct {
@@ -31,3 +34,35 @@ type GroupListResponseDto struct {
 	TotalItems int64              `json:"total_items"`
 	TotalPages int                `json:"total_pages"`
 }
+
+type CategorySpendResponseDto struct {
+	CategoryID   *string `json:"category_id"`
+	CategoryName *string `json:"category_name"`
+	Currency     string  `json:"currency"`
+	TotalAmount  int64   `json:"total_amount"`
+	SplitCount   int64   `json:"split_count"`
+}
+
+type MemberSpendResponseDto struct {
+	UserID      string `json:"user_id"`
+	Name        string `json:"name"`
+	Currency    string `json:"currency"`
+	PaidAmount  int64  `json:"paid_amount"`
+	ShareAmount int64  `json:"share_amount"`
+}
+
+type MonthlySpendResponseDto struct {
+	Month       string `json:"month"`
+	Currency    string `json:"currency"`
+	TotalAmount int64  `json:"total_amount"`
+	SplitCount  int64  `json:"split_count"`
+}
+
+type GroupAnalyticsResponseDto struct {
+	GroupID    string                     `json:"group_id"`
+	From       *string                    `json:"from,omitempty"`
+	To         *string                    `json:"to,omitempty"`
+	ByCategory []CategorySpendResponseDto `json:"by_category"`
+	ByMember   []MemberSpendResponseDto   `json:"by_member"`
+	ByMonth    []MonthlySpendResponseDto  `json:"by_month"`
+}
@@ -77,6 +77,29 @@ func (h *GroupHandler) GetGroupHandler(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(ToGroupDetailResponseDto(result))
 }
 
+func (h *GroupHandler) GetGroupAnalyticsHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+	input, err := ToGroupAnalyticsInput(c.Query("from"), c.Query("to"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetGroupAnalytics(ctx, userId, groupId, input)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToGroupAnalyticsResponseDto(result))
+}
+
 func (h *GroupHandler) UpdateGroupHandler(c *fiber.Ctx) error {
 	ctx := Middlewares.GetContext(c)
 	userId, err := Helpers.GetUserIdFromContext(c)
@@ -151,6 +174,34 @@ func (h *GroupHandler) AddMemberHandler(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusCreated).JSON(ToMemberResponseDto(result))
 }
 
+func (h *GroupHandler) AddGuestHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(GroupDtos.AddGuestRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.AddGuest(ctx, userId, groupId, ToAddGuestInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ToMemberResponseDto(result))
+}
+
 func (h *GroupHandler) UpdateMemberRoleHandler(c *fiber.Ctx) error {
 	ctx := Middlewares.GetContext(c)
 	userId, err := Helpers.GetUserIdFromContext(c)
@@ -1,22 +1,34 @@
 package GroupAdapter
 
 import (
+	"time"
+
 	AdapterDtos "autobill-service/internal/adapters/inbound/http/group/dtos"
 	ServiceDtos "autobill-service/internal/application/group/dtos"
+	Errors "autobill-service/pkg/errors"
 	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	dateLayout  = "2006-01-02"
+	monthLayout = "2006-01"
 )
 
 func ToCreateGroupInput(dto *AdapterDtos.CreateGroupRequestDto) ServiceDtos.CreateGroupInput {
 	return ServiceDtos.CreateGroupInput{
-		Name:          dto.Name,
-		SimplifyDebts: dto.SimplifyDebts,
+		Name:               dto.Name,
+		SimplifyDebts:      dto.SimplifyDebts,
+		SettlementCurrency: dto.SettlementCurrency,
 	}
 }
 
 func ToUpdateGroupInput(dto *AdapterDtos.UpdateGroupRequestDto) ServiceDtos.UpdateGroupInput {
 	return ServiceDtos.UpdateGroupInput{
-		Name:          dto.Name,
-		SimplifyDebts: dto.SimplifyDebts,
+		Name:               dto.Name,
+		SimplifyDebts:      dto.SimplifyDebts,
+		SettlementCurrency: dto.SettlementCurrency,
 	}
 }
 
@@ -27,21 +39,30 @@ func ToAddMemberInput(dto *AdapterDtos.AddMemberRequestDto) ServiceDtos.AddMembe
 	}
 }
 
+func ToAddGuestInput(dto *AdapterDtos.AddGuestRequestDto) ServiceDtos.AddGuestInput {
+	return ServiceDtos.AddGuestInput{
+		Name:  dto.Name,
+		Email: dto.Email,
+	}
+}
+
 func ToGroupResponseDto(result *ServiceDtos.GroupResult) AdapterDtos.GroupResponseDto {
 	return AdapterDtos.GroupResponseDto{
-		ID:            result.ID,
-		Name:          result.Name,
-		SimplifyDebts: result.SimplifyDebts,
-		CreatedAt:     result.CreatedAt,
+		ID:                 result.ID,
+		Name:               result.Name,
+		SimplifyDebts:      result.SimplifyDebts,
+		SettlementCurrency: result.SettlementCurrency,
+		CreatedAt:          result.CreatedAt,
 	}
 }
 
 func ToMemberResponseDto(result *ServiceDtos.MemberResult) AdapterDtos.MemberResponseDto {
 	return AdapterDtos.MemberResponseDto{
-		UserID: result.UserID,
-		Name:   result.Name,
-		Email:  result.Email,
-		Role:   result.Role,
+		UserID:  result.UserID,
+		Name:    result.Name,
+		Email:   result.Email,
+		Role:    result.Role,
+		IsGuest: result.IsGuest,
 	}
 }
 
@@ -55,11 +76,12 @@ func ToMemberResponseDtoList(results []ServiceDtos.MemberResult) []AdapterDtos.M
 
 func ToGroupDetailResponseDto(result *ServiceDtos.GroupDetailResult) AdapterDtos.GroupDetailResponseDto {
 	return AdapterDtos.GroupDetailResponseDto{
-		ID:            result.ID,
-		Name:          result.Name,
-		SimplifyDebts: result.SimplifyDebts,
-		CreatedAt:     result.CreatedAt,
-		Members:       ToMemberResponseDtoList(result.Members),
+		ID:                 result.ID,
+		Name:               result.Name,
+		SimplifyDebts:      result.SimplifyDebts,
+		SettlementCurrency: result.SettlementCurrency,
+		CreatedAt:          result.CreatedAt,
+		Members:            ToMemberResponseDtoList(result.Members),
 	}
 }
 
@@ -80,3 +102,70 @@ func ToGroupListResponseDto(result *ServiceDtos.GroupListResult) AdapterDtos.Gro
 		TotalPages: Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
 	}
 }
+
+func ToGroupAnalyticsInput(from, to string) (ServiceDtos.GroupAnalyticsInput, error) {
+	var input ServiceDtos.GroupAnalyticsInput
+	if from != "" {
+		parsed, err := time.Parse(dateLayout, from)
+		if err != nil {
+			return input, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidDateRange)
+		}
+		input.From = &parsed
+	}
+	if to != "" {
+		parsed, err := time.Parse(dateLayout, to)
+		if err != nil {
+			return input, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidDateRange)
+		}
+		input.To = &parsed
+	}
+	return input, nil
+}
+
+func formatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format(dateLayout)
+	return &formatted
+}
+
+func ToGroupAnalyticsResponseDto(result *ServiceDtos.GroupAnalyticsResult) AdapterDtos.GroupAnalyticsResponseDto {
+	response := AdapterDtos.GroupAnalyticsResponseDto{
+		GroupID:    result.GroupID,
+		From:       formatDate(result.From),
+		To:         formatDate(result.To),
+		ByCategory: make([]AdapterDtos.CategorySpendResponseDto, len(result.ByCategory)),
+		ByMember:   make([]AdapterDtos.MemberSpendResponseDto, len(result.ByMember)),
+		ByMonth:    make([]AdapterDtos.MonthlySpendResponseDto, len(result.ByMonth)),
+	}
+
+	for i, row := range result.ByCategory {
+		response.ByCategory[i] = AdapterDtos.CategorySpendResponseDto{
+			CategoryID:   row.CategoryID,
+			CategoryName: row.CategoryName,
+			Currency:     row.Currency,
+			TotalAmount:  row.TotalAmount,
+			SplitCount:   row.SplitCount,
+		}
+	}
+	for i, row := range result.ByMember {
+		response.ByMember[i] = AdapterDtos.MemberSpendResponseDto{
+			UserID:      row.UserID,
+			Name:        row.Name,
+			Currency:    row.Currency,
+			PaidAmount:  row.PaidAmount,
+			ShareAmount: row.ShareAmount,
+		}
+	}
+	for i, row := range result.ByMonth {
+		response.ByMonth[i] = AdapterDtos.MonthlySpendResponseDto{
+			Month:       row.Month.Format(monthLayout),
+			Currency:    row.Currency,
+			TotalAmount: row.TotalAmount,
+			SplitCount:  row.SplitCount,
+		}
+	}
+
+	return response
+}
@@ -1,6 +1,9 @@
 package GroupAdapter
 
 import (
+	ActivityAdapter "autobill-service/internal/adapters/inbound/http/activity"
+	CategoryAdapter "autobill-service/internal/adapters/inbound/http/category"
+	InviteAdapter "autobill-service/internal/adapters/inbound/http/invite"
 	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
 	JWTUtil "autobill-service/pkg/jwt"
 
@@ -8,16 +11,22 @@ import (
 )
 
 type GroupRouter struct {
-	App     *fiber.App
-	handler GroupHandler
-	util    JWTUtil.JWTUtil
+	App             *fiber.App
+	handler         GroupHandler
+	activityHandler ActivityAdapter.ActivityHandler
+	inviteHandler   InviteAdapter.InviteHandler
+	categoryHandler CategoryAdapter.CategoryHandler
+	util            JWTUtil.JWTUtil
 }
 
-func CreateGroupRouter(app *fiber.App, handler GroupHandler, util JWTUtil.JWTUtil) GroupRouter {
+func CreateGroupRouter(app *fiber.App, handler GroupHandler, activityHandler ActivityAdapter.ActivityHandler, inviteHandler InviteAdapter.InviteHandler, categoryHandler CategoryAdapter.CategoryHandler, util JWTUtil.JWTUtil) GroupRouter {
 	return GroupRouter{
-		App:     app,
-		handler: handler,
-		util:    util,
+		App:             app,
+		handler:         handler,
+		activityHandler: activityHandler,
+		inviteHandler:   inviteHandler,
+		categoryHandler: categoryHandler,
+		util:            util,
 	}
 }
 
@@ -26,13 +35,25 @@ func (r GroupRouter) RegisterRoutes() {
 
 	r.App.Post("/", r.handler.CreateGroupHandler).Name("createGroup")
 	r.App.Get("/", r.handler.GetGroupsHandler).Name("getGroups")
+	r.App.Post("/join/:token", r.inviteHandler.JoinGroupHandler).Name("joinGroup")
 	r.App.Get("/:groupId", r.handler.GetGroupHandler).Name("getGroup")
 	r.App.Patch("/:groupId", r.handler.UpdateGroupHandler).Name("updateGroup")
 	r.App.Delete("/:groupId", r.handler.DeleteGroupHandler).Name("deleteGroup")
 	r.App.Post("/:groupId/leave", r.handler.LeaveGroupHandler).Name("leaveGroup")
+	r.App.Get("/:groupId/activity", r.activityHandler.GetGroupActivityHandler).Name("getGroupActivity")
+	r.App.Get("/:groupId/analytics", r.handler.GetGroupAnalyticsHandler).Name("getGroupAnalytics")
 
 	r.App.Post("/:groupId/members", r.handler.AddMemberHandler).Name("addMember")
+	r.App.Post("/:groupId/guests", r.handler.AddGuestHandler).Name("addGuest")
 	r.App.Post("/:groupId/transfer-ownership", r.handler.TransferOwnershipHandler).Name("transferOwnership")
 	r.App.Patch("/:groupId/members/:userId/role", r.handler.UpdateMemberRoleHandler).Name("updateMemberRole")
 	r.App.Delete("/:groupId/members/:userId", r.handler.RemoveMemberHandler).Name("removeMember")
+
+	r.App.Post("/:groupId/invites", r.inviteHandler.CreateInviteHandler).Name("createInvite")
+	r.App.Get("/:groupId/invites", r.inviteHandler.GetInvitesHandler).Name("getInvites")
+	r.App.Delete("/:groupId/invites/:inviteId", r.inviteHandler.RevokeInviteHandler).Name("revokeInvite")
+
+	r.App.Get("/:groupId/categories", r.categoryHandler.GetGroupCategoriesHandler).Name("getGroupCategories")
+	r.App.Post("/:groupId/categories", r.categoryHandler.CreateCategoryHandler).Name("createCategory")
+	r.App.Delete("/:groupId/categories/:categoryId", r.categoryHandler.DeleteCategoryHandler).Name("deleteCategory")
 }
@@ -0,0 +1,9 @@
+package InviteDtos
+
+type CreateInviteRequestDto struct {
+	Email          string `json:"email" validate:"omitempty,email"`
+	GuestID        string `json:"guest_id" validate:"omitempty,uuid"`
+	Role           string `json:"role" validate:"omitempty,oneof=ADMIN MEMBER"`
+	MaxUses        *int   `json:"max_uses" validate:"omitempty,min=1,max=1000"`
+	ExpiresInHours *int   `json:"expires_in_hours" validate:"omitempty,min=1,max=720"`
+}
@@ -0,0 +1,32 @@
+package InviteDtos
+
+import "time"
+
+type InviteResponseDto struct {
+	ID            string    `json:"id"`
+	GroupID       string    `json:"group_id"`
+	Email         *string   `json:"email,omitempty"`
+	GuestID       *string   `json:"guest_id,omitempty"`
+	Role          string    `json:"role"`
+	MaxUses       int       `json:"max_uses"`
+	Uses          int       `json:"uses"`
+	CreatedByID   string    `json:"created_by_id"`
+	CreatedByName string    `json:"created_by_name"`
+	Token         string    `json:"token,omitempty"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type InviteListResponseDto struct {
+	Invites    []InviteResponseDto `json:"invites"`
+	Page       int                 `json:"page"`
+	PageSize   int                 `json:"page_size"`
+	TotalItems int64               `json:"total_items"`
+	TotalPages int                 `json:"total_pages"`
+}
+
+type JoinGroupResponseDto struct {
+	GroupID   string `json:"group_id"`
+	GroupName string `json:"group_name"`
+	Role      string `json:"role"`
+}
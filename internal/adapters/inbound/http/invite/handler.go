@@ -0,0 +1,103 @@
+package InviteAdapter
+
+import (
+	InviteDtos "autobill-service/internal/adapters/inbound/http/invite/dtos"
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type InviteHandler struct {
+	service HttpPorts.InviteUseCase
+}
+
+func CreateInviteHandler(service HttpPorts.InviteUseCase) InviteHandler {
+	return InviteHandler{service: service}
+}
+
+func (h *InviteHandler) CreateInviteHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(InviteDtos.CreateInviteRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.CreateInvite(ctx, userId, groupId, ToCreateInviteInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ToInviteResponseDto(result))
+}
+
+func (h *InviteHandler) GetInvitesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+
+	pagination := Helpers.ParsePagination(c)
+	result, err := h.service.GetInvites(ctx, userId, groupId, pagination)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToInviteListResponseDto(result))
+}
+
+func (h *InviteHandler) RevokeInviteHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+	inviteId, err := Helpers.ParseUUID(c.Params("inviteId"))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.RevokeInvite(ctx, userId, groupId, inviteId); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *InviteHandler) JoinGroupHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.JoinGroup(ctx, userId, c.Params("token"))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToJoinGroupResponseDto(result))
+}
@@ -0,0 +1,57 @@
+package InviteAdapter
+
+import (
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/invite/dtos"
+	ServiceDtos "autobill-service/internal/application/invite/dtos"
+	Helpers "autobill-service/pkg/helpers"
+)
+
+func ToCreateInviteInput(dto *AdapterDtos.CreateInviteRequestDto) ServiceDtos.CreateInviteInput {
+	return ServiceDtos.CreateInviteInput{
+		Email:          dto.Email,
+		GuestID:        dto.GuestID,
+		Role:           dto.Role,
+		MaxUses:        dto.MaxUses,
+		ExpiresInHours: dto.ExpiresInHours,
+	}
+}
+
+func ToInviteResponseDto(result *ServiceDtos.InviteResult) AdapterDtos.InviteResponseDto {
+	return AdapterDtos.InviteResponseDto{
+		ID:            result.ID,
+		GroupID:       result.GroupID,
+		Email:         result.Email,
+		GuestID:       result.GuestID,
+		Role:          result.Role,
+		MaxUses:       result.MaxUses,
+		Uses:          result.Uses,
+		CreatedByID:   result.CreatedByID,
+		CreatedByName: result.CreatedByName,
+		Token:         result.Token,
+		ExpiresAt:     result.ExpiresAt,
+		CreatedAt:     result.CreatedAt,
+	}
+}
+
+func ToInviteListResponseDto(result *ServiceDtos.InviteListResult) AdapterDtos.InviteListResponseDto {
+	invites := make([]AdapterDtos.InviteResponseDto, len(result.Invites))
+	for i := range result.Invites {
+		invites[i] = ToInviteResponseDto(&result.Invites[i])
+	}
+
+	return AdapterDtos.InviteListResponseDto{
+		Invites:    invites,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
+		TotalPages: Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
+	}
+}
+
+func ToJoinGroupResponseDto(result *ServiceDtos.JoinGroupResult) AdapterDtos.JoinGroupResponseDto {
+	return AdapterDtos.JoinGroupResponseDto{
+		GroupID:   result.GroupID,
+		GroupName: result.GroupName,
+		Role:      result.Role,
+	}
+}
@@ -0,0 +1,6 @@
+package ReminderDtos
+
+type SendReminderRequestDto struct {
+	SplitID  string `json:"split_id" validate:"required"`
+	DebtorID string `json:"debtor_id" validate:"required"`
+}
@@ -0,0 +1,25 @@
+package ReminderDtos
+
+import "time"
+
+type ReminderResponseDto struct {
+	ID               string    `json:"id"`
+	SplitID          string    `json:"split_id"`
+	SplitDescription string    `json:"split_description"`
+	SenderID         *string   `json:"sender_id,omitempty"`
+	SenderName       string    `json:"sender_name,omitempty"`
+	DebtorID         string    `json:"debtor_id"`
+	DebtorName       string    `json:"debtor_name"`
+	Amount           int64     `json:"amount"`
+	Currency         string    `json:"currency"`
+	Automatic        bool      `json:"automatic"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type ReminderListResponseDto struct {
+	Reminders  []ReminderResponseDto `json:"reminders"`
+	Page       int                   `json:"page"`
+	PageSize   int                   `json:"page_size"`
+	TotalItems int64                 `json:"total_items"`
+	TotalPages int                   `json:"total_pages"`
+}
@@ -0,0 +1,64 @@
+package ReminderAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	ReminderDtos "autobill-service/internal/adapters/inbound/http/reminder/dtos"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReminderHandler struct {
+	service HttpPorts.ReminderUseCase
+}
+
+func CreateReminderHandler(service HttpPorts.ReminderUseCase) ReminderHandler {
+	return ReminderHandler{service: service}
+}
+
+func (h *ReminderHandler) SendReminderHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	reqBody := new(ReminderDtos.SendReminderRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.SendReminder(ctx, userId, ToSendReminderInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ToReminderResponseDto(result))
+}
+
+func (h *ReminderHandler) GetRemindersHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	reminderType, err := ToReminderType(c.Query("type"))
+	if err != nil {
+		return err
+	}
+
+	pagination := Helpers.ParsePagination(c)
+	result, err := h.service.GetReminders(ctx, userId, reminderType, pagination)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToReminderListResponseDto(result))
+}
@@ -0,0 +1,59 @@
+package ReminderAdapter
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/reminder/dtos"
+	ServiceDtos "autobill-service/internal/application/reminder/dtos"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+)
+
+func ToSendReminderInput(dto *AdapterDtos.SendReminderRequestDto) ServiceDtos.SendReminderInput {
+	return ServiceDtos.SendReminderInput{
+		SplitID:  dto.SplitID,
+		DebtorID: dto.DebtorID,
+	}
+}
+
+func ToReminderType(reminderTypeStr string) (ServiceDtos.ReminderType, error) {
+	reminderType := ServiceDtos.ReminderType(reminderTypeStr)
+	if reminderType == "" {
+		return "", fiber.NewError(fiber.StatusBadRequest, Errors.ErrMissingQueryParam)
+	}
+	if reminderType != ServiceDtos.ReminderTypeSent && reminderType != ServiceDtos.ReminderTypeReceived {
+		return "", fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidQueryParam)
+	}
+	return reminderType, nil
+}
+
+func ToReminderResponseDto(result *ServiceDtos.ReminderResult) AdapterDtos.ReminderResponseDto {
+	return AdapterDtos.ReminderResponseDto{
+		ID:               result.ID,
+		SplitID:          result.SplitID,
+		SplitDescription: result.SplitDescription,
+		SenderID:         result.SenderID,
+		SenderName:       result.SenderName,
+		DebtorID:         result.DebtorID,
+		DebtorName:       result.DebtorName,
+		Amount:           result.Amount,
+		Currency:         result.Currency,
+		Automatic:        result.Automatic,
+		CreatedAt:        result.CreatedAt,
+	}
+}
+
+func ToReminderListResponseDto(result *ServiceDtos.ReminderListResult) AdapterDtos.ReminderListResponseDto {
+	reminders := make([]AdapterDtos.ReminderResponseDto, len(result.Reminders))
+	for i := range result.Reminders {
+		reminders[i] = ToReminderResponseDto(&result.Reminders[i])
+	}
+
+	return AdapterDtos.ReminderListResponseDto{
+		Reminders:  reminders,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
+		TotalPages: Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
+	}
+}
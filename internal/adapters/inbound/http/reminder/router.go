@@ -0,0 +1,29 @@
+package ReminderAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	JWTUtil "autobill-service/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReminderRouter struct {
+	App     *fiber.App
+	handler ReminderHandler
+	util    JWTUtil.JWTUtil
+}
+
+func CreateReminderRouter(app *fiber.App, handler ReminderHandler, util JWTUtil.JWTUtil) ReminderRouter {
+	return ReminderRouter{
+		App:     app,
+		handler: handler,
+		util:    util,
+	}
+}
+
+func (r ReminderRouter) RegisterRoutes() {
+	r.App.Use(Middlewares.AuthMiddleware(r.util))
+
+	r.App.Post("/", r.handler.SendReminderHandler).Name("sendReminder")
+	r.App.Get("/", r.handler.GetRemindersHandler).Name("getReminders")
+}
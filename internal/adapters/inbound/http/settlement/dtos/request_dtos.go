@@ -1,9 +1,21 @@
 package SettlementDtos
 
 type CreateSettlementRequestDto struct {
-	SplitID        string `json:"split_id" validate:"required"`
+	SplitID        string `json:"split_id"`
+	GroupID        string `json:"group_id"`
 	PayeeID        string `json:"payee_id" validate:"required"`
 	Amount         int64  `json:"amount" validate:"required,gt=0"`
-	Currency       string `json:"currency" validate:"required,oneof=INR USD EUR"`
+	Currency       string `json:"currency" validate:"required,len=3"`
 	IdempotencyKey string `json:"idempotency_key" validate:"omitempty,max=64"`
 }
+
+type SettleUpRequestDto struct {
+	FromUserID string `json:"from_user_id" validate:"required"`
+	ToUserID   string `json:"to_user_id" validate:"required"`
+	Amount     int64  `json:"amount" validate:"required,gt=0"`
+	Currency   string `json:"currency" validate:"required,len=3"`
+}
+
+type SettlementStatusChangeRequestDto struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
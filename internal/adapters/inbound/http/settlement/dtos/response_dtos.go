@@ -3,16 +3,33 @@ package SettlementDtos
 import "time"
 
 type SettlementResponseDto struct {
-	ID        string    `json:"id"`
-	SplitID   string    `json:"split_id"`
-	PayerID   string    `json:"payer_id"`
-	PayerName string    `json:"payer_name"`
-	PayeeID   string    `json:"payee_id"`
-	PayeeName string    `json:"payee_name"`
-	Amount    int64     `json:"amount"`
-	Currency  string    `json:"currency"`
-	Date      time.Time `json:"date"`
-	Confirmed bool      `json:"confirmed"`
+	ID              string    `json:"id"`
+	SplitID         string    `json:"split_id,omitempty"`
+	GroupID         string    `json:"group_id,omitempty"`
+	PayerID         string    `json:"payer_id"`
+	PayerName       string    `json:"payer_name"`
+	PayeeID         string    `json:"payee_id"`
+	PayeeName       string    `json:"payee_name"`
+	Amount          int64     `json:"amount"`
+	FormattedAmount *string   `json:"formatted_amount,omitempty"`
+	Currency        string    `json:"currency"`
+	Date            time.Time `json:"date"`
+	Confirmed       bool      `json:"confirmed"`
+	Status          string    `json:"status"`
+	StatusReason    string    `json:"status_reason,omitempty"`
+	SettleUpID      string    `json:"settle_up_id,omitempty"`
+}
+
+type SettleUpResponseDto struct {
+	ID              string                  `json:"id"`
+	GroupID         string                  `json:"group_id"`
+	PayerID         string                  `json:"payer_id"`
+	PayeeID         string                  `json:"payee_id"`
+	Amount          int64                   `json:"amount"`
+	FormattedAmount *string                 `json:"formatted_amount,omitempty"`
+	Currency        string                  `json:"currency"`
+	Confirmed       bool                    `json:"confirmed"`
+	Settlements     []SettlementResponseDto `json:"settlements"`
 }
 
 type SettlementListResponseDto struct {
@@ -22,3 +39,18 @@ type SettlementListResponseDto struct {
 	TotalItems  int64                   `json:"total_items"`
 	TotalPages  int                     `json:"total_pages"`
 }
+
+type SettlementEventResponseDto struct {
+	ID         string    `json:"id"`
+	ActorID    string    `json:"actor_id"`
+	ActorName  string    `json:"actor_name"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Reason     string    `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type SettlementEventListResponseDto struct {
+	SettlementID string                       `json:"settlement_id"`
+	Events       []SettlementEventResponseDto `json:"events"`
+}
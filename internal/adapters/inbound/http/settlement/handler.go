@@ -39,7 +39,7 @@ func (h *SettlementHandler) CreateSettlementHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(ToSettlementResponseDto(result))
+	return c.Status(fiber.StatusCreated).JSON(ToSettlementResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
 
 func (h *SettlementHandler) GetPendingSettlementsHandler(c *fiber.Ctx) error {
@@ -55,7 +55,7 @@ func (h *SettlementHandler) GetPendingSettlementsHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ToSettlementListResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToSettlementListResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
 
 func (h *SettlementHandler) GetSettlementHistoryHandler(c *fiber.Ctx) error {
@@ -71,7 +71,7 @@ func (h *SettlementHandler) GetSettlementHistoryHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ToSettlementListResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToSettlementListResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
 
 func (h *SettlementHandler) ConfirmSettlementHandler(c *fiber.Ctx) error {
@@ -109,3 +109,141 @@ func (h *SettlementHandler) DeleteSettlementHandler(c *fiber.Ctx) error {
 	}
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+func (h *SettlementHandler) SettleUpHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(SettlementDtos.SettleUpRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.SettleUp(ctx, userId, groupId, ToSettleUpInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ToSettleUpResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *SettlementHandler) GetSettleUpHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	settleUpId, err := Helpers.ParseUUID(c.Params("settleUpId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetSettleUp(ctx, userId, settleUpId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToSettleUpResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *SettlementHandler) ConfirmSettleUpHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	settleUpId, err := Helpers.ParseUUID(c.Params("settleUpId"))
+	if err != nil {
+		return err
+	}
+
+	err = h.service.ConfirmSettleUp(ctx, userId, settleUpId)
+	if err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *SettlementHandler) RejectSettlementHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	settlementId, err := Helpers.ParseUUID(c.Params("settlementId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(SettlementDtos.SettlementStatusChangeRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	err = h.service.RejectSettlement(ctx, userId, settlementId, ToSettlementStatusChangeInput(reqBody))
+	if err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *SettlementHandler) DisputeSettlementHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	settlementId, err := Helpers.ParseUUID(c.Params("settlementId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(SettlementDtos.SettlementStatusChangeRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	err = h.service.DisputeSettlement(ctx, userId, settlementId, ToSettlementStatusChangeInput(reqBody))
+	if err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *SettlementHandler) GetSettlementEventsHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	settlementId, err := Helpers.ParseUUID(c.Params("settlementId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetSettlementEvents(ctx, userId, settlementId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToSettlementEventListResponseDto(result))
+}
@@ -3,12 +3,14 @@ package SettlementAdapter
 import (
 	AdapterDtos "autobill-service/internal/adapters/inbound/http/settlement/dtos"
 	ServiceDtos "autobill-service/internal/application/settlement/dtos"
+	Domain "autobill-service/internal/domain"
 	Helpers "autobill-service/pkg/helpers"
 )
 
 func ToCreateSettlementInput(dto *AdapterDtos.CreateSettlementRequestDto) ServiceDtos.CreateSettlementInput {
 	return ServiceDtos.CreateSettlementInput{
 		SplitID:        dto.SplitID,
+		GroupID:        dto.GroupID,
 		PayeeID:        dto.PayeeID,
 		Amount:         dto.Amount,
 		Currency:       dto.Currency,
@@ -16,35 +18,97 @@ func ToCreateSettlementInput(dto *AdapterDtos.CreateSettlementRequestDto) Servic
 	}
 }
 
-func ToSettlementResponseDto(result *ServiceDtos.SettlementResult) AdapterDtos.SettlementResponseDto {
+func ToSettleUpInput(dto *AdapterDtos.SettleUpRequestDto) ServiceDtos.SettleUpInput {
+	return ServiceDtos.SettleUpInput{
+		FromUserID: dto.FromUserID,
+		ToUserID:   dto.ToUserID,
+		Amount:     dto.Amount,
+		Currency:   dto.Currency,
+	}
+}
+
+func ToSettlementStatusChangeInput(dto *AdapterDtos.SettlementStatusChangeRequestDto) ServiceDtos.SettlementStatusChangeInput {
+	return ServiceDtos.SettlementStatusChangeInput{
+		Reason: dto.Reason,
+	}
+}
+
+func ToSettlementResponseDto(result *ServiceDtos.SettlementResult, formatted bool) AdapterDtos.SettlementResponseDto {
 	return AdapterDtos.SettlementResponseDto{
-		ID:        result.ID,
-		SplitID:   result.SplitID,
-		PayerID:   result.PayerID,
-		PayerName: result.PayerName,
-		PayeeID:   result.PayeeID,
-		PayeeName: result.PayeeName,
-		Amount:    result.Amount,
-		Currency:  result.Currency,
-		Date:      result.Date,
-		Confirmed: result.Confirmed,
+		ID:              result.ID,
+		SplitID:         result.SplitID,
+		GroupID:         result.GroupID,
+		PayerID:         result.PayerID,
+		PayerName:       result.PayerName,
+		PayeeID:         result.PayeeID,
+		PayeeName:       result.PayeeName,
+		Amount:          result.Amount,
+		FormattedAmount: formatAmount(formatted, result.Amount, result.Currency),
+		Currency:        result.Currency,
+		Date:            result.Date,
+		Confirmed:       result.Confirmed,
+		Status:          result.Status,
+		StatusReason:    result.StatusReason,
+		SettleUpID:      result.SettleUpID,
 	}
 }
 
-func ToSettlementResponseDtoList(results []ServiceDtos.SettlementResult) []AdapterDtos.SettlementResponseDto {
+func ToSettlementResponseDtoList(results []ServiceDtos.SettlementResult, formatted bool) []AdapterDtos.SettlementResponseDto {
 	settlements := make([]AdapterDtos.SettlementResponseDto, len(results))
 	for i, s := range results {
-		settlements[i] = ToSettlementResponseDto(&s)
+		settlements[i] = ToSettlementResponseDto(&s, formatted)
 	}
 	return settlements
 }
 
-func ToSettlementListResponseDto(result *ServiceDtos.SettlementListResult) AdapterDtos.SettlementListResponseDto {
+func ToSettlementListResponseDto(result *ServiceDtos.SettlementListResult, formatted bool) AdapterDtos.SettlementListResponseDto {
 	return AdapterDtos.SettlementListResponseDto{
-		Settlements: ToSettlementResponseDtoList(result.Settlements),
+		Settlements: ToSettlementResponseDtoList(result.Settlements, formatted),
 		Page:        result.Page,
 		PageSize:    result.PageSize,
 		TotalItems:  result.TotalItems,
 		TotalPages:  Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
 	}
 }
+
+func ToSettleUpResponseDto(result *ServiceDtos.SettleUpResult, formatted bool) AdapterDtos.SettleUpResponseDto {
+	return AdapterDtos.SettleUpResponseDto{
+		ID:              result.ID,
+		GroupID:         result.GroupID,
+		PayerID:         result.PayerID,
+		PayeeID:         result.PayeeID,
+		Amount:          result.Amount,
+		FormattedAmount: formatAmount(formatted, result.Amount, result.Currency),
+		Currency:        result.Currency,
+		Confirmed:       result.Confirmed,
+		Settlements:     ToSettlementResponseDtoList(result.Settlements, formatted),
+	}
+}
+
+func ToSettlementEventListResponseDto(result *ServiceDtos.SettlementEventListResult) AdapterDtos.SettlementEventListResponseDto {
+	events := make([]AdapterDtos.SettlementEventResponseDto, len(result.Events))
+	for i, e := range result.Events {
+		events[i] = AdapterDtos.SettlementEventResponseDto{
+			ID:         e.ID,
+			ActorID:    e.ActorID,
+			ActorName:  e.ActorName,
+			FromStatus: e.FromStatus,
+			ToStatus:   e.ToStatus,
+			Reason:     e.Reason,
+			CreatedAt:  e.CreatedAt,
+		}
+	}
+
+	return AdapterDtos.SettlementEventListResponseDto{
+		SettlementID: result.SettlementID,
+		Events:       events,
+	}
+}
+
+func formatAmount(formatted bool, amount int64, currency string) *string {
+	if !formatted {
+		return nil
+	}
+	value := Domain.FormatAmount(amount, Domain.Currency(currency))
+	return &value
+}
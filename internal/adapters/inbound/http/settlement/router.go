@@ -1,6 +1,7 @@
 package SettlementAdapter
 
 import (
+	CommentAdapter "autobill-service/internal/adapters/inbound/http/comment"
 	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
 	JWTUtil "autobill-service/pkg/jwt"
 
@@ -8,16 +9,18 @@ import (
 )
 
 type SettlementRouter struct {
-	App     *fiber.App
-	handler SettlementHandler
-	util    JWTUtil.JWTUtil
+	App            *fiber.App
+	handler        SettlementHandler
+	commentHandler CommentAdapter.CommentHandler
+	util           JWTUtil.JWTUtil
 }
 
-func CreateSettlementRouter(app *fiber.App, handler SettlementHandler, util JWTUtil.JWTUtil) SettlementRouter {
+func CreateSettlementRouter(app *fiber.App, handler SettlementHandler, commentHandler CommentAdapter.CommentHandler, util JWTUtil.JWTUtil) SettlementRouter {
 	return SettlementRouter{
-		App:     app,
-		handler: handler,
-		util:    util,
+		App:            app,
+		handler:        handler,
+		commentHandler: commentHandler,
+		util:           util,
 	}
 }
 
@@ -28,5 +31,15 @@ func (r SettlementRouter) RegisterRoutes() {
 	r.App.Get("/pending", r.handler.GetPendingSettlementsHandler).Name("getPendingSettlements")
 	r.App.Get("/history", r.handler.GetSettlementHistoryHandler).Name("getSettlementHistory")
 	r.App.Post("/:settlementId/confirm", r.handler.ConfirmSettlementHandler).Name("confirmSettlement")
+	r.App.Post("/:settlementId/reject", r.handler.RejectSettlementHandler).Name("rejectSettlement")
+	r.App.Post("/:settlementId/dispute", r.handler.DisputeSettlementHandler).Name("disputeSettlement")
+	r.App.Get("/:settlementId/events", r.handler.GetSettlementEventsHandler).Name("getSettlementEvents")
+	r.App.Get("/:settlementId/comments", r.commentHandler.GetCommentsHandler).Name("getSettlementComments")
+	r.App.Post("/:settlementId/comments", r.commentHandler.CreateCommentHandler).Name("createSettlementComment")
+	r.App.Patch("/:settlementId/comments/:commentId", r.commentHandler.UpdateCommentHandler).Name("updateSettlementComment")
+	r.App.Delete("/:settlementId/comments/:commentId", r.commentHandler.DeleteCommentHandler).Name("deleteSettlementComment")
 	r.App.Delete("/:settlementId", r.handler.DeleteSettlementHandler).Name("deleteSettlement")
+	r.App.Post("/groups/:groupId/settle-up", r.handler.SettleUpHandler).Name("settleUp")
+	r.App.Get("/settle-ups/:settleUpId", r.handler.GetSettleUpHandler).Name("getSettleUp")
+	r.App.Post("/settle-ups/:settleUpId/confirm", r.handler.ConfirmSettleUpHandler).Name("confirmSettleUp")
 }
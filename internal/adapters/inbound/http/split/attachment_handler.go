@@ -0,0 +1,123 @@
+package SplitAdapter
+
+import (
+	"mime"
+
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	ServiceDtos "autobill-service/internal/application/split/dtos"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SplitAttachmentHandler struct {
+	service HttpPorts.SplitAttachmentUseCase
+}
+
+func CreateSplitAttachmentHandler(service HttpPorts.SplitAttachmentUseCase) SplitAttachmentHandler {
+	return SplitAttachmentHandler{service: service}
+}
+
+func (h *SplitAttachmentHandler) UploadAttachmentHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	splitId, err := Helpers.ParseUUID(c.Params("splitId"))
+	if err != nil {
+		return err
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrAttachmentMissing)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrAttachmentMissing)
+	}
+	defer file.Close()
+
+	result, err := h.service.UploadAttachment(ctx, userId, splitId, ServiceDtos.UploadAttachmentInput{
+		FileName: fileHeader.Filename,
+		Size:     fileHeader.Size,
+		Content:  file,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ToAttachmentResponseDto(result))
+}
+
+func (h *SplitAttachmentHandler) GetAttachmentsHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	splitId, err := Helpers.ParseUUID(c.Params("splitId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetAttachments(ctx, userId, splitId)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(ToAttachmentListResponseDto(result))
+}
+
+// DownloadAttachmentHandler streams the file; fasthttp closes the content once
+// the response has been written.
+func (h *SplitAttachmentHandler) DownloadAttachmentHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	splitId, err := Helpers.ParseUUID(c.Params("splitId"))
+	if err != nil {
+		return err
+	}
+	attachmentId, err := Helpers.ParseUUID(c.Params("attachmentId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetAttachmentContent(ctx, userId, splitId, attachmentId)
+	if err != nil {
+		return err
+	}
+
+	attachment := result.Attachment
+	c.Set(fiber.HeaderContentType, attachment.ContentType)
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	c.Set(fiber.HeaderETag, `"`+attachment.Checksum+`"`)
+	c.Set("X-Content-Type-Options", "nosniff")
+	return c.Status(fiber.StatusOK).SendStream(result.Content, int(attachment.Size))
+}
+
+func (h *SplitAttachmentHandler) DeleteAttachmentHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	splitId, err := Helpers.ParseUUID(c.Params("splitId"))
+	if err != nil {
+		return err
+	}
+	attachmentId, err := Helpers.ParseUUID(c.Params("attachmentId"))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteAttachment(ctx, userId, splitId, attachmentId); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -1,18 +1,78 @@
 package SplitDtos
 
+import "time"
+
 type ParticipantInput struct {
-	UserID      string `json:"user_id" validate:"required"`
-	ShareAmount int64  `json:"share_amount"`
+	UserID      string  `json:"user_id" validate:"required"`
+	ShareAmount int64   `json:"share_amount"`
+	Percentage  float64 `json:"percentage" validate:"omitempty,gt=0,lte=100"`
+	Shares      int64   `json:"shares" validate:"omitempty,gt=0"`
+}
+
+type PayerInput struct {
+	UserID     string `json:"user_id" validate:"required"`
+	PaidAmount int64  `json:"paid_amount" validate:"required,gt=0"`
+}
+
+type ItemInput struct {
+	Name            string   `json:"name" validate:"required,max=200"`
+	Amount          int64    `json:"amount" validate:"required,gt=0"`
+	Quantity        int64    `json:"quantity" validate:"omitempty,gt=0"`
+	AssignedUserIDs []string `json:"assigned_user_ids" validate:"required,min=1"`
 }
 
 type CreateSplitRequestDto struct {
 	Type           string             `json:"type" validate:"required,oneof=GROUP DIRECT"`
-	DivisionType   string             `json:"division_type" validate:"required,oneof=EQUAL CUSTOM"`
+	DivisionType   string             `json:"division_type" validate:"required,oneof=EQUAL CUSTOM PERCENTAGE SHARES ITEMIZED"`
 	TotalAmount    int64              `json:"total_amount" validate:"required,gt=0"`
-	Currency       string             `json:"currency" validate:"required,oneof=INR USD EUR"`
+	Currency       string             `json:"currency" validate:"required,len=3"`
 	Description    string             `json:"description"`
 	GroupID        string             `json:"group_id"`
+	CategoryID     string             `json:"category_id" validate:"omitempty,uuid"`
 	SimplifyDebts  *bool              `json:"simplify_debts"`
 	IdempotencyKey string             `json:"idempotency_key" validate:"omitempty,max=64"`
+	Payers         []PayerInput       `json:"payers" validate:"omitempty,dive"`
 	Participants   []ParticipantInput `json:"participants" validate:"required,min=1"`
+
+	Items               []ItemInput `json:"items" validate:"omitempty,dive"`
+	TaxAmount           int64       `json:"tax_amount" validate:"gte=0"`
+	TipAmount           int64       `json:"tip_amount" validate:"gte=0"`
+	ServiceChargeAmount int64       `json:"service_charge_amount" validate:"gte=0"`
+}
+
+type UpdateSplitRequestDto struct {
+	Description  *string            `json:"description"`
+	CategoryID   *string            `json:"category_id" validate:"omitempty,uuid|len=0"`
+	TotalAmount  *int64             `json:"total_amount" validate:"omitempty,gt=0"`
+	DivisionType *string            `json:"division_type" validate:"omitempty,oneof=EQUAL CUSTOM PERCENTAGE SHARES ITEMIZED"`
+	Payers       []PayerInput       `json:"payers" validate:"omitempty,min=1,dive"`
+	Participants []ParticipantInput `json:"participants" validate:"omitempty,min=1,dive"`
+
+	Items               []ItemInput `json:"items" validate:"omitempty,dive"`
+	TaxAmount           *int64      `json:"tax_amount" validate:"omitempty,gte=0"`
+	TipAmount           *int64      `json:"tip_amount" validate:"omitempty,gte=0"`
+	ServiceChargeAmount *int64      `json:"service_charge_amount" validate:"omitempty,gte=0"`
+}
+
+type CreateRecurringSplitRequestDto struct {
+	Type           string             `json:"type" validate:"required,oneof=GROUP DIRECT"`
+	DivisionType   string             `json:"division_type" validate:"required,oneof=EQUAL CUSTOM PERCENTAGE SHARES"`
+	TotalAmount    int64              `json:"total_amount" validate:"required,gt=0"`
+	Currency       string             `json:"currency" validate:"required,len=3"`
+	Description    string             `json:"description"`
+	GroupID        string             `json:"group_id"`
+	SimplifyDebts  *bool              `json:"simplify_debts"`
+	Cadence        string             `json:"cadence" validate:"required,oneof=DAILY WEEKLY MONTHLY CRON"`
+	CronExpression string             `json:"cron_expression" validate:"required_if=Cadence CRON,max=100"`
+	StartDate      time.Time          `json:"start_date" validate:"required"`
+	EndDate        *time.Time         `json:"end_date"`
+	Participants   []ParticipantInput `json:"participants" validate:"required,min=1,dive"`
+}
+
+type UpdateRecurringSplitRequestDto struct {
+	Description  *string            `json:"description"`
+	TotalAmount  *int64             `json:"total_amount" validate:"omitempty,gt=0"`
+	EndDate      *time.Time         `json:"end_date"`
+	IsActive     *bool              `json:"is_active"`
+	Participants []ParticipantInput `json:"participants" validate:"omitempty,min=1,dive"`
 }
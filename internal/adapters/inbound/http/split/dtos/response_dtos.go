@@ -3,25 +3,54 @@ package SplitDtos
 import "time"
 
 type ParticipantResponseDto struct {
-	UserID      string `json:"user_id"`
-	UserName    string `json:"user_name"`
-	ShareAmount int64  `json:"share_amount"`
-	Currency    string `json:"currency"`
-	IsSettled   bool   `json:"is_settled"`
+	UserID                 string  `json:"user_id"`
+	UserName               string  `json:"user_name"`
+	ShareAmount            int64   `json:"share_amount"`
+	FormattedShareAmount   *string `json:"formatted_share_amount,omitempty"`
+	SettledAmount          int64   `json:"settled_amount"`
+	FormattedSettledAmount *string `json:"formatted_settled_amount,omitempty"`
+	Currency               string  `json:"currency"`
+	IsSettled              bool    `json:"is_settled"`
+}
+
+type PayerResponseDto struct {
+	UserID              string  `json:"user_id"`
+	UserName            string  `json:"user_name"`
+	PaidAmount          int64   `json:"paid_amount"`
+	FormattedPaidAmount *string `json:"formatted_paid_amount,omitempty"`
+	Currency            string  `json:"currency"`
+}
+
+type ItemResponseDto struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Amount          int64    `json:"amount"`
+	FormattedAmount *string  `json:"formatted_amount,omitempty"`
+	Quantity        int64    `json:"quantity"`
+	AssignedUserIDs []string `json:"assigned_user_ids"`
 }
 
 type SplitResponseDto struct {
-	ID            string                   `json:"id"`
-	Type          string                   `json:"type"`
-	DivisionType  string                   `json:"division_type"`
-	TotalAmount   int64                    `json:"total_amount"`
-	Currency      string                   `json:"currency"`
-	Description   string                   `json:"description"`
-	GroupID       string                   `json:"group_id,omitempty"`
-	CreatedByID   string                   `json:"created_by_id"`
-	CreatedAt     time.Time                `json:"created_at"`
-	SimplifyDebts *bool                    `json:"simplify_debts"`
-	Participants  []ParticipantResponseDto `json:"participants"`
+	ID                   string                   `json:"id"`
+	Type                 string                   `json:"type"`
+	DivisionType         string                   `json:"division_type"`
+	TotalAmount          int64                    `json:"total_amount"`
+	FormattedTotalAmount *string                  `json:"formatted_total_amount,omitempty"`
+	Currency             string                   `json:"currency"`
+	Description          string                   `json:"description"`
+	GroupID              string                   `json:"group_id,omitempty"`
+	CategoryID           string                   `json:"category_id,omitempty"`
+	CategoryName         string                   `json:"category_name,omitempty"`
+	CreatedByID          string                   `json:"created_by_id"`
+	CreatedAt            time.Time                `json:"created_at"`
+	SimplifyDebts        *bool                    `json:"simplify_debts"`
+	Payers               []PayerResponseDto       `json:"payers"`
+	Participants         []ParticipantResponseDto `json:"participants"`
+
+	Items               []ItemResponseDto `json:"items"`
+	TaxAmount           int64             `json:"tax_amount"`
+	TipAmount           int64             `json:"tip_amount"`
+	ServiceChargeAmount int64             `json:"service_charge_amount"`
 }
 
 type SplitListResponseDto struct {
@@ -31,3 +60,110 @@ type SplitListResponseDto struct {
 	TotalItems int64              `json:"total_items"`
 	TotalPages int                `json:"total_pages"`
 }
+
+type RecurringParticipantResponseDto struct {
+	UserID               string  `json:"user_id"`
+	UserName             string  `json:"user_name"`
+	ShareAmount          int64   `json:"share_amount"`
+	FormattedShareAmount *string `json:"formatted_share_amount,omitempty"`
+	Percentage           float64 `json:"percentage"`
+	Shares               int64   `json:"shares"`
+}
+
+type RecurringSplitResponseDto struct {
+	ID                   string                            `json:"id"`
+	Type                 string                            `json:"type"`
+	DivisionType         string                            `json:"division_type"`
+	TotalAmount          int64                             `json:"total_amount"`
+	FormattedTotalAmount *string                           `json:"formatted_total_amount,omitempty"`
+	Currency             string                            `json:"currency"`
+	Description          string                            `json:"description"`
+	GroupID              string                            `json:"group_id,omitempty"`
+	CreatedByID          string                            `json:"created_by_id"`
+	SimplifyDebts        *bool                             `json:"simplify_debts"`
+	Cadence              string                            `json:"cadence"`
+	CronExpression       string                            `json:"cron_expression,omitempty"`
+	StartDate            time.Time                         `json:"start_date"`
+	EndDate              *time.Time                        `json:"end_date,omitempty"`
+	NextRunAt            time.Time                         `json:"next_run_at"`
+	LastRunAt            *time.Time                        `json:"last_run_at,omitempty"`
+	IsActive             bool                              `json:"is_active"`
+	CreatedAt            time.Time                         `json:"created_at"`
+	Participants         []RecurringParticipantResponseDto `json:"participants"`
+}
+
+type RecurringSplitListResponseDto struct {
+	RecurringSplits []RecurringSplitResponseDto `json:"recurring_splits"`
+	Page            int                         `json:"page"`
+	PageSize        int                         `json:"page_size"`
+	TotalItems      int64                       `json:"total_items"`
+	TotalPages      int                         `json:"total_pages"`
+}
+
+type AttachmentResponseDto struct {
+	ID             string    `json:"id"`
+	SplitID        string    `json:"split_id"`
+	FileName       string    `json:"file_name"`
+	ContentType    string    `json:"content_type"`
+	Size           int64     `json:"size"`
+	Checksum       string    `json:"checksum"`
+	UploadedByID   string    `json:"uploaded_by_id"`
+	UploadedByName string    `json:"uploaded_by_name"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type AttachmentListResponseDto struct {
+	Attachments []AttachmentResponseDto `json:"attachments"`
+}
+
+// ExportRecordDto is one line of an export. Amounts are decimal strings in
+// the record's currency.
+type ExportRecordDto struct {
+	RecordType        string  `json:"record_type"`
+	Date              *string `json:"date,omitempty"`
+	GroupID           *string `json:"group_id,omitempty"`
+	GroupName         *string `json:"group_name,omitempty"`
+	SplitID           *string `json:"split_id,omitempty"`
+	SettlementID      *string `json:"settlement_id,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	Category          *string `json:"category,omitempty"`
+	Currency          string  `json:"currency"`
+	TotalAmount       *string `json:"total_amount,omitempty"`
+	UserID            string  `json:"user_id"`
+	UserName          string  `json:"user_name"`
+	UserEmail         string  `json:"user_email"`
+	CounterpartyID    *string `json:"counterparty_id,omitempty"`
+	CounterpartyName  *string `json:"counterparty_name,omitempty"`
+	CounterpartyEmail *string `json:"counterparty_email,omitempty"`
+	PaidAmount        *string `json:"paid_amount,omitempty"`
+	ShareAmount       *string `json:"share_amount,omitempty"`
+	SettledAmount     *string `json:"settled_amount,omitempty"`
+	IsSettled         *bool   `json:"is_settled,omitempty"`
+	Amount            *string `json:"amount,omitempty"`
+	Status            *string `json:"status,omitempty"`
+	Confirmed         *bool   `json:"confirmed,omitempty"`
+	ConfirmedAt       *string `json:"confirmed_at,omitempty"`
+}
+
+type ImportRowResponseDto struct {
+	Line        int      `json:"line"`
+	Status      string   `json:"status"`
+	Date        *string  `json:"date,omitempty"`
+	Description string   `json:"description"`
+	Amount      int64    `json:"amount"`
+	Currency    string   `json:"currency"`
+	SplitID     *string  `json:"split_id,omitempty"`
+	Errors      []string `json:"errors,omitempty"`
+}
+
+type ImportResultResponseDto struct {
+	Format       string                 `json:"format"`
+	DryRun       bool                   `json:"dry_run"`
+	TotalRows    int                    `json:"total_rows"`
+	ValidRows    int                    `json:"valid_rows"`
+	CreatedRows  int                    `json:"created_rows"`
+	ExistingRows int                    `json:"existing_rows"`
+	InvalidRows  int                    `json:"invalid_rows"`
+	FailedRows   int                    `json:"failed_rows"`
+	Rows         []ImportRowResponseDto `json:"rows"`
+}
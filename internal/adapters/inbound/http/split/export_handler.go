@@ -0,0 +1,248 @@
+package SplitAdapter
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/split/dtos"
+	ServiceDtos "autobill-service/internal/application/split/dtos"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	exportTimeout    = 10 * time.Minute
+	exportFlushEvery = 500
+)
+
+var exportColumns = []string{
+	"record_type", "date", "group_id", "group_name", "split_id", "settlement_id", "description", "category",
+	"currency", "total_amount", "user_id", "user_name", "user_email",
+	"counterparty_id", "counterparty_name", "counterparty_email",
+	"paid_amount", "share_amount", "settled_amount", "is_settled",
+	"amount", "status", "confirmed", "confirmed_at",
+}
+
+type SplitExportHandler struct {
+	service HttpPorts.SplitExportUseCase
+}
+
+func CreateSplitExportHandler(service HttpPorts.SplitExportUseCase) SplitExportHandler {
+	return SplitExportHandler{service: service}
+}
+
+func (h *SplitExportHandler) ExportMySplitsHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	format, err := toExportFormat(c.Query("format"))
+	if err != nil {
+		return err
+	}
+	input, err := ToExportInput(c.Query("from"), c.Query("to"))
+	if err != nil {
+		return err
+	}
+
+	stream, err := h.service.ExportUserData(ctx, userId, input)
+	if err != nil {
+		return err
+	}
+
+	return sendExport(ctx, c, stream, format)
+}
+
+func (h *SplitExportHandler) ExportGroupSplitsHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+	format, err := toExportFormat(c.Query("format"))
+	if err != nil {
+		return err
+	}
+	input, err := ToExportInput(c.Query("from"), c.Query("to"))
+	if err != nil {
+		return err
+	}
+
+	stream, err := h.service.ExportGroupData(ctx, userId, groupId, input)
+	if err != nil {
+		return err
+	}
+
+	return sendExport(ctx, c, stream, format)
+}
+
+type exportFormat struct {
+	contentType string
+	extension   string
+	newEncoder  func(w *bufio.Writer) exportEncoder
+}
+
+type exportEncoder interface {
+	Encode(record AdapterDtos.ExportRecordDto) error
+	Flush() error
+}
+
+var exportFormats = map[string]exportFormat{
+	"csv": {
+		contentType: "text/csv; charset=utf-8",
+		extension:   ".csv",
+		newEncoder:  func(w *bufio.Writer) exportEncoder { return &csvEncoder{writer: csv.NewWriter(w)} },
+	},
+	"jsonl": {
+		contentType: "application/x-ndjson",
+		extension:   ".jsonl",
+		newEncoder:  func(w *bufio.Writer) exportEncoder { return &jsonLinesEncoder{encoder: json.NewEncoder(w)} },
+	},
+}
+
+func toExportFormat(format string) (exportFormat, error) {
+	if format == "" {
+		format = "csv"
+	}
+	selected, ok := exportFormats[format]
+	if !ok {
+		return selected, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidExportFormat)
+	}
+	return selected, nil
+}
+
+// sendExport streams the records as the response body. The body is written
+// after the handler has returned, when the timeout middleware has already
+// cancelled the request context, so the stream gets a context of its own.
+// Errors past that point can no longer change the status; the export is cut
+// short and the error logged.
+func sendExport(ctx context.Context, c *fiber.Ctx, stream *ServiceDtos.ExportStream, format exportFormat) error {
+	c.Set(fiber.HeaderContentType, format.contentType)
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{
+		"filename": stream.FileName + format.extension,
+	}))
+	c.Set(fiber.HeaderCacheControl, "no-store")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		streamCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), exportTimeout)
+		defer cancel()
+
+		encoder := format.newEncoder(w)
+		count := 0
+		err := stream.Write(streamCtx, func(record *ServiceDtos.ExportRecord) error {
+			if err := encoder.Encode(ToExportRecordDto(record)); err != nil {
+				return err
+			}
+			count++
+			if count%exportFlushEvery != 0 {
+				return nil
+			}
+			if err := encoder.Flush(); err != nil {
+				return err
+			}
+			// A failed flush means the client has gone away.
+			return w.Flush()
+		})
+		if err == nil {
+			if err = encoder.Flush(); err == nil {
+				err = w.Flush()
+			}
+		}
+		if err != nil {
+			Logger.Warn().
+				Err(err).
+				Str("operation", "Export").
+				Int("records", count).
+				Msg("Export stream ended early")
+		}
+	})
+
+	return nil
+}
+
+type csvEncoder struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (e *csvEncoder) Encode(record AdapterDtos.ExportRecordDto) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	return e.writer.Write([]string{
+		record.RecordType, csvString(record.Date), csvString(record.GroupID), csvText(csvString(record.GroupName)),
+		csvString(record.SplitID), csvString(record.SettlementID), csvText(csvString(record.Description)), csvText(csvString(record.Category)),
+		record.Currency, csvString(record.TotalAmount), record.UserID, csvText(record.UserName), csvText(record.UserEmail),
+		csvString(record.CounterpartyID), csvText(csvString(record.CounterpartyName)), csvText(csvString(record.CounterpartyEmail)),
+		csvString(record.PaidAmount), csvString(record.ShareAmount), csvString(record.SettledAmount), csvBool(record.IsSettled),
+		csvString(record.Amount), csvString(record.Status), csvBool(record.Confirmed), csvString(record.ConfirmedAt),
+	})
+}
+
+// Flush writes the header even when there were no records, so an empty
+// export is still a valid CSV file.
+func (e *csvEncoder) Flush() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+	e.headerWritten = true
+	return e.writer.Write(exportColumns)
+}
+
+type jsonLinesEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *jsonLinesEncoder) Encode(record AdapterDtos.ExportRecordDto) error {
+	return e.encoder.Encode(record)
+}
+
+func (e *jsonLinesEncoder) Flush() error {
+	return nil
+}
+
+func csvString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// csvText stops spreadsheets from evaluating user-entered text as a formula.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func csvBool(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*value)
+}
@@ -39,7 +39,7 @@ func (h *SplitHandler) CreateSplitHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(ToSplitResponseDto(result))
+	return c.Status(fiber.StatusCreated).JSON(ToSplitResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
 
 func (h *SplitHandler) GetSplitHandler(c *fiber.Ctx) error {
@@ -57,7 +57,7 @@ func (h *SplitHandler) GetSplitHandler(c *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
-	return c.Status(fiber.StatusOK).JSON(ToSplitResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToSplitResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
 
 func (h *SplitHandler) GetGroupSplitsHandler(c *fiber.Ctx) error {
@@ -77,7 +77,7 @@ func (h *SplitHandler) GetGroupSplitsHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ToSplitListResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToSplitListResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
 
 func (h *SplitHandler) GetMySplitsHandler(c *fiber.Ctx) error {
@@ -93,7 +93,34 @@ func (h *SplitHandler) GetMySplitsHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ToSplitListResponseDto(result))
+	return c.Status(fiber.StatusOK).JSON(ToSplitListResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *SplitHandler) UpdateSplitHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	splitId, err := Helpers.ParseUUID(c.Params("splitId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(SplitDtos.UpdateSplitRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.UpdateSplit(ctx, userId, splitId, ToUpdateSplitInput(reqBody))
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(ToSplitResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
 
 func (h *SplitHandler) ReverseSplitHandler(c *fiber.Ctx) error {
@@ -111,5 +138,5 @@ func (h *SplitHandler) ReverseSplitHandler(c *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
-	return c.Status(fiber.StatusCreated).JSON(ToSplitResponseDto(result))
+	return c.Status(fiber.StatusCreated).JSON(ToSplitResponseDto(result, Helpers.WantsFormattedAmounts(c)))
 }
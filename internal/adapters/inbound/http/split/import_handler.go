@@ -0,0 +1,56 @@
+package SplitAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	ServiceDtos "autobill-service/internal/application/split/dtos"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SplitImportHandler struct {
+	service HttpPorts.SplitImportUseCase
+}
+
+func CreateSplitImportHandler(service HttpPorts.SplitImportUseCase) SplitImportHandler {
+	return SplitImportHandler{service: service}
+}
+
+func (h *SplitImportHandler) ImportSplitsHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	groupId, err := Helpers.ParseUUID(c.Params("groupId"))
+	if err != nil {
+		return err
+	}
+	members, err := ToImportMembers(c.FormValue("members"))
+	if err != nil {
+		return err
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrImportFileMissing)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrImportFileMissing)
+	}
+	defer file.Close()
+
+	result, err := h.service.ImportSplits(ctx, userId, groupId, ServiceDtos.ImportSplitsInput{
+		Content: file,
+		Members: members,
+		DryRun:  c.QueryBool("dry_run"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToImportResultResponseDto(result))
+}
@@ -1,22 +1,57 @@
 package SplitAdapter
 
 import (
+	"encoding/json"
+	"time"
+
 	AdapterDtos "autobill-service/internal/adapters/inbound/http/split/dtos"
 	ServiceDtos "autobill-service/internal/application/split/dtos"
+	Domain "autobill-service/internal/domain"
+	Errors "autobill-service/pkg/errors"
 	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
 )
 
+const dateLayout = "2006-01-02"
+
 func ToParticipantInputList(dtos []AdapterDtos.ParticipantInput) []ServiceDtos.ParticipantInput {
 	participants := make([]ServiceDtos.ParticipantInput, len(dtos))
 	for i, p := range dtos {
 		participants[i] = ServiceDtos.ParticipantInput{
 			UserID:      p.UserID,
 			ShareAmount: p.ShareAmount,
+			Percentage:  p.Percentage,
+			Shares:      p.Shares,
 		}
 	}
 	return participants
 }
 
+func ToPayerInputList(dtos []AdapterDtos.PayerInput) []ServiceDtos.PayerInput {
+	payers := make([]ServiceDtos.PayerInput, len(dtos))
+	for i, p := range dtos {
+		payers[i] = ServiceDtos.PayerInput{
+			UserID:     p.UserID,
+			PaidAmount: p.PaidAmount,
+		}
+	}
+	return payers
+}
+
+func ToItemInputList(dtos []AdapterDtos.ItemInput) []ServiceDtos.ItemInput {
+	items := make([]ServiceDtos.ItemInput, len(dtos))
+	for i, item := range dtos {
+		items[i] = ServiceDtos.ItemInput{
+			Name:            item.Name,
+			Amount:          item.Amount,
+			Quantity:        item.Quantity,
+			AssignedUserIDs: item.AssignedUserIDs,
+		}
+	}
+	return items
+}
+
 func ToCreateSplitInput(dto *AdapterDtos.CreateSplitRequestDto) ServiceDtos.CreateSplitInput {
 	return ServiceDtos.CreateSplitInput{
 		Type:           dto.Type,
@@ -25,60 +60,350 @@ func ToCreateSplitInput(dto *AdapterDtos.CreateSplitRequestDto) ServiceDtos.Crea
 		Currency:       dto.Currency,
 		Description:    dto.Description,
 		GroupID:        dto.GroupID,
+		CategoryID:     dto.CategoryID,
 		SimplifyDebts:  dto.SimplifyDebts,
 		IdempotencyKey: dto.IdempotencyKey,
+		Payers:         ToPayerInputList(dto.Payers),
 		Participants:   ToParticipantInputList(dto.Participants),
+
+		Items:               ToItemInputList(dto.Items),
+		TaxAmount:           dto.TaxAmount,
+		TipAmount:           dto.TipAmount,
+		ServiceChargeAmount: dto.ServiceChargeAmount,
 	}
 }
 
-func ToParticipantResponseDto(result *ServiceDtos.ParticipantResult) AdapterDtos.ParticipantResponseDto {
+func ToUpdateSplitInput(dto *AdapterDtos.UpdateSplitRequestDto) ServiceDtos.UpdateSplitInput {
+	input := ServiceDtos.UpdateSplitInput{
+		Description:         dto.Description,
+		CategoryID:          dto.CategoryID,
+		TotalAmount:         dto.TotalAmount,
+		DivisionType:        dto.DivisionType,
+		TaxAmount:           dto.TaxAmount,
+		TipAmount:           dto.TipAmount,
+		ServiceChargeAmount: dto.ServiceChargeAmount,
+	}
+	if dto.Payers != nil {
+		input.Payers = ToPayerInputList(dto.Payers)
+	}
+	if dto.Participants != nil {
+		input.Participants = ToParticipantInputList(dto.Participants)
+	}
+	if dto.Items != nil {
+		input.Items = ToItemInputList(dto.Items)
+	}
+	return input
+}
+
+func ToParticipantResponseDto(result *ServiceDtos.ParticipantResult, formatted bool) AdapterDtos.ParticipantResponseDto {
 	return AdapterDtos.ParticipantResponseDto{
-		UserID:      result.UserID,
-		UserName:    result.UserName,
-		ShareAmount: result.ShareAmount,
-		Currency:    result.Currency,
-		IsSettled:   result.IsSettled,
+		UserID:                 result.UserID,
+		UserName:               result.UserName,
+		ShareAmount:            result.ShareAmount,
+		FormattedShareAmount:   formatAmount(formatted, result.ShareAmount, result.Currency),
+		SettledAmount:          result.SettledAmount,
+		FormattedSettledAmount: formatAmount(formatted, result.SettledAmount, result.Currency),
+		Currency:               result.Currency,
+		IsSettled:              result.IsSettled,
 	}
 }
 
-func ToParticipantResponseDtoList(results []ServiceDtos.ParticipantResult) []AdapterDtos.ParticipantResponseDto {
+func ToPayerResponseDtoList(results []ServiceDtos.PayerResult, formatted bool) []AdapterDtos.PayerResponseDto {
+	payers := make([]AdapterDtos.PayerResponseDto, len(results))
+	for i, p := range results {
+		payers[i] = AdapterDtos.PayerResponseDto{
+			UserID:              p.UserID,
+			UserName:            p.UserName,
+			PaidAmount:          p.PaidAmount,
+			FormattedPaidAmount: formatAmount(formatted, p.PaidAmount, p.Currency),
+			Currency:            p.Currency,
+		}
+	}
+	return payers
+}
+
+func ToParticipantResponseDtoList(results []ServiceDtos.ParticipantResult, formatted bool) []AdapterDtos.ParticipantResponseDto {
 	participants := make([]AdapterDtos.ParticipantResponseDto, len(results))
 	for i, p := range results {
-		participants[i] = ToParticipantResponseDto(&p)
+		participants[i] = ToParticipantResponseDto(&p, formatted)
 	}
 	return participants
 }
 
-func ToSplitResponseDto(result *ServiceDtos.SplitResult) AdapterDtos.SplitResponseDto {
+func ToItemResponseDtoList(results []ServiceDtos.ItemResult, currency string, formatted bool) []AdapterDtos.ItemResponseDto {
+	items := make([]AdapterDtos.ItemResponseDto, len(results))
+	for i, item := range results {
+		items[i] = AdapterDtos.ItemResponseDto{
+			ID:              item.ID,
+			Name:            item.Name,
+			Amount:          item.Amount,
+			FormattedAmount: formatAmount(formatted, item.Amount, currency),
+			Quantity:        item.Quantity,
+			AssignedUserIDs: item.AssignedUserIDs,
+		}
+	}
+	return items
+}
+
+func ToSplitResponseDto(result *ServiceDtos.SplitResult, formatted bool) AdapterDtos.SplitResponseDto {
 	return AdapterDtos.SplitResponseDto{
-		ID:            result.ID,
-		Type:          result.Type,
-		DivisionType:  result.DivisionType,
-		TotalAmount:   result.TotalAmount,
-		Currency:      result.Currency,
-		Description:   result.Description,
-		GroupID:       result.GroupID,
-		CreatedByID:   result.CreatedByID,
-		CreatedAt:     result.CreatedAt,
-		SimplifyDebts: result.SimplifyDebts,
-		Participants:  ToParticipantResponseDtoList(result.Participants),
+		ID:                   result.ID,
+		Type:                 result.Type,
+		DivisionType:         result.DivisionType,
+		TotalAmount:          result.TotalAmount,
+		FormattedTotalAmount: formatAmount(formatted, result.TotalAmount, result.Currency),
+		Currency:             result.Currency,
+		Description:          result.Description,
+		GroupID:              result.GroupID,
+		CategoryID:           result.CategoryID,
+		CategoryName:         result.CategoryName,
+		CreatedByID:          result.CreatedByID,
+		CreatedAt:            result.CreatedAt,
+		SimplifyDebts:        result.SimplifyDebts,
+		Payers:               ToPayerResponseDtoList(result.Payers, formatted),
+		Participants:         ToParticipantResponseDtoList(result.Participants, formatted),
+
+		Items:               ToItemResponseDtoList(result.Items, result.Currency, formatted),
+		TaxAmount:           result.TaxAmount,
+		TipAmount:           result.TipAmount,
+		ServiceChargeAmount: result.ServiceChargeAmount,
 	}
 }
 
-func ToSplitResponseDtoList(results []ServiceDtos.SplitResult) []AdapterDtos.SplitResponseDto {
+func ToSplitResponseDtoList(results []ServiceDtos.SplitResult, formatted bool) []AdapterDtos.SplitResponseDto {
 	splits := make([]AdapterDtos.SplitResponseDto, len(results))
 	for i, s := range results {
-		splits[i] = ToSplitResponseDto(&s)
+		splits[i] = ToSplitResponseDto(&s, formatted)
 	}
 	return splits
 }
 
-func ToSplitListResponseDto(result *ServiceDtos.SplitListResult) AdapterDtos.SplitListResponseDto {
+func ToSplitListResponseDto(result *ServiceDtos.SplitListResult, formatted bool) AdapterDtos.SplitListResponseDto {
 	return AdapterDtos.SplitListResponseDto{
-		Splits:     ToSplitResponseDtoList(result.Splits),
+		Splits:     ToSplitResponseDtoList(result.Splits, formatted),
 		Page:       result.Page,
 		PageSize:   result.PageSize,
 		TotalItems: result.TotalItems,
 		TotalPages: Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
 	}
 }
+
+func ToCreateRecurringSplitInput(dto *AdapterDtos.CreateRecurringSplitRequestDto) ServiceDtos.CreateRecurringSplitInput {
+	return ServiceDtos.CreateRecurringSplitInput{
+		Type:           dto.Type,
+		DivisionType:   dto.DivisionType,
+		TotalAmount:    dto.TotalAmount,
+		Currency:       dto.Currency,
+		Description:    dto.Description,
+		GroupID:        dto.GroupID,
+		SimplifyDebts:  dto.SimplifyDebts,
+		Cadence:        dto.Cadence,
+		CronExpression: dto.CronExpression,
+		StartDate:      dto.StartDate,
+		EndDate:        dto.EndDate,
+		Participants:   ToParticipantInputList(dto.Participants),
+	}
+}
+
+func ToUpdateRecurringSplitInput(dto *AdapterDtos.UpdateRecurringSplitRequestDto) ServiceDtos.UpdateRecurringSplitInput {
+	input := ServiceDtos.UpdateRecurringSplitInput{
+		Description: dto.Description,
+		TotalAmount: dto.TotalAmount,
+		EndDate:     dto.EndDate,
+		IsActive:    dto.IsActive,
+	}
+	if dto.Participants != nil {
+		input.Participants = ToParticipantInputList(dto.Participants)
+	}
+	return input
+}
+
+func ToRecurringSplitResponseDto(result *ServiceDtos.RecurringSplitResult, formatted bool) AdapterDtos.RecurringSplitResponseDto {
+	participants := make([]AdapterDtos.RecurringParticipantResponseDto, len(result.Participants))
+	for i, p := range result.Participants {
+		participants[i] = AdapterDtos.RecurringParticipantResponseDto{
+			UserID:               p.UserID,
+			UserName:             p.UserName,
+			ShareAmount:          p.ShareAmount,
+			FormattedShareAmount: formatAmount(formatted, p.ShareAmount, result.Currency),
+			Percentage:           p.Percentage,
+			Shares:               p.Shares,
+		}
+	}
+
+	return AdapterDtos.RecurringSplitResponseDto{
+		ID:                   result.ID,
+		Type:                 result.Type,
+		DivisionType:         result.DivisionType,
+		TotalAmount:          result.TotalAmount,
+		FormattedTotalAmount: formatAmount(formatted, result.TotalAmount, result.Currency),
+		Currency:             result.Currency,
+		Description:          result.Description,
+		GroupID:              result.GroupID,
+		CreatedByID:          result.CreatedByID,
+		SimplifyDebts:        result.SimplifyDebts,
+		Cadence:              result.Cadence,
+		CronExpression:       result.CronExpression,
+		StartDate:            result.StartDate,
+		EndDate:              result.EndDate,
+		NextRunAt:            result.NextRunAt,
+		LastRunAt:            result.LastRunAt,
+		IsActive:             result.IsActive,
+		CreatedAt:            result.CreatedAt,
+		Participants:         participants,
+	}
+}
+
+func ToRecurringSplitListResponseDto(result *ServiceDtos.RecurringSplitListResult, formatted bool) AdapterDtos.RecurringSplitListResponseDto {
+	recurringSplits := make([]AdapterDtos.RecurringSplitResponseDto, len(result.RecurringSplits))
+	for i, r := range result.RecurringSplits {
+		recurringSplits[i] = ToRecurringSplitResponseDto(&r, formatted)
+	}
+
+	return AdapterDtos.RecurringSplitListResponseDto{
+		RecurringSplits: recurringSplits,
+		Page:            result.Page,
+		PageSize:        result.PageSize,
+		TotalItems:      result.TotalItems,
+		TotalPages:      Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
+	}
+}
+
+func formatAmount(formatted bool, amount int64, currency string) *string {
+	if !formatted {
+		return nil
+	}
+	value := Domain.FormatAmount(amount, Domain.Currency(currency))
+	return &value
+}
+
+func ToAttachmentResponseDto(result *ServiceDtos.AttachmentResult) AdapterDtos.AttachmentResponseDto {
+	return AdapterDtos.AttachmentResponseDto{
+		ID:             result.ID,
+		SplitID:        result.SplitID,
+		FileName:       result.FileName,
+		ContentType:    result.ContentType,
+		Size:           result.Size,
+		Checksum:       result.Checksum,
+		UploadedByID:   result.UploadedByID,
+		UploadedByName: result.UploadedByName,
+		CreatedAt:      result.CreatedAt,
+	}
+}
+
+func ToAttachmentListResponseDto(result *ServiceDtos.AttachmentListResult) AdapterDtos.AttachmentListResponseDto {
+	attachments := make([]AdapterDtos.AttachmentResponseDto, len(result.Attachments))
+	for i := range result.Attachments {
+		attachments[i] = ToAttachmentResponseDto(&result.Attachments[i])
+	}
+	return AdapterDtos.AttachmentListResponseDto{Attachments: attachments}
+}
+
+func ToExportInput(from, to string) (ServiceDtos.ExportInput, error) {
+	var input ServiceDtos.ExportInput
+	if from != "" {
+		parsed, err := time.Parse(dateLayout, from)
+		if err != nil {
+			return input, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidDateRange)
+		}
+		input.From = &parsed
+	}
+	if to != "" {
+		parsed, err := time.Parse(dateLayout, to)
+		if err != nil {
+			return input, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidDateRange)
+		}
+		input.To = &parsed
+	}
+	return input, nil
+}
+
+func ToExportRecordDto(record *ServiceDtos.ExportRecord) AdapterDtos.ExportRecordDto {
+	currency := Domain.Currency(record.Currency)
+	return AdapterDtos.ExportRecordDto{
+		RecordType:        record.RecordType,
+		Date:              formatTimestamp(record.Date),
+		GroupID:           record.GroupID,
+		GroupName:         record.GroupName,
+		SplitID:           record.SplitID,
+		SettlementID:      record.SettlementID,
+		Description:       record.Description,
+		Category:          record.Category,
+		Currency:          record.Currency,
+		TotalAmount:       formatExportAmount(record.TotalAmount, currency),
+		UserID:            record.UserID,
+		UserName:          record.UserName,
+		UserEmail:         record.UserEmail,
+		CounterpartyID:    record.CounterpartyID,
+		CounterpartyName:  record.CounterpartyName,
+		CounterpartyEmail: record.CounterpartyEmail,
+		PaidAmount:        formatExportAmount(record.PaidAmount, currency),
+		ShareAmount:       formatExportAmount(record.ShareAmount, currency),
+		SettledAmount:     formatExportAmount(record.SettledAmount, currency),
+		IsSettled:         record.IsSettled,
+		Amount:            formatExportAmount(record.Amount, currency),
+		Status:            record.Status,
+		Confirmed:         record.Confirmed,
+		ConfirmedAt:       formatTimestamp(record.ConfirmedAt),
+	}
+}
+
+func formatExportAmount(amount *int64, currency Domain.Currency) *string {
+	if amount == nil {
+		return nil
+	}
+	formatted := Domain.FormatAmount(*amount, currency)
+	return &formatted
+}
+
+func formatTimestamp(timestamp *time.Time) *string {
+	if timestamp == nil {
+		return nil
+	}
+	formatted := timestamp.UTC().Format(time.RFC3339)
+	return &formatted
+}
+
+// ToImportMembers reads the optional JSON object that maps Splitwise member
+// columns to email addresses.
+func ToImportMembers(value string) (map[string]string, error) {
+	members := map[string]string{}
+	if value == "" {
+		return members, nil
+	}
+	if err := json.Unmarshal([]byte(value), &members); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidImportMembers)
+	}
+	return members, nil
+}
+
+func ToImportResultResponseDto(result *ServiceDtos.ImportResult) AdapterDtos.ImportResultResponseDto {
+	rows := make([]AdapterDtos.ImportRowResponseDto, len(result.Rows))
+	for i, row := range result.Rows {
+		rows[i] = AdapterDtos.ImportRowResponseDto{
+			Line:        row.Line,
+			Status:      row.Status,
+			Description: row.Description,
+			Amount:      row.Amount,
+			Currency:    row.Currency,
+			SplitID:     row.SplitID,
+			Errors:      row.Errors,
+		}
+		if row.Date != nil {
+			date := row.Date.Format(dateLayout)
+			rows[i].Date = &date
+		}
+	}
+
+	return AdapterDtos.ImportResultResponseDto{
+		Format:       result.Format,
+		DryRun:       result.DryRun,
+		TotalRows:    result.TotalRows,
+		ValidRows:    result.ValidRows,
+		CreatedRows:  result.CreatedRows,
+		ExistingRows: result.ExistingRows,
+		InvalidRows:  result.InvalidRows,
+		FailedRows:   result.FailedRows,
+		Rows:         rows,
+	}
+}
@@ -0,0 +1,121 @@
+package SplitAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	SplitDtos "autobill-service/internal/adapters/inbound/http/split/dtos"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RecurringSplitHandler struct {
+	service HttpPorts.RecurringSplitUseCase
+}
+
+func CreateRecurringSplitHandler(service HttpPorts.RecurringSplitUseCase) RecurringSplitHandler {
+	return RecurringSplitHandler{service: service}
+}
+
+func (h *RecurringSplitHandler) CreateRecurringSplitHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	reqBody := new(SplitDtos.CreateRecurringSplitRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.CreateRecurringSplit(ctx, userId, ToCreateRecurringSplitInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ToRecurringSplitResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *RecurringSplitHandler) GetMyRecurringSplitsHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	pagination := Helpers.ParsePagination(c)
+	result, err := h.service.GetMyRecurringSplits(ctx, userId, pagination)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToRecurringSplitListResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *RecurringSplitHandler) GetRecurringSplitHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	recurringSplitId, err := Helpers.ParseUUID(c.Params("recurringSplitId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetRecurringSplit(ctx, userId, recurringSplitId)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(ToRecurringSplitResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *RecurringSplitHandler) UpdateRecurringSplitHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	recurringSplitId, err := Helpers.ParseUUID(c.Params("recurringSplitId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(SplitDtos.UpdateRecurringSplitRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.UpdateRecurringSplit(ctx, userId, recurringSplitId, ToUpdateRecurringSplitInput(reqBody))
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(ToRecurringSplitResponseDto(result, Helpers.WantsFormattedAmounts(c)))
+}
+
+func (h *RecurringSplitHandler) DeleteRecurringSplitHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	recurringSplitId, err := Helpers.ParseUUID(c.Params("recurringSplitId"))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteRecurringSplit(ctx, userId, recurringSplitId); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
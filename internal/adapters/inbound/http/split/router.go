@@ -1,6 +1,8 @@
 package SplitAdapter
 
 import (
+	CategoryAdapter "autobill-service/internal/adapters/inbound/http/category"
+	CommentAdapter "autobill-service/internal/adapters/inbound/http/comment"
 	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
 	JWTUtil "autobill-service/pkg/jwt"
 
@@ -8,16 +10,28 @@ import (
 )
 
 type SplitRouter struct {
-	App     *fiber.App
-	handler SplitHandler
-	util    JWTUtil.JWTUtil
+	App               *fiber.App
+	handler           SplitHandler
+	recurringHandler  RecurringSplitHandler
+	categoryHandler   CategoryAdapter.CategoryHandler
+	attachmentHandler SplitAttachmentHandler
+	commentHandler    CommentAdapter.CommentHandler
+	exportHandler     SplitExportHandler
+	importHandler     SplitImportHandler
+	util              JWTUtil.JWTUtil
 }
 
-func CreateSplitRouter(app *fiber.App, handler SplitHandler, util JWTUtil.JWTUtil) SplitRouter {
+func CreateSplitRouter(app *fiber.App, handler SplitHandler, recurringHandler RecurringSplitHandler, categoryHandler CategoryAdapter.CategoryHandler, attachmentHandler SplitAttachmentHandler, commentHandler CommentAdapter.CommentHandler, exportHandler SplitExportHandler, importHandler SplitImportHandler, util JWTUtil.JWTUtil) SplitRouter {
 	return SplitRouter{
-		App:     app,
-		handler: handler,
-		util:    util,
+		App:               app,
+		handler:           handler,
+		recurringHandler:  recurringHandler,
+		categoryHandler:   categoryHandler,
+		attachmentHandler: attachmentHandler,
+		commentHandler:    commentHandler,
+		exportHandler:     exportHandler,
+		importHandler:     importHandler,
+		util:              util,
 	}
 }
 
@@ -26,7 +40,25 @@ func (r SplitRouter) RegisterRoutes() {
 
 	r.App.Post("/", r.handler.CreateSplitHandler).Name("createSplit")
 	r.App.Get("/me", r.handler.GetMySplitsHandler).Name("getMySplits")
+	r.App.Get("/me/export", r.exportHandler.ExportMySplitsHandler).Name("exportMySplits")
+	r.App.Get("/categories", r.categoryHandler.GetSystemCategoriesHandler).Name("getSystemCategories")
+	r.App.Post("/recurring", r.recurringHandler.CreateRecurringSplitHandler).Name("createRecurringSplit")
+	r.App.Get("/recurring", r.recurringHandler.GetMyRecurringSplitsHandler).Name("getMyRecurringSplits")
+	r.App.Get("/recurring/:recurringSplitId", r.recurringHandler.GetRecurringSplitHandler).Name("getRecurringSplit")
+	r.App.Patch("/recurring/:recurringSplitId", r.recurringHandler.UpdateRecurringSplitHandler).Name("updateRecurringSplit")
+	r.App.Delete("/recurring/:recurringSplitId", r.recurringHandler.DeleteRecurringSplitHandler).Name("deleteRecurringSplit")
 	r.App.Get("/:splitId", r.handler.GetSplitHandler).Name("getSplit")
+	r.App.Patch("/:splitId", r.handler.UpdateSplitHandler).Name("updateSplit")
 	r.App.Get("/groups/:groupId", r.handler.GetGroupSplitsHandler).Name("getGroupSplits")
+	r.App.Get("/groups/:groupId/export", r.exportHandler.ExportGroupSplitsHandler).Name("exportGroupSplits")
+	r.App.Post("/groups/:groupId/import", r.importHandler.ImportSplitsHandler).Name("importGroupSplits")
 	r.App.Post("/:splitId/reverse", r.handler.ReverseSplitHandler).Name("reverseSplit")
+	r.App.Post("/:splitId/attachments", r.attachmentHandler.UploadAttachmentHandler).Name("uploadSplitAttachment")
+	r.App.Get("/:splitId/attachments", r.attachmentHandler.GetAttachmentsHandler).Name("getSplitAttachments")
+	r.App.Get("/:splitId/attachments/:attachmentId", r.attachmentHandler.DownloadAttachmentHandler).Name("downloadSplitAttachment")
+	r.App.Delete("/:splitId/attachments/:attachmentId", r.attachmentHandler.DeleteAttachmentHandler).Name("deleteSplitAttachment")
+	r.App.Get("/:splitId/comments", r.commentHandler.GetCommentsHandler).Name("getSplitComments")
+	r.App.Post("/:splitId/comments", r.commentHandler.CreateCommentHandler).Name("createSplitComment")
+	r.App.Patch("/:splitId/comments/:commentId", r.commentHandler.UpdateCommentHandler).Name("updateSplitComment")
+	r.App.Delete("/:splitId/comments/:commentId", r.commentHandler.DeleteCommentHandler).Name("deleteSplitComment")
 }
@@ -8,3 +8,11 @@ type UpdateUserRequestDto struct {
 type FindUserByEmailRequestDto struct {
 	Email string `json:"email" validate:"required,email"`
 }
+
+type UpdateNotificationPreferencesRequestDto struct {
+	Frequency   *string `json:"frequency" validate:"omitempty,oneof=OFF INSTANT DAILY_DIGEST"`
+	Splits      *bool   `json:"splits"`
+	Settlements *bool   `json:"settlements"`
+	Reminders   *bool   `json:"reminders"`
+	Mentions    *bool   `json:"mentions"`
+}
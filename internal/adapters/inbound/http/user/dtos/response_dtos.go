@@ -16,3 +16,11 @@ type UpdateUserResponseDto struct {
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
+
+type NotificationPreferencesResponseDto struct {
+	Frequency   string `json:"frequency"`
+	Splits      bool   `json:"splits"`
+	Settlements bool   `json:"settlements"`
+	Reminders   bool   `json:"reminders"`
+	Mentions    bool   `json:"mentions"`
+}
@@ -75,3 +75,42 @@ func (h *UserHandler) UpdateUserHandler(c *fiber.Ctx) error {
 
 	return c.JSON(ToUpdateUserResponseDto(result))
 }
+
+func (h *UserHandler) GetNotificationPreferencesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	idStr, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetNotificationPreferences(ctx, idStr)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(ToNotificationPreferencesResponseDto(result))
+}
+
+func (h *UserHandler) UpdateNotificationPreferencesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	idStr, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	reqBody := new(UserDtos.UpdateNotificationPreferencesRequestDto)
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.UpdateNotificationPreferences(ctx, idStr, ToUpdateNotificationPreferencesInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(ToNotificationPreferencesResponseDto(result))
+}
@@ -30,3 +30,23 @@ func ToUpdateUserResponseDto(result *ServiceDtos.UserResult) AdapterDtos.UpdateU
 		UpdatedAt: result.UpdatedAt,
 	}
 }
+
+func ToUpdateNotificationPreferencesInput(dto *AdapterDtos.UpdateNotificationPreferencesRequestDto) ServiceDtos.UpdateNotificationPreferencesInput {
+	return ServiceDtos.UpdateNotificationPreferencesInput{
+		Frequency:   dto.Frequency,
+		Splits:      dto.Splits,
+		Settlements: dto.Settlements,
+		Reminders:   dto.Reminders,
+		Mentions:    dto.Mentions,
+	}
+}
+
+func ToNotificationPreferencesResponseDto(result *ServiceDtos.NotificationPreferencesResult) AdapterDtos.NotificationPreferencesResponseDto {
+	return AdapterDtos.NotificationPreferencesResponseDto{
+		Frequency:   result.Frequency,
+		Splits:      result.Splits,
+		Settlements: result.Settlements,
+		Reminders:   result.Reminders,
+		Mentions:    result.Mentions,
+	}
+}
@@ -26,4 +26,6 @@ func (ur UserRouter) RegisterRoutes() {
 	ur.App.Get("/", ur.handler.GetUserHandler).Name("getUser")
 	ur.App.Post("/search", ur.handler.FindUserByEmailHandler).Name("findUserByEmail")
 	ur.App.Put("/update", ur.handler.UpdateUserHandler).Name("updateUser")
+	ur.App.Get("/notifications", ur.handler.GetNotificationPreferencesHandler).Name("getNotificationPreferences")
+	ur.App.Patch("/notifications", ur.handler.UpdateNotificationPreferencesHandler).Name("updateNotificationPreferences")
 }
@@ -0,0 +1,15 @@
+package WebhookDtos
+
+type CreateWebhookRequestDto struct {
+	URL         string   `json:"url" validate:"required,max=2048"`
+	GroupID     string   `json:"group_id"`
+	Events      []string `json:"events"`
+	Description string   `json:"description" validate:"max=500"`
+}
+
+type UpdateWebhookRequestDto struct {
+	URL         *string   `json:"url" validate:"omitempty,max=2048"`
+	Events      *[]string `json:"events"`
+	Description *string   `json:"description" validate:"omitempty,max=500"`
+	Active      *bool     `json:"active"`
+}
@@ -0,0 +1,49 @@
+package WebhookDtos
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type WebhookResponseDto struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	GroupID     *string   `json:"group_id,omitempty"`
+	Events      []string  `json:"events"`
+	Description string    `json:"description"`
+	Active      bool      `json:"active"`
+	Secret      string    `json:"secret,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type WebhookListResponseDto struct {
+	Webhooks   []WebhookResponseDto `json:"webhooks"`
+	Page       int                  `json:"page"`
+	PageSize   int                  `json:"page_size"`
+	TotalItems int64                `json:"total_items"`
+	TotalPages int                  `json:"total_pages"`
+}
+
+type WebhookDeliveryResponseDto struct {
+	ID             string          `json:"id"`
+	WebhookID      string          `json:"webhook_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	LastStatusCode *int            `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type WebhookDeliveryListResponseDto struct {
+	Deliveries []WebhookDeliveryResponseDto `json:"deliveries"`
+	Page       int                          `json:"page"`
+	PageSize   int                          `json:"page_size"`
+	TotalItems int64                        `json:"total_items"`
+	TotalPages int                          `json:"total_pages"`
+}
@@ -0,0 +1,178 @@
+package WebhookAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	WebhookDtos "autobill-service/internal/adapters/inbound/http/webhook/dtos"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WebhookHandler struct {
+	service HttpPorts.WebhookUseCase
+}
+
+func CreateWebhookHandler(service HttpPorts.WebhookUseCase) WebhookHandler {
+	return WebhookHandler{service: service}
+}
+
+func (h *WebhookHandler) CreateWebhookHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	reqBody := new(WebhookDtos.CreateWebhookRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.CreateWebhook(ctx, userId, ToCreateWebhookInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ToWebhookResponseDto(result))
+}
+
+func (h *WebhookHandler) GetWebhooksHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	pagination := Helpers.ParsePagination(c)
+	result, err := h.service.GetWebhooks(ctx, userId, pagination)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToWebhookListResponseDto(result))
+}
+
+func (h *WebhookHandler) GetWebhookHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	webhookId, err := Helpers.ParseUUID(c.Params("webhookId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.GetWebhook(ctx, userId, webhookId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToWebhookResponseDto(result))
+}
+
+func (h *WebhookHandler) UpdateWebhookHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	webhookId, err := Helpers.ParseUUID(c.Params("webhookId"))
+	if err != nil {
+		return err
+	}
+	reqBody := new(WebhookDtos.UpdateWebhookRequestDto)
+
+	if err := c.BodyParser(reqBody); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRequestBody)
+	}
+
+	if err := Helpers.ValidateRequest(reqBody); err != nil {
+		return err
+	}
+
+	result, err := h.service.UpdateWebhook(ctx, userId, webhookId, ToUpdateWebhookInput(reqBody))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToWebhookResponseDto(result))
+}
+
+func (h *WebhookHandler) DeleteWebhookHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	webhookId, err := Helpers.ParseUUID(c.Params("webhookId"))
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.DeleteWebhook(ctx, userId, webhookId); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *WebhookHandler) GetDeliveriesHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	webhookId, err := Helpers.ParseUUID(c.Params("webhookId"))
+	if err != nil {
+		return err
+	}
+
+	pagination := Helpers.ParsePagination(c)
+	result, err := h.service.GetDeliveries(ctx, userId, webhookId, c.Query("status"), pagination)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToWebhookDeliveryListResponseDto(result))
+}
+
+func (h *WebhookHandler) GetDeadLettersHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	pagination := Helpers.ParsePagination(c)
+	result, err := h.service.GetDeadLetters(ctx, userId, pagination)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToWebhookDeliveryListResponseDto(result))
+}
+
+func (h *WebhookHandler) RetryDeliveryHandler(c *fiber.Ctx) error {
+	ctx := Middlewares.GetContext(c)
+	userId, err := Helpers.GetUserIdFromContext(c)
+	if err != nil {
+		return err
+	}
+	deliveryId, err := Helpers.ParseUUID(c.Params("deliveryId"))
+	if err != nil {
+		return err
+	}
+
+	result, err := h.service.RetryDelivery(ctx, userId, deliveryId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ToWebhookDeliveryResponseDto(result))
+}
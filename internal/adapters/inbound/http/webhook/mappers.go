@@ -0,0 +1,88 @@
+package WebhookAdapter
+
+import (
+	"encoding/json"
+
+	AdapterDtos "autobill-service/internal/adapters/inbound/http/webhook/dtos"
+	ServiceDtos "autobill-service/internal/application/webhook/dtos"
+	Helpers "autobill-service/pkg/helpers"
+)
+
+func ToCreateWebhookInput(dto *AdapterDtos.CreateWebhookRequestDto) ServiceDtos.CreateWebhookInput {
+	return ServiceDtos.CreateWebhookInput{
+		URL:         dto.URL,
+		GroupID:     dto.GroupID,
+		Events:      dto.Events,
+		Description: dto.Description,
+	}
+}
+
+func ToUpdateWebhookInput(dto *AdapterDtos.UpdateWebhookRequestDto) ServiceDtos.UpdateWebhookInput {
+	return ServiceDtos.UpdateWebhookInput{
+		URL:         dto.URL,
+		Events:      dto.Events,
+		Description: dto.Description,
+		Active:      dto.Active,
+	}
+}
+
+func ToWebhookResponseDto(result *ServiceDtos.WebhookResult) AdapterDtos.WebhookResponseDto {
+	return AdapterDtos.WebhookResponseDto{
+		ID:          result.ID,
+		URL:         result.URL,
+		GroupID:     result.GroupID,
+		Events:      result.Events,
+		Description: result.Description,
+		Active:      result.Active,
+		Secret:      result.Secret,
+		CreatedAt:   result.CreatedAt,
+		UpdatedAt:   result.UpdatedAt,
+	}
+}
+
+func ToWebhookListResponseDto(result *ServiceDtos.WebhookListResult) AdapterDtos.WebhookListResponseDto {
+	webhooks := make([]AdapterDtos.WebhookResponseDto, len(result.Webhooks))
+	for i := range result.Webhooks {
+		webhooks[i] = ToWebhookResponseDto(&result.Webhooks[i])
+	}
+
+	return AdapterDtos.WebhookListResponseDto{
+		Webhooks:   webhooks,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
+		TotalPages: Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
+	}
+}
+
+func ToWebhookDeliveryResponseDto(result *ServiceDtos.WebhookDeliveryResult) AdapterDtos.WebhookDeliveryResponseDto {
+	return AdapterDtos.WebhookDeliveryResponseDto{
+		ID:             result.ID,
+		WebhookID:      result.WebhookID,
+		EventType:      result.EventType,
+		Payload:        json.RawMessage(result.Payload),
+		Status:         result.Status,
+		Attempts:       result.Attempts,
+		NextAttemptAt:  result.NextAttemptAt,
+		LastAttemptAt:  result.LastAttemptAt,
+		LastStatusCode: result.LastStatusCode,
+		LastError:      result.LastError,
+		DeliveredAt:    result.DeliveredAt,
+		CreatedAt:      result.CreatedAt,
+	}
+}
+
+func ToWebhookDeliveryListResponseDto(result *ServiceDtos.WebhookDeliveryListResult) AdapterDtos.WebhookDeliveryListResponseDto {
+	deliveries := make([]AdapterDtos.WebhookDeliveryResponseDto, len(result.Deliveries))
+	for i := range result.Deliveries {
+		deliveries[i] = ToWebhookDeliveryResponseDto(&result.Deliveries[i])
+	}
+
+	return AdapterDtos.WebhookDeliveryListResponseDto{
+		Deliveries: deliveries,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalItems: result.TotalItems,
+		TotalPages: Helpers.CalculateTotalPages(result.PageSize, result.TotalItems),
+	}
+}
@@ -0,0 +1,35 @@
+package WebhookAdapter
+
+import (
+	Middlewares "autobill-service/internal/adapters/inbound/http/middleware"
+	JWTUtil "autobill-service/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WebhookRouter struct {
+	App     *fiber.App
+	handler WebhookHandler
+	util    JWTUtil.JWTUtil
+}
+
+func CreateWebhookRouter(app *fiber.App, handler WebhookHandler, util JWTUtil.JWTUtil) WebhookRouter {
+	return WebhookRouter{
+		App:     app,
+		handler: handler,
+		util:    util,
+	}
+}
+
+func (r WebhookRouter) RegisterRoutes() {
+	r.App.Use(Middlewares.AuthMiddleware(r.util))
+
+	r.App.Post("/", r.handler.CreateWebhookHandler).Name("createWebhook")
+	r.App.Get("/", r.handler.GetWebhooksHandler).Name("getWebhooks")
+	r.App.Get("/dead-letters", r.handler.GetDeadLettersHandler).Name("getWebhookDeadLetters")
+	r.App.Post("/deliveries/:deliveryId/retry", r.handler.RetryDeliveryHandler).Name("retryWebhookDelivery")
+	r.App.Get("/:webhookId", r.handler.GetWebhookHandler).Name("getWebhook")
+	r.App.Patch("/:webhookId", r.handler.UpdateWebhookHandler).Name("updateWebhook")
+	r.App.Delete("/:webhookId", r.handler.DeleteWebhookHandler).Name("deleteWebhook")
+	r.App.Get("/:webhookId/deliveries", r.handler.GetDeliveriesHandler).Name("getWebhookDeliveries")
+}
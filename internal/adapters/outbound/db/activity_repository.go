@@ -0,0 +1,72 @@
+package RepositoryAdapters
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ActivityRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateActivityRepository(db DB.PostgresDB) RepositoryPorts.ActivityRepositoryPort {
+	return &ActivityRepository{db: db}
+}
+
+func (repo *ActivityRepository) GetUserActivity(ctx context.Context, userId uuid.UUID, limit, offset int) ([]Domain.ActivityEvent, int64, error) {
+	audience := repo.db.DB.WithContext(ctx).Model(&Domain.ActivityEventUser{}).
+		Select("activity_event_id").
+		Where("user_id = ?", userId)
+
+	baseQuery := repo.db.DB.WithContext(ctx).Model(&Domain.ActivityEvent{}).
+		Where("id IN (?)", audience)
+
+	return repo.findActivity(baseQuery, limit, offset)
+}
+
+func (repo *ActivityRepository) GetGroupActivity(ctx context.Context, groupId uuid.UUID, limit, offset int) ([]Domain.ActivityEvent, int64, error) {
+	baseQuery := repo.db.DB.WithContext(ctx).Model(&Domain.ActivityEvent{}).
+		Where("group_id = ?", groupId)
+
+	return repo.findActivity(baseQuery, limit, offset)
+}
+
+func (repo *ActivityRepository) findActivity(baseQuery *gorm.DB, limit, offset int) ([]Domain.ActivityEvent, int64, error) {
+	var total int64
+	if err := baseQuery.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if total == 0 {
+		return []Domain.ActivityEvent{}, 0, nil
+	}
+
+	var events []Domain.ActivityEvent
+	if err := baseQuery.Preload("Actor").
+		Order("created_at DESC, id DESC").
+		Limit(limit).Offset(offset).
+		Find(&events).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return events, total, nil
+}
+
+func recordActivityTx(tx *gorm.DB, activity *Domain.ActivityEvent) error {
+	if err := tx.Omit("Actor").Create(activity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if err := enqueueWebhookDeliveriesTx(tx, activity); err != nil {
+		return err
+	}
+	return enqueueNotificationsTx(tx, activity)
+}
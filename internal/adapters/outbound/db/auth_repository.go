@@ -38,9 +38,10 @@ func (repo *AuthRepository) CreateUser(ctx context.Context, email, name, passwor
 	}()
 
 	user := Domain.User{
-		Email:  email,
-		Name:   name,
-		Status: Domain.AccountActive,
+		Email:         email,
+		Name:          name,
+		Status:        Domain.AccountActive,
+		Notifications: Domain.DefaultNotificationPreferences(),
 	}
 	if err := tx.Create(&user).Error; err != nil {
 		tx.Rollback()
@@ -136,7 +137,7 @@ func (repo *AuthRepository) DeactivateUser(ctx context.Context, userId uuid.UUID
 
 func (repo *AuthRepository) ReactivateUser(ctx context.Context, email string, password string) error {
 	var user Domain.User
-	if err := repo.db.DB.WithContext(ctx).Preload("Credential").Where("email = ? AND status = ?", email, Domain.AccountDeactivated).First(&user).Error; err != nil {
+	if err := repo.db.DB.WithContext(ctx).Preload("Credential").Where("email = ? AND status = ? AND merged_into_id IS NULL", email, Domain.AccountDeactivated).First(&user).Error; err != nil {
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrUserNotFound)
 	}
 
@@ -149,7 +150,7 @@ func (repo *AuthRepository) ReactivateUser(ctx context.Context, email string, pa
 
 	if err := repo.db.DB.WithContext(ctx).
 		Model(&Domain.User{}).
-		Where("email = ?", email).
+		Where("id = ?", user.Id).
 		Update("status", Domain.AccountActive).
 		Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
@@ -157,6 +158,40 @@ func (repo *AuthRepository) ReactivateUser(ctx context.Context, email string, pa
 	return nil
 }
 
+func (repo *AuthRepository) VerifyPassword(ctx context.Context, userId uuid.UUID, password string) error {
+	var cred Domain.Credential
+	if err := repo.db.DB.WithContext(ctx).Where("user_id = ?", userId).First(&cred).Error; err != nil {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrUserNotFound)
+	}
+
+	if err := bcrypt.CompareHashAndPassword(
+		[]byte(cred.PasswordHash),
+		[]byte(password),
+	); err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, Errors.ErrUnauthorized)
+	}
+	return nil
+}
+
+func (repo *AuthRepository) MergeUsers(ctx context.Context, sourceId, targetId uuid.UUID) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := mergeAccountTx(tx, sourceId, targetId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
 func (repo *AuthRepository) CreateRefreshToken(ctx context.Context, userId uuid.UUID, token string, expiresAt time.Time) (*Domain.RefreshToken, error) {
 	refreshToken := &Domain.RefreshToken{
 		UserID:    userId,
@@ -11,6 +11,7 @@ import (
 	Errors "autobill-service/pkg/errors"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type BalanceRepository struct {
@@ -45,127 +46,9 @@ func (repo *BalanceRepository) UpdateBalancesForSplit(ctx context.Context, split
 		}
 	}()
 
-	creatorId := split.CreatedByID
-	currency := split.Currency
-
-	userIds := make([]uuid.UUID, 0, len(participants))
-	for _, p := range participants {
-		userIds = append(userIds, p.UserID)
-	}
-
-	var existingUserBalances []Domain.UserBalance
-	if err := tx.Where("currency = ? AND ((user_id IN ? AND other_user_id = ?) OR (user_id = ? AND other_user_id IN ?))",
-		currency, userIds, creatorId, creatorId, userIds).Find(&existingUserBalances).Error; err != nil {
+	if err := updateBalancesForSplitTx(tx, split, participants); err != nil {
 		tx.Rollback()
-		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-	}
-
-	userBalanceMap := make(map[string]*Domain.UserBalance)
-	for i := range existingUserBalances {
-		key := existingUserBalances[i].UserID.String() + "-" + existingUserBalances[i].OtherUserID.String()
-		userBalanceMap[key] = &existingUserBalances[i]
-	}
-
-	var newBalances []Domain.UserBalance
-	var updateBalances []*Domain.UserBalance
-
-	for _, participant := range participants {
-		if participant.UserID == creatorId {
-			continue
-		}
-
-		participantKey := participant.UserID.String() + "-" + creatorId.String()
-		if balance, exists := userBalanceMap[participantKey]; exists {
-			balance.NetAmount -= participant.ShareAmount
-			updateBalances = append(updateBalances, balance)
-		} else {
-			newBalances = append(newBalances, Domain.UserBalance{
-				UserID:      participant.UserID,
-				OtherUserID: creatorId,
-				NetAmount:   -participant.ShareAmount,
-				Currency:    currency,
-			})
-		}
-
-		creatorKey := creatorId.String() + "-" + participant.UserID.String()
-		if balance, exists := userBalanceMap[creatorKey]; exists {
-			balance.NetAmount += participant.ShareAmount
-			updateBalances = append(updateBalances, balance)
-		} else {
-			newBalances = append(newBalances, Domain.UserBalance{
-				UserID:      creatorId,
-				OtherUserID: participant.UserID,
-				NetAmount:   participant.ShareAmount,
-				Currency:    currency,
-			})
-		}
-	}
-
-	if len(newBalances) > 0 {
-		if err := tx.Create(&newBalances).Error; err != nil {
-			tx.Rollback()
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-		}
-	}
-
-	for _, balance := range updateBalances {
-		if err := tx.Save(balance).Error; err != nil {
-			tx.Rollback()
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-		}
-	}
-
-	if split.GroupID != nil {
-		groupId := *split.GroupID
-
-		var existingGroupBalances []Domain.GroupBalance
-		if err := tx.Where("group_id = ? AND currency = ? AND user_id IN ?", groupId, currency, userIds).Find(&existingGroupBalances).Error; err != nil {
-			tx.Rollback()
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-		}
-
-		groupBalanceMap := make(map[uuid.UUID]*Domain.GroupBalance)
-		for i := range existingGroupBalances {
-			groupBalanceMap[existingGroupBalances[i].UserID] = &existingGroupBalances[i]
-		}
-
-		var newGroupBalances []Domain.GroupBalance
-		var updateGroupBalances []*Domain.GroupBalance
-
-		for _, participant := range participants {
-			netChange := int64(0)
-			if participant.UserID == creatorId {
-				netChange = split.TotalAmount - participant.ShareAmount
-			} else {
-				netChange = -participant.ShareAmount
-			}
-
-			if balance, exists := groupBalanceMap[participant.UserID]; exists {
-				balance.NetAmount += netChange
-				updateGroupBalances = append(updateGroupBalances, balance)
-			} else {
-				newGroupBalances = append(newGroupBalances, Domain.GroupBalance{
-					UserID:    participant.UserID,
-					GroupID:   groupId,
-					NetAmount: netChange,
-					Currency:  currency,
-				})
-			}
-		}
-
-		if len(newGroupBalances) > 0 {
-			if err := tx.Create(&newGroupBalances).Error; err != nil {
-				tx.Rollback()
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		}
-
-		for _, balance := range updateGroupBalances {
-			if err := tx.Save(balance).Error; err != nil {
-				tx.Rollback()
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		}
+		return err
 	}
 
 	if err := tx.Commit().Error; err != nil {
@@ -175,6 +58,10 @@ func (repo *BalanceRepository) UpdateBalancesForSplit(ctx context.Context, split
 	return nil
 }
 
+func updateBalancesForSplitTx(tx *gorm.DB, split *Domain.Split, participants []Domain.SplitParticipant) error {
+	return postSplitLedgerEntriesTx(tx, split, split.Payers, participants, 1)
+}
+
 func (repo *BalanceRepository) GetGroupBalances(ctx context.Context, groupId uuid.UUID) ([]Domain.GroupBalance, error) {
 	var balances []Domain.GroupBalance
 	if err := repo.db.DB.WithContext(ctx).Preload("User").Where("group_id = ?", groupId).Find(&balances).Error; err != nil {
@@ -183,27 +70,6 @@ func (repo *BalanceRepository) GetGroupBalances(ctx context.Context, groupId uui
 	return balances, nil
 }
 
-func (repo *BalanceRepository) GetSplitsWithParticipants(ctx context.Context, groupId uuid.UUID) ([]Domain.Split, error) {
-	var splits []Domain.Split
-	if err := repo.db.DB.WithContext(ctx).Preload("Participants").Where("group_id = ?", groupId).Find(&splits).Error; err != nil {
-		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-	}
-	return splits, nil
-}
-
-func (repo *BalanceRepository) GetSettlementsForSplits(ctx context.Context, splitIDs []uuid.UUID) ([]Domain.Settlement, error) {
-	if len(splitIDs) == 0 {
-		return []Domain.Settlement{}, nil
-	}
-	var settlements []Domain.Settlement
-	if err := repo.db.DB.WithContext(ctx).
-		Where("split_id IN ? AND confirmed = ?", splitIDs, true).
-		Find(&settlements).Error; err != nil {
-		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-	}
-	return settlements, nil
-}
-
 func (repo *BalanceRepository) GetSettledParticipants(ctx context.Context, splitId uuid.UUID, userId uuid.UUID) (bool, error) {
 	var participant Domain.SplitParticipant
 	err := repo.db.DB.WithContext(ctx).Where("split_id = ? AND user_id = ?", splitId, userId).First(&participant).Error
@@ -213,7 +79,7 @@ func (repo *BalanceRepository) GetSettledParticipants(ctx context.Context, split
 	return participant.IsSettled, nil
 }
 
-func (repo *BalanceRepository) ReplaceGroupBalances(ctx context.Context, groupId uuid.UUID, balances []Domain.GroupBalance) ([]Domain.GroupBalance, error) {
+func (repo *BalanceRepository) RebuildUserBalances(ctx context.Context, userId uuid.UUID) ([]Domain.UserBalance, error) {
 	tx := repo.db.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -221,108 +87,205 @@ func (repo *BalanceRepository) ReplaceGroupBalances(ctx context.Context, groupId
 		}
 	}()
 
-	if err := tx.Delete(&Domain.GroupBalance{}, "group_id = ?", groupId).Error; err != nil {
+	if err := rebuildUserBalancesTx(tx, userId); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var balances []Domain.UserBalance
+	if err := tx.Preload("OtherUser").Where("user_id = ?", userId).Find(&balances).Error; err != nil {
 		tx.Rollback()
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	var result []Domain.GroupBalance
-	for _, balance := range balances {
-		if err := tx.Create(&balance).Error; err != nil {
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return balances, nil
+}
+
+func (repo *BalanceRepository) RebuildGroupBalances(ctx context.Context, groupId uuid.UUID) ([]Domain.GroupBalance, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
 			tx.Rollback()
-			return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 		}
-		tx.Preload("User").First(&balance, "id = ?", balance.Id)
-		result = append(result, balance)
+	}()
+
+	if err := rebuildGroupBalancesTx(tx, groupId); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var balances []Domain.GroupBalance
+	if err := tx.Preload("User").Where("group_id = ?", groupId).Find(&balances).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
 	if err := tx.Commit().Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	return result, nil
+	return balances, nil
 }
 
-func (repo *BalanceRepository) GetSimplifiedDebts(ctx context.Context, groupId uuid.UUID) ([]Domain.SimplifiedDebt, error) {
-	var balances []Domain.GroupBalance
-	if err := repo.db.DB.WithContext(ctx).Preload("User").Where("group_id = ?", groupId).Find(&balances).Error; err != nil {
+func (repo *BalanceRepository) GetUserBalanceMismatches(ctx context.Context, userId uuid.UUID) ([]RepositoryPorts.BalanceMismatch, error) {
+	var mismatches []RepositoryPorts.BalanceMismatch
+	err := repo.db.DB.WithContext(ctx).Raw(`
+		WITH ledger AS (
+			SELECT user_id, counterparty_id AS other_user_id, currency, SUM(amount) AS amount
+			FROM ledger_entries
+			WHERE account = ? AND deleted_at IS NULL AND (user_id = ? OR counterparty_id = ?)
+			GROUP BY user_id, counterparty_id, currency
+		), projection AS (
+			SELECT user_id, other_user_id, currency, SUM(net_amount) AS amount
+			FROM user_balances
+			WHERE deleted_at IS NULL AND (user_id = ? OR other_user_id = ?)
+			GROUP BY user_id, other_user_id, currency
+		)
+		SELECT COALESCE(l.user_id, p.user_id) AS user_id,
+			COALESCE(l.other_user_id, p.other_user_id) AS other_user_id,
+			COALESCE(l.currency, p.currency) AS currency,
+			COALESCE(l.amount, 0) AS ledger_amount,
+			COALESCE(p.amount, 0) AS projected_amount
+		FROM ledger l
+		FULL OUTER JOIN projection p
+			ON l.user_id = p.user_id AND l.other_user_id = p.other_user_id AND l.currency = p.currency
+		WHERE COALESCE(l.amount, 0) <> COALESCE(p.amount, 0)
+		ORDER BY currency, user_id, other_user_id`,
+		Domain.LedgerAccountUser, userId, userId, userId, userId).Scan(&mismatches).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return mismatches, nil
+}
+
+func (repo *BalanceRepository) GetGroupBalanceMismatches(ctx context.Context, groupId uuid.UUID) ([]RepositoryPorts.BalanceMismatch, error) {
+	var mismatches []RepositoryPorts.BalanceMismatch
+	err := repo.db.DB.WithContext(ctx).Raw(`
+		WITH ledger AS (
+			SELECT group_id, user_id, currency, SUM(amount) AS amount
+			FROM ledger_entries
+			WHERE account = ? AND deleted_at IS NULL AND group_id = ?
+			GROUP BY group_id, user_id, currency
+		), projection AS (
+			SELECT group_id, user_id, currency, SUM(net_amount) AS amount
+			FROM group_balances
+			WHERE deleted_at IS NULL AND group_id = ?
+			GROUP BY group_id, user_id, currency
+		)
+		SELECT COALESCE(l.group_id, p.group_id) AS group_id,
+			COALESCE(l.user_id, p.user_id) AS user_id,
+			COALESCE(l.currency, p.currency) AS currency,
+			COALESCE(l.amount, 0) AS ledger_amount,
+			COALESCE(p.amount, 0) AS projected_amount
+		FROM ledger l
+		FULL OUTER JOIN projection p
+			ON l.user_id = p.user_id AND l.currency = p.currency
+		WHERE COALESCE(l.amount, 0) <> COALESCE(p.amount, 0)
+		ORDER BY currency, user_id`,
+		Domain.LedgerAccountGroup, groupId, groupId).Scan(&mismatches).Error
+	if err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
+	return mismatches, nil
+}
+
+func (repo *BalanceRepository) GetUnbalancedTransactionsForUser(ctx context.Context, userId uuid.UUID) ([]RepositoryPorts.UnbalancedTransaction, error) {
+	return repo.getUnbalancedTransactions(ctx, "user_id = ? OR counterparty_id = ?", userId, userId)
+}
+
+func (repo *BalanceRepository) GetUnbalancedTransactionsForGroup(ctx context.Context, groupId uuid.UUID) ([]RepositoryPorts.UnbalancedTransaction, error) {
+	return repo.getUnbalancedTransactions(ctx, "group_id = ?", groupId)
+}
 
-	if len(balances) == 0 {
-		return []Domain.SimplifiedDebt{}, nil
+func (repo *BalanceRepository) getUnbalancedTransactions(ctx context.Context, scope string, args ...interface{}) ([]RepositoryPorts.UnbalancedTransaction, error) {
+	scoped := repo.db.DB.WithContext(ctx).Model(&Domain.LedgerEntry{}).
+		Select("transaction_id").
+		Where(scope, args...)
+
+	var transactions []RepositoryPorts.UnbalancedTransaction
+	err := repo.db.DB.WithContext(ctx).Model(&Domain.LedgerEntry{}).
+		Select("transaction_id, account, currency, SUM(amount) AS total").
+		Where("transaction_id IN (?)", scoped).
+		Group("transaction_id, account, currency").
+		Having("SUM(amount) <> 0").
+		Order("transaction_id").
+		Scan(&transactions).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
+	return transactions, nil
+}
 
-	currencyBalances := make(map[Domain.Currency][]struct {
-		UserID   uuid.UUID
-		UserName string
-		Amount   int64
-	})
-
-	for _, b := range balances {
-		currencyBalances[b.Currency] = append(currencyBalances[b.Currency], struct {
-			UserID   uuid.UUID
-			UserName string
-			Amount   int64
-		}{
-			UserID:   b.UserID,
-			UserName: b.User.Name,
-			Amount:   b.NetAmount,
-		})
+// GetOutstandingReceivables returns, oldest split first, the part of each
+// debtor's outstanding share that is owed to the creditor as a payer: their
+// portion of the share less confirmed settlements paid to them.
+func (repo *BalanceRepository) GetOutstandingReceivables(ctx context.Context, creditorId uuid.UUID) ([]RepositoryPorts.Receivable, error) {
+	var participants []Domain.SplitParticipant
+	if err := repo.db.DB.WithContext(ctx).
+		Joins("JOIN splits ON splits.id = split_participants.split_id AND splits.deleted_at IS NULL").
+		Joins("JOIN split_payers ON split_payers.split_id = split_participants.split_id AND split_payers.user_id = ? AND split_payers.deleted_at IS NULL", creditorId).
+		Where("split_participants.user_id <> ?", creditorId).
+		Where("split_participants.settled_amount < split_participants.share_amount").
+		Preload("Split.Payers").
+		Preload("User").
+		Order("splits.created_at ASC, split_participants.id ASC").
+		Find(&participants).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	var simplifiedDebts []Domain.SimplifiedDebt
+	if len(participants) == 0 {
+		return []RepositoryPorts.Receivable{}, nil
+	}
 
-	for currency, userBalances := range currencyBalances {
-		type userAmount struct {
-			UserID   uuid.UUID
-			UserName string
-			Amount   int64
-		}
+	type settledRow struct {
+		SplitID uuid.UUID
+		PayerID uuid.UUID
+		Total   int64
+	}
+	var settledRows []settledRow
+	if err := repo.db.DB.WithContext(ctx).
+		Model(&Domain.Settlement{}).
+		Select("split_id, payer_id, COALESCE(SUM(amount), 0) AS total").
+		Where("payee_id = ? AND confirmed = ? AND split_id IS NOT NULL", creditorId, true).
+		Group("split_id, payer_id").
+		Scan(&settledRows).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
 
-		var creditors []userAmount
-		var debtors []userAmount
+	type splitDebtor struct {
+		splitId  uuid.UUID
+		debtorId uuid.UUID
+	}
+	settled := make(map[splitDebtor]int64, len(settledRows))
+	for _, row := range settledRows {
+		settled[splitDebtor{row.SplitID, row.PayerID}] = row.Total
+	}
 
-		for _, ub := range userBalances {
-			if ub.Amount > 0 {
-				creditors = append(creditors, userAmount{ub.UserID, ub.UserName, ub.Amount})
-			} else if ub.Amount < 0 {
-				debtors = append(debtors, userAmount{ub.UserID, ub.UserName, -ub.Amount})
-			}
+	receivables := make([]RepositoryPorts.Receivable, 0, len(participants))
+	for _, participant := range participants {
+		owed := Domain.OwedToPayer(participant, participant.Split.Payers, creditorId) - settled[splitDebtor{participant.SplitID, participant.UserID}]
+		if outstanding := participant.Outstanding(); owed > outstanding {
+			owed = outstanding
 		}
-
-		i, j := 0, 0
-		for i < len(debtors) && j < len(creditors) {
-			debtor := &debtors[i]
-			creditor := &creditors[j]
-
-			settleAmount := debtor.Amount
-			if creditor.Amount < settleAmount {
-				settleAmount = creditor.Amount
-			}
-
-			if settleAmount > 0 {
-				simplifiedDebts = append(simplifiedDebts, Domain.SimplifiedDebt{
-					FromUserID:   debtor.UserID,
-					FromUserName: debtor.UserName,
-					ToUserID:     creditor.UserID,
-					ToUserName:   creditor.UserName,
-					Amount:       settleAmount,
-					Currency:     currency,
-				})
-			}
-
-			debtor.Amount -= settleAmount
-			creditor.Amount -= settleAmount
-
-			if debtor.Amount == 0 {
-				i++
-			}
-			if creditor.Amount == 0 {
-				j++
-			}
+		if owed <= 0 {
+			continue
 		}
+
+		receivables = append(receivables, RepositoryPorts.Receivable{
+			SplitID:        participant.SplitID,
+			Description:    participant.Split.Description,
+			GroupID:        participant.Split.GroupID,
+			DebtorID:       participant.UserID,
+			DebtorName:     participant.User.Name,
+			Amount:         owed,
+			Currency:       participant.Currency,
+			SplitCreatedAt: participant.Split.CreatedAt,
+		})
 	}
 
-	return simplifiedDebts, nil
+	return receivables, nil
 }
@@ -0,0 +1,73 @@
+package RepositoryAdapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+type CategoryRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateCategoryRepository(db DB.PostgresDB) RepositoryPorts.CategoryRepositoryPort {
+	return &CategoryRepository{db: db}
+}
+
+func (repo *CategoryRepository) CreateCategory(ctx context.Context, category *Domain.Category) (*Domain.Category, error) {
+	if err := repo.db.DB.WithContext(ctx).Omit("Group", "CreatedBy").Create(category).Error; err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
+			return nil, fiber.NewError(fiber.StatusConflict, Errors.ErrCategoryAlreadyExists)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return category, nil
+}
+
+func (repo *CategoryRepository) GetCategoryById(ctx context.Context, categoryId uuid.UUID) (*Domain.Category, error) {
+	var category Domain.Category
+	if err := repo.db.DB.WithContext(ctx).First(&category, "id = ?", categoryId).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrCategoryNotFound)
+	}
+	return &category, nil
+}
+
+// GetCategories returns the system defaults followed by the custom categories
+// of groupId, if any.
+func (repo *CategoryRepository) GetCategories(ctx context.Context, groupId *uuid.UUID) ([]Domain.Category, error) {
+	query := repo.db.DB.WithContext(ctx).Model(&Domain.Category{})
+	if groupId != nil {
+		query = query.Where("group_id IS NULL OR group_id = ?", *groupId)
+	} else {
+		query = query.Where("group_id IS NULL")
+	}
+
+	var categories []Domain.Category
+	if err := query.Order("group_id NULLS FIRST, name").Find(&categories).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return categories, nil
+}
+
+// DeleteCategory removes a custom category for good. Splits that used it
+// become uncategorized through the foreign key.
+func (repo *CategoryRepository) DeleteCategory(ctx context.Context, groupId, categoryId uuid.UUID) error {
+	result := repo.db.DB.WithContext(ctx).Unscoped().
+		Where("id = ? AND group_id = ?", categoryId, groupId).
+		Delete(&Domain.Category{})
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrCategoryNotFound)
+	}
+	return nil
+}
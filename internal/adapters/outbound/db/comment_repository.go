@@ -0,0 +1,162 @@
+package RepositoryAdapters
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CommentRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateCommentRepository(db DB.PostgresDB) RepositoryPorts.CommentRepositoryPort {
+	return &CommentRepository{db: db}
+}
+
+// CreateComment stores the comment with its mentions. activity is nil when
+// nobody needs to be notified.
+func (repo *CommentRepository) CreateComment(ctx context.Context, comment *Domain.Comment, mentionIds []uuid.UUID, activity *Domain.ActivityEvent) (*Domain.Comment, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Omit("Split", "Settlement", "Parent", "Author", "Replies", "Mentions").Create(comment).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := saveMentionsTx(tx, comment.Id, mentionIds, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return repo.GetCommentById(ctx, comment.Id)
+}
+
+func (repo *CommentRepository) GetCommentById(ctx context.Context, commentId uuid.UUID) (*Domain.Comment, error) {
+	var comment Domain.Comment
+	if err := repo.db.DB.WithContext(ctx).Preload("Author").Preload("Mentions.User").
+		First(&comment, "id = ?", commentId).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrCommentNotFound)
+	}
+	return &comment, nil
+}
+
+// GetComments pages through the top-level comments of a thread, oldest first,
+// with all of their replies. Deleted top-level comments that still have
+// replies are kept so the replies have something to hang off.
+func (repo *CommentRepository) GetComments(ctx context.Context, targetType Domain.ActivityTargetType, targetId uuid.UUID, limit, offset int) ([]Domain.Comment, int64, error) {
+	column := "split_id"
+	if targetType == Domain.ActivityTargetSettlement {
+		column = "settlement_id"
+	}
+
+	baseQuery := repo.db.DB.WithContext(ctx).Unscoped().Model(&Domain.Comment{}).
+		Where(column+" = ? AND parent_id IS NULL", targetId).
+		Where("deleted_at IS NULL OR EXISTS (SELECT 1 FROM comments r WHERE r.parent_id = comments.id AND r.deleted_at IS NULL)")
+
+	var total int64
+	if err := baseQuery.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if total == 0 {
+		return []Domain.Comment{}, 0, nil
+	}
+
+	var comments []Domain.Comment
+	if err := baseQuery.Preload("Author").Preload("Mentions.User").
+		Preload("Replies", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("created_at ASC, id ASC")
+		}).
+		Preload("Replies.Author").Preload("Replies.Mentions.User").
+		Order("created_at ASC, id ASC").
+		Limit(limit).Offset(offset).
+		Find(&comments).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return comments, total, nil
+}
+
+func (repo *CommentRepository) UpdateComment(ctx context.Context, comment *Domain.Comment, mentionIds []uuid.UUID, activity *Domain.ActivityEvent) (*Domain.Comment, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Model(&Domain.Comment{}).Where("id = ?", comment.Id).
+		Updates(map[string]any{"body": comment.Body, "edited_at": comment.EditedAt})
+	if result.Error != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrCommentNotFound)
+	}
+
+	if err := tx.Unscoped().Where("comment_id = ?", comment.Id).Delete(&Domain.CommentMention{}).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := saveMentionsTx(tx, comment.Id, mentionIds, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return repo.GetCommentById(ctx, comment.Id)
+}
+
+// DeleteComment soft deletes the comment. Its mentions are kept so that the
+// row can be restored as it was.
+func (repo *CommentRepository) DeleteComment(ctx context.Context, commentId uuid.UUID) error {
+	result := repo.db.DB.WithContext(ctx).Where("id = ?", commentId).Delete(&Domain.Comment{})
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrCommentNotFound)
+	}
+	return nil
+}
+
+func saveMentionsTx(tx *gorm.DB, commentId uuid.UUID, mentionIds []uuid.UUID, activity *Domain.ActivityEvent) error {
+	if len(mentionIds) > 0 {
+		mentions := make([]Domain.CommentMention, len(mentionIds))
+		for i, userId := range mentionIds {
+			mentions[i] = Domain.CommentMention{CommentID: commentId, UserID: userId}
+		}
+		if err := tx.Omit("Comment", "User").Create(&mentions).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	if activity != nil {
+		return recordActivityTx(tx, activity)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package RepositoryAdapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm/clause"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	ExchangePorts "autobill-service/internal/ports/outbound/exchange"
+	Errors "autobill-service/pkg/errors"
+)
+
+type ExchangeRateRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateExchangeRateRepository(db DB.PostgresDB) ExchangePorts.ExchangeRateProvider {
+	return &ExchangeRateRepository{db: db}
+}
+
+func (repo *ExchangeRateRepository) GetRate(ctx context.Context, base, quote Domain.Currency, asOf time.Time) (*Domain.ExchangeRate, error) {
+	if base == quote {
+		rate := Domain.IdentityExchangeRate(base, asOf)
+		return &rate, nil
+	}
+
+	var candidates []Domain.ExchangeRate
+	err := repo.db.DB.WithContext(ctx).
+		Where("((base_currency = ? AND quote_currency = ?) OR (base_currency = ? AND quote_currency = ?)) AND effective_date <= ?", base, quote, quote, base, asOf).
+		Order("effective_date DESC").
+		Limit(2).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	rate, found := Domain.FindExchangeRate(candidates, base, quote, asOf)
+	if !found {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, Errors.ErrExchangeRateNotFound)
+	}
+	return &rate, nil
+}
+
+func (repo *ExchangeRateRepository) GetRates(ctx context.Context, asOf time.Time) ([]Domain.ExchangeRate, error) {
+	var rates []Domain.ExchangeRate
+	err := repo.db.DB.WithContext(ctx).
+		Raw(`SELECT DISTINCT ON (base_currency, quote_currency) *
+			FROM exchange_rates
+			WHERE deleted_at IS NULL AND effective_date <= ?
+			ORDER BY base_currency, quote_currency, effective_date DESC`, asOf).
+		Scan(&rates).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return rates, nil
+}
+
+func (repo *ExchangeRateRepository) SaveRates(ctx context.Context, rates []Domain.ExchangeRate) ([]Domain.ExchangeRate, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	for i := range rates {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}, {Name: "effective_date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "source", "updated_at"}),
+		}).Create(&rates[i]).Error
+		if err != nil {
+			tx.Rollback()
+			return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return rates, nil
+}
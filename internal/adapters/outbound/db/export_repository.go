@@ -0,0 +1,144 @@
+package RepositoryAdapters
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+type ExportRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateExportRepository(db DB.PostgresDB) RepositoryPorts.ExportRepositoryPort {
+	return &ExportRepository{db: db}
+}
+
+// StreamSplitShares emits a row for everyone who paid for or has a share in
+// a split, so payers who are not participants are exported as well.
+func (repo *ExportRepository) StreamSplitShares(ctx context.Context, filter RepositoryPorts.ExportFilter, fn func(*RepositoryPorts.ExportShareRow) error) error {
+	query := repo.db.DB.WithContext(ctx).
+		Table("splits s").
+		Select("s.id AS split_id, s.created_at, s.group_id, g.name AS group_name, s.description, " +
+			"c.name AS category_name, s.currency, s.total_amount, " +
+			"u.id AS user_id, u.name AS user_name, u.email AS user_email, " +
+			"COALESCE(sp.paid_amount, 0) AS paid_amount, COALESCE(p.share_amount, 0) AS share_amount, " +
+			"COALESCE(p.settled_amount, 0) AS settled_amount, COALESCE(p.is_settled, TRUE) AS is_settled").
+		Joins("CROSS JOIN LATERAL (SELECT user_id FROM split_participants WHERE split_id = s.id AND deleted_at IS NULL " +
+			"UNION SELECT user_id FROM split_payers WHERE split_id = s.id AND deleted_at IS NULL) m").
+		Joins("JOIN users u ON u.id = m.user_id").
+		Joins("LEFT JOIN split_participants p ON p.split_id = s.id AND p.user_id = m.user_id AND p.deleted_at IS NULL").
+		Joins("LEFT JOIN split_payers sp ON sp.split_id = s.id AND sp.user_id = m.user_id AND sp.deleted_at IS NULL").
+		Joins("LEFT JOIN groups g ON g.id = s.group_id").
+		Joins("LEFT JOIN categories c ON c.id = s.category_id").
+		Where("s.deleted_at IS NULL")
+
+	if filter.GroupID != nil {
+		query = query.Where("s.group_id = ?", *filter.GroupID)
+	}
+	if filter.UserID != nil {
+		query = query.Where("s.created_by_id = ? "+
+			"OR EXISTS (SELECT 1 FROM split_participants WHERE split_id = s.id AND user_id = ? AND deleted_at IS NULL) "+
+			"OR EXISTS (SELECT 1 FROM split_payers WHERE split_id = s.id AND user_id = ? AND deleted_at IS NULL)",
+			*filter.UserID, *filter.UserID, *filter.UserID)
+	}
+	if filter.From != nil {
+		query = query.Where("s.created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("s.created_at < ?", *filter.To)
+	}
+
+	return streamRows(repo.db.DB, query.Order("s.created_at, s.id, u.name, u.id"), fn)
+}
+
+func (repo *ExportRepository) StreamSettlements(ctx context.Context, filter RepositoryPorts.ExportFilter, fn func(*RepositoryPorts.ExportSettlementRow) error) error {
+	query := repo.db.DB.WithContext(ctx).
+		Table("settlements st").
+		Select("st.id AS settlement_id, st.split_id, st.date, g.id AS group_id, g.name AS group_name, "+
+			"s.description, st.currency, st.amount, "+
+			"payer.id AS payer_id, payer.name AS payer_name, payer.email AS payer_email, "+
+			"payee.id AS payee_id, payee.name AS payee_name, payee.email AS payee_email, "+
+			"st.status, st.confirmed, confirmation.confirmed_at").
+		Joins("JOIN users payer ON payer.id = st.payer_id").
+		Joins("JOIN users payee ON payee.id = st.payee_id").
+		Joins("LEFT JOIN splits s ON s.id = st.split_id").
+		Joins("LEFT JOIN groups g ON g.id = COALESCE(st.group_id, s.group_id)").
+		Joins("LEFT JOIN LATERAL (SELECT MAX(created_at) AS confirmed_at FROM settlement_events "+
+			"WHERE settlement_id = st.id AND to_status = ? AND deleted_at IS NULL) confirmation ON TRUE", Domain.SettlementConfirmed).
+		Where("st.deleted_at IS NULL")
+
+	if filter.GroupID != nil {
+		query = query.Where("COALESCE(st.group_id, s.group_id) = ?", *filter.GroupID)
+	}
+	if filter.UserID != nil {
+		query = query.Where("st.payer_id = ? OR st.payee_id = ?", *filter.UserID, *filter.UserID)
+	}
+	if filter.From != nil {
+		query = query.Where("st.date >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("st.date < ?", *filter.To)
+	}
+
+	return streamRows(repo.db.DB, query.Order("st.date, st.id"), fn)
+}
+
+// StreamBalances exports balances as they are now; the date range does not
+// apply to them.
+func (repo *ExportRepository) StreamBalances(ctx context.Context, filter RepositoryPorts.ExportFilter, fn func(*RepositoryPorts.ExportBalanceRow) error) error {
+	var query *gorm.DB
+	if filter.GroupID != nil {
+		query = repo.db.DB.WithContext(ctx).
+			Table("group_balances b").
+			Select("g.id AS group_id, g.name AS group_name, u.id AS user_id, u.name AS user_name, u.email AS user_email, "+
+				"b.currency, b.net_amount").
+			Joins("JOIN groups g ON g.id = b.group_id").
+			Joins("JOIN users u ON u.id = b.user_id").
+			Where("b.group_id = ? AND b.deleted_at IS NULL AND b.net_amount <> 0", *filter.GroupID).
+			Order("b.currency, u.name, u.id")
+	} else {
+		query = repo.db.DB.WithContext(ctx).
+			Table("user_balances b").
+			Select("u.id AS user_id, u.name AS user_name, u.email AS user_email, "+
+				"o.id AS counterparty_id, o.name AS counterparty_name, o.email AS counterparty_email, "+
+				"b.currency, b.net_amount").
+			Joins("JOIN users u ON u.id = b.user_id").
+			Joins("JOIN users o ON o.id = b.other_user_id").
+			Where("b.user_id = ? AND b.deleted_at IS NULL AND b.net_amount <> 0", *filter.UserID).
+			Order("b.currency, o.name, o.id")
+	}
+
+	return streamRows(repo.db.DB, query, fn)
+}
+
+// streamRows walks the result of query with a cursor instead of loading it
+// into memory. Errors returned by fn are passed through unchanged.
+func streamRows[T any](db *gorm.DB, query *gorm.DB, fn func(*T) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row T
+		if err := db.ScanRows(rows, &row); err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
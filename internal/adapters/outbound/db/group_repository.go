@@ -21,7 +21,7 @@ func CreateGroupRepository(db DB.PostgresDB) RepositoryPorts.GroupRepositoryPort
 	return &GroupRepository{db: db}
 }
 
-func (repo *GroupRepository) CreateGroup(ctx context.Context, name string, ownerId uuid.UUID, simplifyDebts bool) (*Domain.Group, error) {
+func (repo *GroupRepository) CreateGroup(ctx context.Context, name string, ownerId uuid.UUID, simplifyDebts bool, settlementCurrency *Domain.Currency, activity *Domain.ActivityEvent) (*Domain.Group, error) {
 	tx := repo.db.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -30,9 +30,10 @@ func (repo *GroupRepository) CreateGroup(ctx context.Context, name string, owner
 	}()
 
 	group := Domain.Group{
-		Name:          name,
-		OwnerID:       ownerId,
-		SimplifyDebts: simplifyDebts,
+		Name:               name,
+		OwnerID:            ownerId,
+		SimplifyDebts:      simplifyDebts,
+		SettlementCurrency: settlementCurrency,
 	}
 	if err := tx.Create(&group).Error; err != nil {
 		tx.Rollback()
@@ -49,6 +50,13 @@ func (repo *GroupRepository) CreateGroup(ctx context.Context, name string, owner
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
+	activity.TargetID = group.Id
+	activity.GroupID = &group.Id
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
@@ -87,13 +95,31 @@ func (repo *GroupRepository) GetGroupById(ctx context.Context, groupId uuid.UUID
 	return &group, nil
 }
 
-func (repo *GroupRepository) UpdateGroup(ctx context.Context, groupId uuid.UUID, updates map[string]interface{}) (*Domain.Group, error) {
+func (repo *GroupRepository) UpdateGroup(ctx context.Context, groupId uuid.UUID, updates map[string]interface{}, activity *Domain.ActivityEvent) (*Domain.Group, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
 	var group Domain.Group
-	if err := repo.db.DB.WithContext(ctx).First(&group, "id = ?", groupId).Error; err != nil {
+	if err := tx.First(&group, "id = ?", groupId).Error; err != nil {
+		tx.Rollback()
 		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrGroupNotFound)
 	}
 
-	if err := repo.db.DB.WithContext(ctx).Model(&group).Updates(updates).Error; err != nil {
+	if err := tx.Model(&group).Updates(updates).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
@@ -108,22 +134,42 @@ func (repo *GroupRepository) GetGroupWithMembers(ctx context.Context, groupId uu
 	return &group, nil
 }
 
-func (repo *GroupRepository) DeleteGroup(ctx context.Context, groupId uuid.UUID) error {
+func (repo *GroupRepository) DeleteGroup(ctx context.Context, groupId uuid.UUID, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
 	var activeSplitCount int64
-	if err := repo.db.DB.WithContext(ctx).Model(&Domain.Split{}).Where("group_id = ?", groupId).Count(&activeSplitCount).Error; err != nil {
+	if err := tx.Model(&Domain.Split{}).Where("group_id = ?", groupId).Count(&activeSplitCount).Error; err != nil {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 	if activeSplitCount > 0 {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrGroupHasActiveSplits)
 	}
 
-	if err := repo.db.DB.WithContext(ctx).Delete(&Domain.Group{}, "id = ?", groupId).Error; err != nil {
+	if err := tx.Delete(&Domain.Group{}, "id = ?", groupId).Error; err != nil {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
 	return nil
 }
 
-func (repo *GroupRepository) AddMember(ctx context.Context, groupId, userId uuid.UUID, role Domain.GroupRole) (*Domain.GroupMembership, error) {
+func (repo *GroupRepository) AddMember(ctx context.Context, groupId, userId uuid.UUID, role Domain.GroupRole, activity *Domain.ActivityEvent) (*Domain.GroupMembership, error) {
 	tx := repo.db.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -153,6 +199,11 @@ func (repo *GroupRepository) AddMember(ctx context.Context, groupId, userId uuid
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
@@ -168,20 +219,39 @@ func (repo *GroupRepository) GetMembership(ctx context.Context, groupId, userId
 	return &membership, nil
 }
 
-func (repo *GroupRepository) UpdateMemberRole(ctx context.Context, groupId, userId uuid.UUID, role Domain.GroupRole) error {
-	result := repo.db.DB.WithContext(ctx).Model(&Domain.GroupMembership{}).
+func (repo *GroupRepository) UpdateMemberRole(ctx context.Context, groupId, userId uuid.UUID, role Domain.GroupRole, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Model(&Domain.GroupMembership{}).
 		Where("group_id = ? AND user_id = ?", groupId, userId).
 		Update("role", role)
 	if result.Error != nil {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 	if result.RowsAffected == 0 {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrNotGroupMember)
 	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
 	return nil
 }
 
-func (repo *GroupRepository) TransferOwnership(ctx context.Context, groupId, currentOwnerId, newOwnerId uuid.UUID) error {
+func (repo *GroupRepository) TransferOwnership(ctx context.Context, groupId, currentOwnerId, newOwnerId uuid.UUID, activity *Domain.ActivityEvent) error {
 	tx := repo.db.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -216,6 +286,11 @@ func (repo *GroupRepository) TransferOwnership(ctx context.Context, groupId, cur
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
@@ -223,14 +298,33 @@ func (repo *GroupRepository) TransferOwnership(ctx context.Context, groupId, cur
 	return nil
 }
 
-func (repo *GroupRepository) RemoveMember(ctx context.Context, groupId, userId uuid.UUID) error {
-	result := repo.db.DB.WithContext(ctx).Delete(&Domain.GroupMembership{}, "group_id = ? AND user_id = ?", groupId, userId)
+func (repo *GroupRepository) RemoveMember(ctx context.Context, groupId, userId uuid.UUID, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Delete(&Domain.GroupMembership{}, "group_id = ? AND user_id = ?", groupId, userId)
 	if result.Error != nil {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 	if result.RowsAffected == 0 {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrNotGroupMember)
 	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
 	return nil
 }
 
@@ -249,3 +343,76 @@ func (repo *GroupRepository) IsGroupOwner(ctx context.Context, groupId, userId u
 	}
 	return membership.Role == Domain.GroupRoleOwner, nil
 }
+
+func (repo *GroupRepository) AddGuest(ctx context.Context, groupId uuid.UUID, guest *Domain.User, activity *Domain.ActivityEvent) (*Domain.GroupMembership, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Create(guest).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	membership := Domain.GroupMembership{
+		GroupID: groupId,
+		UserID:  guest.Id,
+		Role:    Domain.GroupRoleMember,
+		User:    *guest,
+	}
+	if err := tx.Omit("User", "Group").Create(&membership).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	activity.TargetID = guest.Id
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return &membership, nil
+}
+
+func (repo *GroupRepository) GetGuestsByEmail(ctx context.Context, email string) ([]Domain.User, error) {
+	var guests []Domain.User
+	if err := repo.db.DB.WithContext(ctx).Preload("GroupMemberships").
+		Where("status = ? AND email <> '' AND LOWER(email) = LOWER(?)", Domain.AccountGuest, email).
+		Order("created_at ASC").
+		Find(&guests).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return guests, nil
+}
+
+func (repo *GroupRepository) ClaimGuest(ctx context.Context, guestId, userId uuid.UUID, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := claimGuestTx(tx, guestId, userId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return nil
+}
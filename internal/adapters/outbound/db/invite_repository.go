@@ -0,0 +1,188 @@
+package RepositoryAdapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type InviteRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateInviteRepository(db DB.PostgresDB) RepositoryPorts.InviteRepositoryPort {
+	return &InviteRepository{db: db}
+}
+
+func (repo *InviteRepository) CreateInvite(ctx context.Context, invite *Domain.GroupInvite) (*Domain.GroupInvite, error) {
+	if err := repo.db.DB.WithContext(ctx).Omit("Group", "CreatedBy").Create(invite).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := repo.db.DB.WithContext(ctx).Preload("CreatedBy").First(invite, "id = ?", invite.Id).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return invite, nil
+}
+
+func (repo *InviteRepository) GetInviteById(ctx context.Context, groupId, inviteId uuid.UUID) (*Domain.GroupInvite, error) {
+	var invite Domain.GroupInvite
+	if err := repo.db.DB.WithContext(ctx).Preload("CreatedBy").
+		Where("id = ? AND group_id = ?", inviteId, groupId).
+		First(&invite).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrInviteNotFound)
+	}
+	return &invite, nil
+}
+
+func (repo *InviteRepository) GetInviteByTokenHash(ctx context.Context, tokenHash string) (*Domain.GroupInvite, error) {
+	var invite Domain.GroupInvite
+	if err := repo.db.DB.WithContext(ctx).Preload("Group").
+		Where("token_hash = ?", tokenHash).
+		First(&invite).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrInviteNotFound)
+	}
+	return &invite, nil
+}
+
+func (repo *InviteRepository) GetPendingInvites(ctx context.Context, groupId uuid.UUID, now time.Time, limit, offset int) ([]Domain.GroupInvite, int64, error) {
+	baseQuery := repo.pendingInvites(ctx, now).Where("group_id = ?", groupId)
+
+	var total int64
+	if err := baseQuery.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if total == 0 {
+		return []Domain.GroupInvite{}, 0, nil
+	}
+
+	var invites []Domain.GroupInvite
+	if err := baseQuery.Preload("CreatedBy").
+		Order("created_at DESC, id DESC").
+		Limit(limit).Offset(offset).
+		Find(&invites).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return invites, total, nil
+}
+
+func (repo *InviteRepository) GetPendingEmailInvites(ctx context.Context, email string, now time.Time) ([]Domain.GroupInvite, error) {
+	var invites []Domain.GroupInvite
+	if err := repo.pendingInvites(ctx, now).
+		Where("LOWER(email) = LOWER(?)", email).
+		Order("created_at ASC").
+		Find(&invites).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return invites, nil
+}
+
+func (repo *InviteRepository) pendingInvites(ctx context.Context, now time.Time) *gorm.DB {
+	return repo.db.DB.WithContext(ctx).Model(&Domain.GroupInvite{}).
+		Where("revoked_at IS NULL AND uses < max_uses AND expires_at > ?", now)
+}
+
+func (repo *InviteRepository) RevokeInvite(ctx context.Context, groupId, inviteId uuid.UUID, now time.Time) error {
+	result := repo.db.DB.WithContext(ctx).Model(&Domain.GroupInvite{}).
+		Where("id = ? AND group_id = ? AND revoked_at IS NULL", inviteId, groupId).
+		Update("revoked_at", now)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrInviteNotFound)
+	}
+	return nil
+}
+
+// AcceptInvite re-checks the invite under a row lock so that concurrent joins
+// cannot exceed its max uses. Guest invites merge the guest into the user
+// instead of adding a new membership.
+func (repo *InviteRepository) AcceptInvite(ctx context.Context, inviteId uuid.UUID, user *Domain.User, activity *Domain.ActivityEvent) (*Domain.GroupMembership, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var invite Domain.GroupInvite
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&invite, "id = ?", inviteId).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrInviteNotFound)
+	}
+	if !invite.IsPending(time.Now()) {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusGone, Errors.ErrInviteNoLongerValid)
+	}
+	if !invite.AllowsEmail(user.Email) {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusForbidden, Errors.ErrInviteEmailMismatch)
+	}
+
+	if invite.GuestID != nil {
+		if err := claimGuestTx(tx, *invite.GuestID, user.Id); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	} else if err := addInvitedMemberTx(tx, &invite, user.Id); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Model(&invite).Update("uses", gorm.Expr("uses + 1")).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	var membership Domain.GroupMembership
+	if err := tx.Preload("Group").Where("group_id = ? AND user_id = ?", invite.GroupID, user.Id).First(&membership).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return &membership, nil
+}
+
+func addInvitedMemberTx(tx *gorm.DB, invite *Domain.GroupInvite, userId uuid.UUID) error {
+	var existing int64
+	if err := tx.Model(&Domain.GroupMembership{}).
+		Where("group_id = ? AND user_id = ?", invite.GroupID, userId).
+		Count(&existing).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if existing > 0 {
+		return fiber.NewError(fiber.StatusConflict, Errors.ErrUserAlreadyMember)
+	}
+
+	membership := Domain.GroupMembership{
+		GroupID: invite.GroupID,
+		UserID:  userId,
+		Role:    invite.Role,
+	}
+	if err := tx.Create(&membership).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
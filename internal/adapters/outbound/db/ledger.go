@@ -0,0 +1,150 @@
+package RepositoryAdapters
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// postLedgerEntriesTx appends entries to the ledger and applies them to the
+// user_balances and group_balances projections in the same transaction.
+func postLedgerEntriesTx(tx *gorm.DB, entries []Domain.LedgerEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := tx.Create(&entries).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	for _, entry := range entries {
+		var err error
+		switch entry.Account {
+		case Domain.LedgerAccountUser:
+			err = adjustUserBalanceTx(tx, entry.UserID, *entry.CounterpartyID, entry.Currency, entry.Amount)
+		case Domain.LedgerAccountGroup:
+			err = adjustGroupBalanceTx(tx, *entry.GroupID, entry.UserID, entry.Currency, entry.Amount)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func adjustUserBalanceTx(tx *gorm.DB, userId, otherUserId uuid.UUID, currency Domain.Currency, amount int64) error {
+	var balance Domain.UserBalance
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND other_user_id = ? AND currency = ?", userId, otherUserId, currency).
+		First(&balance).Error
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+		balance = Domain.UserBalance{
+			UserID:      userId,
+			OtherUserID: otherUserId,
+			NetAmount:   0,
+			Currency:    currency,
+		}
+		if createErr := tx.Create(&balance).Error; createErr != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	balance.NetAmount += amount
+	if err := tx.Save(&balance).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
+func adjustGroupBalanceTx(tx *gorm.DB, groupId, userId uuid.UUID, currency Domain.Currency, amount int64) error {
+	var balance Domain.GroupBalance
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("group_id = ? AND user_id = ? AND currency = ?", groupId, userId, currency).
+		First(&balance).Error
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+		balance = Domain.GroupBalance{
+			GroupID:   groupId,
+			UserID:    userId,
+			NetAmount: 0,
+			Currency:  currency,
+		}
+		if createErr := tx.Create(&balance).Error; createErr != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	balance.NetAmount += amount
+	if err := tx.Save(&balance).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
+// rebuildUserBalancesTx replaces every user_balances row involving userId with
+// the sums of its ledger entries.
+func rebuildUserBalancesTx(tx *gorm.DB, userId uuid.UUID) error {
+	if err := tx.Delete(&Domain.UserBalance{}, "user_id = ? OR other_user_id = ?", userId, userId).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	err := tx.Exec(`
+		INSERT INTO user_balances (id, created_at, updated_at, user_id, other_user_id, net_amount, currency)
+		SELECT gen_random_uuid(), NOW(), NOW(), user_id, counterparty_id, SUM(amount), currency
+		FROM ledger_entries
+		WHERE account = ? AND deleted_at IS NULL AND (user_id = ? OR counterparty_id = ?)
+		GROUP BY user_id, counterparty_id, currency
+		HAVING SUM(amount) <> 0`, Domain.LedgerAccountUser, userId, userId).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
+func rebuildGroupBalancesTx(tx *gorm.DB, groupId uuid.UUID) error {
+	if err := tx.Delete(&Domain.GroupBalance{}, "group_id = ?", groupId).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	err := tx.Exec(`
+		INSERT INTO group_balances (id, created_at, updated_at, group_id, user_id, net_amount, currency)
+		SELECT gen_random_uuid(), NOW(), NOW(), group_id, user_id, SUM(amount), currency
+		FROM ledger_entries
+		WHERE account = ? AND deleted_at IS NULL AND group_id = ?
+		GROUP BY group_id, user_id, currency
+		HAVING SUM(amount) <> 0`, Domain.LedgerAccountGroup, groupId).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
+func postSplitLedgerEntriesTx(tx *gorm.DB, split *Domain.Split, payers []Domain.SplitPayer, participants []Domain.SplitParticipant, sign int64) error {
+	return postLedgerEntriesTx(tx, Domain.SplitLedgerEntries(split, payers, participants, sign))
+}
+
+func postSettlementLedgerEntriesTx(tx *gorm.DB, settlement *Domain.Settlement, sign int64) error {
+	groupId := settlement.GroupID
+	if !settlement.IsDirect() {
+		var split Domain.Split
+		if err := tx.Select("id", "group_id").First(&split, "id = ?", *settlement.SplitID).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+		groupId = split.GroupID
+	}
+
+	return postLedgerEntriesTx(tx, Domain.SettlementLedgerEntries(settlement, groupId, sign))
+}
@@ -0,0 +1,133 @@
+package RepositoryAdapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateNotificationRepository(db DB.PostgresDB) RepositoryPorts.NotificationRepositoryPort {
+	return &NotificationRepository{db: db}
+}
+
+func (repo *NotificationRepository) ClaimDueNotifications(ctx context.Context, digest bool, now time.Time, lease time.Duration, limit int) ([]Domain.Notification, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	activeUsers := tx.Model(&Domain.User{}).
+		Select("id").
+		Where("status = ?", Domain.AccountActive)
+
+	var claimed []Domain.Notification
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND digest = ? AND next_attempt_at <= ?", Domain.NotificationPending, digest, now).
+		Where("user_id IN (?)", activeUsers).
+		Order("user_id ASC, created_at ASC").
+		Limit(limit).
+		Find(&claimed).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if len(claimed) == 0 {
+		tx.Rollback()
+		return claimed, nil
+	}
+
+	notificationIds := make([]uuid.UUID, len(claimed))
+	for i, notification := range claimed {
+		notificationIds[i] = notification.Id
+	}
+
+	if err := tx.Model(&Domain.Notification{}).
+		Where("id IN ?", notificationIds).
+		Update("next_attempt_at", now.Add(lease)).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	var notifications []Domain.Notification
+	if err := repo.db.DB.WithContext(ctx).
+		Preload("User").
+		Preload("ActivityEvent.Actor").
+		Where("id IN ?", notificationIds).
+		Order("user_id ASC, created_at ASC").
+		Find(&notifications).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return notifications, nil
+}
+
+func (repo *NotificationRepository) SaveNotificationAttempt(ctx context.Context, notification *Domain.Notification) error {
+	if err := repo.db.DB.WithContext(ctx).Model(&Domain.Notification{}).
+		Where("id = ?", notification.Id).
+		Updates(map[string]any{
+			"status":          notification.Status,
+			"attempts":        notification.Attempts,
+			"next_attempt_at": notification.NextAttemptAt,
+			"last_error":      notification.LastError,
+			"sent_at":         notification.SentAt,
+		}).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
+// enqueueNotificationsTx writes an email outbox row for every member of the
+// activity's audience, other than the actor, whose preferences ask for it.
+func enqueueNotificationsTx(tx *gorm.DB, activity *Domain.ActivityEvent) error {
+	if !Domain.IsNotificationEvent(activity.Action) {
+		return nil
+	}
+
+	recipientIds := make([]uuid.UUID, 0, len(activity.Audience))
+	for _, member := range activity.Audience {
+		if member.UserID != activity.ActorID {
+			recipientIds = append(recipientIds, member.UserID)
+		}
+	}
+	if len(recipientIds) == 0 {
+		return nil
+	}
+
+	var recipients []Domain.User
+	if err := tx.Where("id IN ? AND status = ?", recipientIds, Domain.AccountActive).Find(&recipients).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	now := time.Now().UTC()
+	for _, recipient := range recipients {
+		if !recipient.Notifications.Wants(activity.Action) {
+			continue
+		}
+		notification := Domain.NewNotification(recipient.Id, activity, recipient.Notifications, now)
+		if err := tx.Omit("User", "ActivityEvent").Create(notification).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	return nil
+}
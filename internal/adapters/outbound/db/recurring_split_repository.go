@@ -0,0 +1,149 @@
+package RepositoryAdapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+type RecurringSplitRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateRecurringSplitRepository(db DB.PostgresDB) RepositoryPorts.RecurringSplitRepositoryPort {
+	return &RecurringSplitRepository{db: db}
+}
+
+func (repo *RecurringSplitRepository) CreateRecurringSplit(ctx context.Context, recurringSplit *Domain.RecurringSplit, participants []Domain.RecurringSplitParticipant) (*Domain.RecurringSplit, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Create(recurringSplit).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	for i := range participants {
+		participants[i].RecurringSplitID = recurringSplit.Id
+		if err := tx.Create(&participants[i]).Error; err != nil {
+			tx.Rollback()
+			return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return repo.GetRecurringSplitById(ctx, recurringSplit.Id)
+}
+
+func (repo *RecurringSplitRepository) GetRecurringSplitById(ctx context.Context, recurringSplitId uuid.UUID) (*Domain.RecurringSplit, error) {
+	var recurringSplit Domain.RecurringSplit
+	if err := repo.db.DB.WithContext(ctx).Preload("Participants.User").First(&recurringSplit, "id = ?", recurringSplitId).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrRecurringSplitNotFound)
+	}
+	return &recurringSplit, nil
+}
+
+func (repo *RecurringSplitRepository) GetRecurringSplitsByUserId(ctx context.Context, userId uuid.UUID, limit, offset int) ([]Domain.RecurringSplit, int64, error) {
+	var recurringSplits []Domain.RecurringSplit
+	var total int64
+
+	query := repo.db.DB.WithContext(ctx).Model(&Domain.RecurringSplit{}).Where("created_by_id = ?", userId)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if total == 0 {
+		return []Domain.RecurringSplit{}, 0, nil
+	}
+
+	if err := query.Preload("Participants.User").Order("created_at DESC").Limit(limit).Offset(offset).Find(&recurringSplits).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return recurringSplits, total, nil
+}
+
+func (repo *RecurringSplitRepository) UpdateRecurringSplit(ctx context.Context, recurringSplitId uuid.UUID, updates map[string]any, participants []Domain.RecurringSplitParticipant) (*Domain.RecurringSplit, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if len(updates) > 0 {
+		if err := tx.Model(&Domain.RecurringSplit{}).Where("id = ?", recurringSplitId).Updates(updates).Error; err != nil {
+			tx.Rollback()
+			return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	if participants != nil {
+		if err := tx.Unscoped().Delete(&Domain.RecurringSplitParticipant{}, "recurring_split_id = ?", recurringSplitId).Error; err != nil {
+			tx.Rollback()
+			return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+
+		for i := range participants {
+			participants[i].RecurringSplitID = recurringSplitId
+			if err := tx.Create(&participants[i]).Error; err != nil {
+				tx.Rollback()
+				return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+			}
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return repo.GetRecurringSplitById(ctx, recurringSplitId)
+}
+
+func (repo *RecurringSplitRepository) DeleteRecurringSplit(ctx context.Context, recurringSplitId uuid.UUID) error {
+	if err := repo.db.DB.WithContext(ctx).Delete(&Domain.RecurringSplit{}, "id = ?", recurringSplitId).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
+func (repo *RecurringSplitRepository) GetDueRecurringSplits(ctx context.Context, now time.Time, limit int) ([]Domain.RecurringSplit, error) {
+	var recurringSplits []Domain.RecurringSplit
+	if err := repo.db.DB.WithContext(ctx).
+		Preload("Participants").
+		Where("is_active = ? AND next_run_at <= ?", true, now).
+		Order("next_run_at ASC").
+		Limit(limit).
+		Find(&recurringSplits).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return recurringSplits, nil
+}
+
+func (repo *RecurringSplitRepository) UpdateRecurringSplitSchedule(ctx context.Context, recurringSplitId uuid.UUID, nextRunAt time.Time, lastRunAt *time.Time, isActive bool) error {
+	if err := repo.db.DB.WithContext(ctx).
+		Model(&Domain.RecurringSplit{}).
+		Where("id = ?", recurringSplitId).
+		Updates(map[string]any{
+			"next_run_at": nextRunAt,
+			"last_run_at": lastRunAt,
+			"is_active":   isActive,
+		}).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
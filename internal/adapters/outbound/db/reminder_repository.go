@@ -0,0 +1,150 @@
+package RepositoryAdapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ReminderRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateReminderRepository(db DB.PostgresDB) RepositoryPorts.ReminderRepositoryPort {
+	return &ReminderRepository{db: db}
+}
+
+func (repo *ReminderRepository) CreateReminder(ctx context.Context, reminder *Domain.PaymentReminder, activity *Domain.ActivityEvent) (*Domain.PaymentReminder, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := createReminderTx(tx, reminder, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return reminder, nil
+}
+
+// CreateReminderWithCooldown creates the reminder unless its sender already
+// reminded the debtor within cooldown. The pair is locked for the rest of the
+// transaction, so concurrent reminders cannot both pass the check.
+func (repo *ReminderRepository) CreateReminderWithCooldown(ctx context.Context, reminder *Domain.PaymentReminder, activity *Domain.ActivityEvent, cooldown time.Duration) (*Domain.PaymentReminder, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	pairKey := "payment_reminders:" + reminder.SenderID.String() + ":" + reminder.DebtorID.String()
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", pairKey).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	var recent int64
+	if err := tx.Model(&Domain.PaymentReminder{}).
+		Where("sender_id = ? AND debtor_id = ? AND created_at > ?", *reminder.SenderID, reminder.DebtorID, time.Now().Add(-cooldown)).
+		Count(&recent).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if recent > 0 {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusTooManyRequests, Errors.ErrReminderRateLimited)
+	}
+
+	if err := createReminderTx(tx, reminder, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return reminder, nil
+}
+
+func createReminderTx(tx *gorm.DB, reminder *Domain.PaymentReminder, activity *Domain.ActivityEvent) error {
+	if err := tx.Omit("Split", "Sender", "Debtor").Create(reminder).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return recordActivityTx(tx, activity)
+}
+
+func (repo *ReminderRepository) GetSentReminders(ctx context.Context, senderId uuid.UUID, limit, offset int) ([]Domain.PaymentReminder, int64, error) {
+	baseQuery := repo.db.DB.WithContext(ctx).Model(&Domain.PaymentReminder{}).
+		Where("sender_id = ?", senderId)
+
+	return repo.findReminders(baseQuery, limit, offset)
+}
+
+func (repo *ReminderRepository) GetReceivedReminders(ctx context.Context, debtorId uuid.UUID, limit, offset int) ([]Domain.PaymentReminder, int64, error) {
+	baseQuery := repo.db.DB.WithContext(ctx).Model(&Domain.PaymentReminder{}).
+		Where("debtor_id = ?", debtorId)
+
+	return repo.findReminders(baseQuery, limit, offset)
+}
+
+func (repo *ReminderRepository) findReminders(baseQuery *gorm.DB, limit, offset int) ([]Domain.PaymentReminder, int64, error) {
+	var total int64
+	if err := baseQuery.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if total == 0 {
+		return []Domain.PaymentReminder{}, 0, nil
+	}
+
+	var reminders []Domain.PaymentReminder
+	if err := baseQuery.Preload("Split").Preload("Sender").Preload("Debtor").
+		Order("created_at DESC, id DESC").
+		Limit(limit).Offset(offset).
+		Find(&reminders).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return reminders, total, nil
+}
+
+func (repo *ReminderRepository) GetParticipantsDueForReminder(ctx context.Context, createdBefore, remindedSince time.Time, limit int) ([]Domain.SplitParticipant, error) {
+	recentReminders := repo.db.DB.WithContext(ctx).Model(&Domain.PaymentReminder{}).
+		Select("1").
+		Where("payment_reminders.split_id = split_participants.split_id AND payment_reminders.debtor_id = split_participants.user_id").
+		Where("payment_reminders.created_at >= ?", remindedSince)
+
+	var participants []Domain.SplitParticipant
+	if err := repo.db.DB.WithContext(ctx).
+		Joins("JOIN splits ON splits.id = split_participants.split_id AND splits.deleted_at IS NULL").
+		Joins("JOIN users ON users.id = split_participants.user_id AND users.status = ?", Domain.AccountActive).
+		Where("split_participants.settled_amount < split_participants.share_amount").
+		Where("splits.created_at <= ?", createdBefore).
+		Where("NOT EXISTS (?)", recentReminders).
+		Preload("Split.Payers").
+		Order("splits.created_at ASC, split_participants.id ASC").
+		Limit(limit).
+		Find(&participants).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return participants, nil
+}
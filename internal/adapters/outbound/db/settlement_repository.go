@@ -2,7 +2,6 @@ package RepositoryAdapters
 
 import (
 	"context"
-	"errors"
 
 	"github.com/gofiber/fiber/v2"
 
@@ -24,8 +23,39 @@ func CreateSettlementRepository(db DB.PostgresDB) RepositoryPorts.SettlementRepo
 	return &SettlementRepository{db: db}
 }
 
-func (repo *SettlementRepository) CreateSettlement(ctx context.Context, settlement *Domain.Settlement) (*Domain.Settlement, error) {
-	if err := repo.db.DB.WithContext(ctx).Create(settlement).Error; err != nil {
+// CreateSettlement inserts a pending settlement. One passed in as CONFIRMED,
+// a payment recorded to a guest who cannot confirm it, is confirmed in the
+// same transaction.
+func (repo *SettlementRepository) CreateSettlement(ctx context.Context, settlement *Domain.Settlement, activity *Domain.ActivityEvent) (*Domain.Settlement, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	status := settlement.Status
+	settlement.Status = Domain.SettlementPending
+	settlement.Confirmed = false
+	if err := tx.Create(settlement).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if status == Domain.SettlementConfirmed {
+		if err := repo.confirmSettlementTx(tx, settlement, settlement.PayerID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	activity.TargetID = settlement.Id
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
@@ -36,6 +66,60 @@ func (repo *SettlementRepository) CreateSettlement(ctx context.Context, settleme
 	return settlement, nil
 }
 
+func (repo *SettlementRepository) CreateSettlements(ctx context.Context, settlements []Domain.Settlement, activity *Domain.ActivityEvent) ([]Domain.Settlement, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	statuses := make([]Domain.SettlementStatus, len(settlements))
+	for i := range settlements {
+		statuses[i] = settlements[i].Status
+		settlements[i].Status = Domain.SettlementPending
+		settlements[i].Confirmed = false
+	}
+	if err := tx.Create(&settlements).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	for i := range settlements {
+		if statuses[i] != Domain.SettlementConfirmed {
+			continue
+		}
+		if err := repo.confirmSettlementTx(tx, &settlements[i], settlements[i].PayerID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	ids := make([]uuid.UUID, len(settlements))
+	for i, settlement := range settlements {
+		ids[i] = settlement.Id
+	}
+
+	var created []Domain.Settlement
+	if err := repo.db.DB.WithContext(ctx).Preload("Payer").Preload("Payee").
+		Where("id IN ?", ids).
+		Order("date ASC, id ASC").
+		Find(&created).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return created, nil
+}
+
 func (repo *SettlementRepository) GetSettlementById(ctx context.Context, settlementId uuid.UUID) (*Domain.Settlement, error) {
 	var settlement Domain.Settlement
 	if err := repo.db.DB.WithContext(ctx).First(&settlement, "id = ?", settlementId).Error; err != nil {
@@ -52,19 +136,34 @@ func (repo *SettlementRepository) GetSettlementByIdempotencyKey(ctx context.Cont
 	return &settlement, nil
 }
 
+func (repo *SettlementRepository) GetSettlementsBySettleUpId(ctx context.Context, settleUpId uuid.UUID) ([]Domain.Settlement, error) {
+	var settlements []Domain.Settlement
+	if err := repo.db.DB.WithContext(ctx).Preload("Payer").Preload("Payee").Preload("Split").
+		Where("settle_up_id = ?", settleUpId).
+		Order("date ASC, id ASC").
+		Find(&settlements).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if len(settlements) == 0 {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSettleUpNotFound)
+	}
+	return settlements, nil
+}
+
 func (repo *SettlementRepository) GetPendingSettlementsByUserId(ctx context.Context, userId uuid.UUID, limit, offset int) ([]Domain.Settlement, int64, error) {
 	var settlements []Domain.Settlement
 	var total int64
 
 	baseQuery := repo.db.DB.WithContext(ctx).Model(&Domain.Settlement{}).
-		Where("(settlements.payer_id = ? OR settlements.payee_id = ?) AND settlements.confirmed = ?", userId, userId, false)
+		Where("(settlements.payer_id = ? OR settlements.payee_id = ?) AND settlements.status = ?", userId, userId, Domain.SettlementPending)
 
 	if err := baseQuery.Count(&total).Error; err != nil {
 		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
 	if err := repo.db.DB.WithContext(ctx).Preload("Payer").Preload("Payee").Preload("Split").
-		Where("(settlements.payer_id = ? OR settlements.payee_id = ?) AND settlements.confirmed = ?", userId, userId, false).
+		Where("(settlements.payer_id = ? OR settlements.payee_id = ?) AND settlements.status = ?", userId, userId, Domain.SettlementPending).
 		Order("date DESC").
 		Limit(limit).Offset(offset).
 		Find(&settlements).Error; err != nil {
@@ -99,7 +198,7 @@ func (repo *SettlementRepository) GetSettlementHistoryWithConfirmation(ctx conte
 	return settlements, confirmedMap, total, nil
 }
 
-func (repo *SettlementRepository) ConfirmSettlement(ctx context.Context, settlementId uuid.UUID) error {
+func (repo *SettlementRepository) ConfirmSettlement(ctx context.Context, settlementId uuid.UUID, activity *Domain.ActivityEvent) error {
 	tx := repo.db.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -113,41 +212,63 @@ func (repo *SettlementRepository) ConfirmSettlement(ctx context.Context, settlem
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
 	}
 
-	if settlement.Confirmed {
+	if settlement.Status != Domain.SettlementPending {
 		tx.Rollback()
-		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementAlreadyConfirmed)
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementNotPending)
 	}
 
-	var participant Domain.SplitParticipant
-	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("split_id = ? AND user_id = ?", settlement.SplitID, settlement.PayerID).First(&participant).Error; err != nil {
+	if err := repo.confirmSettlementTx(tx, &settlement, settlement.PayeeID); err != nil {
 		tx.Rollback()
-		return fiber.NewError(fiber.StatusNotFound, Errors.ErrParticipantNotFound)
+		return err
 	}
 
-	remaining := participant.ShareAmount - participant.SettledAmount
-	if remaining <= 0 {
+	if err := recordActivityTx(tx, activity); err != nil {
 		tx.Rollback()
-		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementAlreadyConfirmed)
+		return err
 	}
-	if settlement.Amount > remaining {
-		tx.Rollback()
-		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSettlementAmount)
+
+	if err := tx.Commit().Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	participant.SettledAmount += settlement.Amount
-	participant.IsSettled = participant.SettledAmount >= participant.ShareAmount
-	if err := tx.Save(&participant).Error; err != nil {
+	return nil
+}
+
+func (repo *SettlementRepository) ConfirmSettleUp(ctx context.Context, settleUpId uuid.UUID, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var settlements []Domain.Settlement
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("settle_up_id = ?", settleUpId).
+		Order("date ASC, id ASC").
+		Find(&settlements).Error; err != nil {
 		tx.Rollback()
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	settlement.Confirmed = true
-	if err := tx.Model(&Domain.Settlement{}).Where("id = ?", settlementId).Update("confirmed", true).Error; err != nil {
+	if len(settlements) == 0 {
 		tx.Rollback()
-		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrSettleUpNotFound)
 	}
 
-	if err := repo.applySettlementBalanceUpdatesTx(tx, &settlement); err != nil {
+	for i := range settlements {
+		if settlements[i].Status != Domain.SettlementPending {
+			tx.Rollback()
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementNotPending)
+		}
+
+		if err := repo.confirmSettlementTx(tx, &settlements[i], settlements[i].PayeeID); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
 		tx.Rollback()
 		return err
 	}
@@ -159,136 +280,333 @@ func (repo *SettlementRepository) ConfirmSettlement(ctx context.Context, settlem
 	return nil
 }
 
-func (repo *SettlementRepository) IsSettlementConfirmed(ctx context.Context, settlementId uuid.UUID) (bool, error) {
-	var settlement Domain.Settlement
-	if err := repo.db.DB.WithContext(ctx).First(&settlement, "id = ?", settlementId).Error; err != nil {
-		return false, fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
+// confirmSettlementTx settles the payer's share and posts the ledger entries.
+// actorId is the payee, or the payer when recording a payment to a guest.
+func (repo *SettlementRepository) confirmSettlementTx(tx *gorm.DB, settlement *Domain.Settlement, actorId uuid.UUID) error {
+	if !settlement.IsDirect() {
+		var participant Domain.SplitParticipant
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("split_id = ? AND user_id = ?", *settlement.SplitID, settlement.PayerID).First(&participant).Error; err != nil {
+			return fiber.NewError(fiber.StatusNotFound, Errors.ErrParticipantNotFound)
+		}
+
+		remaining := participant.ShareAmount - participant.SettledAmount
+		if remaining <= 0 {
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementAlreadyConfirmed)
+		}
+		if settlement.Amount > remaining {
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSettlementAmount)
+		}
+
+		participant.SettledAmount += settlement.Amount
+		participant.IsSettled = participant.SettledAmount >= participant.ShareAmount
+		if err := tx.Save(&participant).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
 	}
 
-	return settlement.Confirmed, nil
+	if err := repo.transitionSettlementTx(tx, settlement, Domain.SettlementConfirmed, actorId, nil); err != nil {
+		return err
+	}
+
+	return repo.applySettlementBalanceUpdatesTx(tx, settlement, 1)
 }
 
-func (repo *SettlementRepository) applySettlementBalanceUpdatesTx(tx *gorm.DB, settlement *Domain.Settlement) error {
-	amount := settlement.Amount
-	currency := settlement.Currency
+func (repo *SettlementRepository) RejectSettlement(ctx context.Context, settlementId, actorId uuid.UUID, reason string, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
 
-	var payerToPayee Domain.UserBalance
-	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-		Where("user_id = ? AND other_user_id = ? AND currency = ?", settlement.PayerID, settlement.PayeeID, currency).
-		First(&payerToPayee).Error
+	settlements, err := repo.lockSettlementBatchTx(tx, settlementId)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			payerToPayee = Domain.UserBalance{
-				UserID:      settlement.PayerID,
-				OtherUserID: settlement.PayeeID,
-				NetAmount:   0,
-				Currency:    currency,
-			}
-			if createErr := tx.Create(&payerToPayee).Error; createErr != nil {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		} else {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		tx.Rollback()
+		return err
+	}
+
+	for i := range settlements {
+		if settlements[i].Status != Domain.SettlementPending {
+			tx.Rollback()
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementNotPending)
 		}
+
+		if err := repo.transitionSettlementTx(tx, &settlements[i], Domain.SettlementRejected, actorId, &reason); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
 	}
-	payerToPayee.NetAmount += amount
-	if err := tx.Save(&payerToPayee).Error; err != nil {
+
+	if err := tx.Commit().Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	var payeeToPayer Domain.UserBalance
-	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-		Where("user_id = ? AND other_user_id = ? AND currency = ?", settlement.PayeeID, settlement.PayerID, currency).
-		First(&payeeToPayer).Error
+	return nil
+}
+
+func (repo *SettlementRepository) DisputeSettlement(ctx context.Context, settlementId, actorId uuid.UUID, reason string, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	settlements, err := repo.lockSettlementBatchTx(tx, settlementId)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			payeeToPayer = Domain.UserBalance{
-				UserID:      settlement.PayeeID,
-				OtherUserID: settlement.PayerID,
-				NetAmount:   0,
-				Currency:    currency,
+		tx.Rollback()
+		return err
+	}
+
+	for i := range settlements {
+		settlement := &settlements[i]
+		if settlement.Status != Domain.SettlementConfirmed {
+			tx.Rollback()
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementNotConfirmed)
+		}
+
+		if !settlement.IsDirect() {
+			var participant Domain.SplitParticipant
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("split_id = ? AND user_id = ?", *settlement.SplitID, settlement.PayerID).First(&participant).Error; err != nil {
+				tx.Rollback()
+				return fiber.NewError(fiber.StatusNotFound, Errors.ErrParticipantNotFound)
 			}
-			if createErr := tx.Create(&payeeToPayer).Error; createErr != nil {
+
+			participant.SettledAmount -= settlement.Amount
+			if participant.SettledAmount < 0 {
+				participant.SettledAmount = 0
+			}
+			participant.IsSettled = participant.SettledAmount >= participant.ShareAmount
+			if err := tx.Save(&participant).Error; err != nil {
+				tx.Rollback()
 				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 			}
-		} else {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+
+		if err := repo.transitionSettlementTx(tx, settlement, Domain.SettlementDisputed, actorId, &reason); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := repo.applySettlementBalanceUpdatesTx(tx, settlement, -1); err != nil {
+			tx.Rollback()
+			return err
 		}
 	}
-	payeeToPayer.NetAmount -= amount
-	if err := tx.Save(&payeeToPayer).Error; err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
 	}
 
-	var split Domain.Split
-	if err := tx.Select("id", "group_id").First(&split, "id = ?", settlement.SplitID).Error; err != nil {
+	if err := tx.Commit().Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	if split.GroupID == nil {
-		return nil
+	return nil
+}
+
+func (repo *SettlementRepository) GetSettlementEvents(ctx context.Context, settlementId uuid.UUID) ([]Domain.SettlementEvent, error) {
+	var events []Domain.SettlementEvent
+	if err := repo.db.DB.WithContext(ctx).Preload("Actor").
+		Where("settlement_id = ?", settlementId).
+		Order("created_at ASC").
+		Find(&events).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
+	return events, nil
+}
 
-	groupID := *split.GroupID
+func (repo *SettlementRepository) lockSettlementBatchTx(tx *gorm.DB, settlementId uuid.UUID) ([]Domain.Settlement, error) {
+	var settlement Domain.Settlement
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&settlement, "id = ?", settlementId).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
+	}
 
-	var payerGroupBalance Domain.GroupBalance
-	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-		Where("group_id = ? AND user_id = ? AND currency = ?", groupID, settlement.PayerID, currency).
-		First(&payerGroupBalance).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			payerGroupBalance = Domain.GroupBalance{
-				GroupID:   groupID,
-				UserID:    settlement.PayerID,
-				NetAmount: 0,
-				Currency:  currency,
-			}
-			if createErr := tx.Create(&payerGroupBalance).Error; createErr != nil {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		} else {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-		}
+	if settlement.SettleUpID == nil {
+		return []Domain.Settlement{settlement}, nil
+	}
+
+	var settlements []Domain.Settlement
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("settle_up_id = ?", *settlement.SettleUpID).
+		Order("date ASC, id ASC").
+		Find(&settlements).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
-	payerGroupBalance.NetAmount += amount
-	if err := tx.Save(&payerGroupBalance).Error; err != nil {
+	return settlements, nil
+}
+
+func (repo *SettlementRepository) transitionSettlementTx(tx *gorm.DB, settlement *Domain.Settlement, status Domain.SettlementStatus, actorId uuid.UUID, reason *string) error {
+	event := Domain.SettlementEvent{
+		SettlementID: settlement.Id,
+		ActorID:      actorId,
+		FromStatus:   settlement.Status,
+		ToStatus:     status,
+		Reason:       reason,
+	}
+
+	settlement.Status = status
+	settlement.StatusReason = reason
+	settlement.Confirmed = status == Domain.SettlementConfirmed
+
+	if err := tx.Model(&Domain.Settlement{}).Where("id = ?", settlement.Id).Updates(map[string]interface{}{
+		"status":        settlement.Status,
+		"status_reason": settlement.StatusReason,
+		"confirmed":     settlement.Confirmed,
+	}).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	var payeeGroupBalance Domain.GroupBalance
-	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-		Where("group_id = ? AND user_id = ? AND currency = ?", groupID, settlement.PayeeID, currency).
-		First(&payeeGroupBalance).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			payeeGroupBalance = Domain.GroupBalance{
-				GroupID:   groupID,
-				UserID:    settlement.PayeeID,
-				NetAmount: 0,
-				Currency:  currency,
-			}
-			if createErr := tx.Create(&payeeGroupBalance).Error; createErr != nil {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		} else {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	if err := tx.Create(&event).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return nil
+}
+
+func (repo *SettlementRepository) IsSettlementConfirmed(ctx context.Context, settlementId uuid.UUID) (bool, error) {
+	var settlement Domain.Settlement
+	if err := repo.db.DB.WithContext(ctx).First(&settlement, "id = ?", settlementId).Error; err != nil {
+		return false, fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
+	}
+
+	return settlement.Confirmed, nil
+}
+
+func (repo *SettlementRepository) GetConfirmedSettlementTotal(ctx context.Context, splitId, payerId, payeeId uuid.UUID) (int64, error) {
+	var total int64
+	if err := repo.db.DB.WithContext(ctx).
+		Model(&Domain.Settlement{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("split_id = ? AND payer_id = ? AND payee_id = ? AND confirmed = ?", splitId, payerId, payeeId, true).
+		Scan(&total).Error; err != nil {
+		return 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return total, nil
+}
+
+func (repo *SettlementRepository) GetPendingSettlementTotalsBySplit(ctx context.Context, payerId uuid.UUID, splitIds []uuid.UUID) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		SplitID uuid.UUID
+		Total   int64
+	}
+
+	totals := make(map[uuid.UUID]int64)
+	if len(splitIds) == 0 {
+		return totals, nil
+	}
+
+	if err := repo.db.DB.WithContext(ctx).
+		Model(&Domain.Settlement{}).
+		Select("split_id, COALESCE(SUM(amount), 0) AS total").
+		Where("payer_id = ? AND split_id IN ? AND status = ?", payerId, splitIds, Domain.SettlementPending).
+		Group("split_id").
+		Scan(&rows).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	for _, row := range rows {
+		totals[row.SplitID] = row.Total
+	}
+	return totals, nil
+}
+
+// GetOpenSettlementTotalsToPayeeBySplit sums the pending and confirmed
+// settlements from payerId to payeeId in each split.
+func (repo *SettlementRepository) GetOpenSettlementTotalsToPayeeBySplit(ctx context.Context, payerId, payeeId uuid.UUID, splitIds []uuid.UUID) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		SplitID uuid.UUID
+		Total   int64
+	}
+
+	totals := make(map[uuid.UUID]int64)
+	if len(splitIds) == 0 {
+		return totals, nil
+	}
+
+	if err := repo.db.DB.WithContext(ctx).
+		Model(&Domain.Settlement{}).
+		Select("split_id, COALESCE(SUM(amount), 0) AS total").
+		Where("payer_id = ? AND payee_id = ? AND split_id IN ? AND status IN ?", payerId, payeeId, splitIds,
+			[]Domain.SettlementStatus{Domain.SettlementPending, Domain.SettlementConfirmed}).
+		Group("split_id").
+		Scan(&rows).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	for _, row := range rows {
+		totals[row.SplitID] = row.Total
+	}
+	return totals, nil
+}
+
+func (repo *SettlementRepository) applySettlementBalanceUpdatesTx(tx *gorm.DB, settlement *Domain.Settlement, sign int64) error {
+	return postSettlementLedgerEntriesTx(tx, settlement, sign)
+}
+
+func (repo *SettlementRepository) DeleteSettlement(ctx context.Context, settlementId uuid.UUID, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
 		}
+	}()
+
+	result := tx.Delete(&Domain.Settlement{}, "id = ?", settlementId)
+	if result.Error != nil {
+		tx.Rollback()
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
-	payeeGroupBalance.NetAmount -= amount
-	if err := tx.Save(&payeeGroupBalance).Error; err != nil {
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
+	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
 	return nil
 }
 
-func (repo *SettlementRepository) DeleteSettlement(ctx context.Context, settlementId uuid.UUID) error {
-	result := repo.db.DB.WithContext(ctx).Delete(&Domain.Settlement{}, "id = ?", settlementId)
+func (repo *SettlementRepository) DeleteSettleUp(ctx context.Context, settleUpId uuid.UUID, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Delete(&Domain.Settlement{}, "settle_up_id = ? AND status IN ?", settleUpId, []Domain.SettlementStatus{Domain.SettlementPending, Domain.SettlementRejected})
 	if result.Error != nil {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 	if result.RowsAffected == 0 {
-		return fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
+		tx.Rollback()
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrSettleUpNotFound)
+	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
+
 	return nil
 }
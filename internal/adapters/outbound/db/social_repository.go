@@ -55,20 +55,38 @@ func (repo *SocialRepository) GetFriendRequestByIdempotencyKey(ctx context.Conte
 	return &request, nil
 }
 
-func (repo *SocialRepository) CreateFriendRequest(ctx context.Context, senderId uuid.UUID, receiverId uuid.UUID, idempotencyKey *string) (*Domain.FriendRequest, error) {
+func (repo *SocialRepository) CreateFriendRequest(ctx context.Context, senderId uuid.UUID, receiverId uuid.UUID, idempotencyKey *string, activity *Domain.ActivityEvent) (*Domain.FriendRequest, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
 	request := &Domain.FriendRequest{
 		SenderId:       senderId,
 		ReceiverId:     receiverId,
 		Status:         Domain.FriendPending,
 		IdempotencyKey: idempotencyKey,
 	}
-	if err := repo.db.DB.WithContext(ctx).Create(request).Error; err != nil {
+	if err := tx.Create(request).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	activity.TargetID = request.Id
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 	return request, nil
 }
 
-func (repo *SocialRepository) AcceptFriendRequest(ctx context.Context, receiverId, requestId uuid.UUID) error {
+func (repo *SocialRepository) AcceptFriendRequest(ctx context.Context, receiverId, requestId uuid.UUID, activity *Domain.ActivityEvent) error {
 	var request Domain.FriendRequest
 	if err := repo.db.DB.WithContext(ctx).Where("id = ? AND receiver_id = ? AND status = ?", requestId, receiverId, Domain.FriendPending).First(&request).Error; err != nil {
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrFriendRequestNotFound)
@@ -103,31 +121,74 @@ func (repo *SocialRepository) AcceptFriendRequest(ctx context.Context, receiverI
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
+	activity.AddAudience(request.SenderId)
+	if err = recordActivityTx(tx, activity); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (repo *SocialRepository) RejectFriendRequest(ctx context.Context, receiverId, requestId uuid.UUID) error {
+func (repo *SocialRepository) RejectFriendRequest(ctx context.Context, receiverId, requestId uuid.UUID, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
 	var request Domain.FriendRequest
-	if err := repo.db.DB.WithContext(ctx).Where("id = ? AND receiver_id = ? AND status = ?", requestId, receiverId, Domain.FriendPending).First(&request).Error; err != nil {
+	if err := tx.Where("id = ? AND receiver_id = ? AND status = ?", requestId, receiverId, Domain.FriendPending).First(&request).Error; err != nil {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrFriendRequestNotFound)
 	}
 
 	request.Status = Domain.FriendRejected
-	if err := repo.db.DB.WithContext(ctx).Save(&request).Error; err != nil {
+	if err := tx.Save(&request).Error; err != nil {
+		tx.Rollback()
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	activity.AddAudience(request.SenderId)
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
 	return nil
 }
 
-func (repo *SocialRepository) CancelFriendRequest(ctx context.Context, senderId, requestId uuid.UUID) error {
+func (repo *SocialRepository) CancelFriendRequest(ctx context.Context, senderId, requestId uuid.UUID, activity *Domain.ActivityEvent) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
 	var request Domain.FriendRequest
-	if err := repo.db.DB.WithContext(ctx).Where("id = ? AND sender_id = ? AND status = ?", requestId, senderId, Domain.FriendPending).First(&request).Error; err != nil {
+	if err := tx.Where("id = ? AND sender_id = ? AND status = ?", requestId, senderId, Domain.FriendPending).First(&request).Error; err != nil {
+		tx.Rollback()
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrFriendRequestNotFound)
 	}
 
 	request.Status = Domain.FriendRejected
-	if err := repo.db.DB.WithContext(ctx).Save(&request).Error; err != nil {
+	if err := tx.Save(&request).Error; err != nil {
+		tx.Rollback()
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	activity.AddAudience(request.ReceiverId)
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
@@ -157,7 +218,7 @@ func (repo *SocialRepository) GetFriendsList(ctx context.Context, userId uuid.UU
 	return friends, total, nil
 }
 
-func (repo *SocialRepository) RemoveFriend(ctx context.Context, userId, friendId uuid.UUID) error {
+func (repo *SocialRepository) RemoveFriend(ctx context.Context, userId, friendId uuid.UUID, activity *Domain.ActivityEvent) error {
 	tx := repo.db.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -182,6 +243,11 @@ func (repo *SocialRepository) RemoveFriend(ctx context.Context, userId, friendId
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrFriendshipNotFound)
 	}
 
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
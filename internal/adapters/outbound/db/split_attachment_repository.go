@@ -0,0 +1,101 @@
+package RepositoryAdapters
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+type SplitAttachmentRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateSplitAttachmentRepository(db DB.PostgresDB) RepositoryPorts.SplitAttachmentRepositoryPort {
+	return &SplitAttachmentRepository{db: db}
+}
+
+// CreateAttachment locks the split while counting its attachments so that
+// parallel uploads cannot go past maxPerSplit.
+func (repo *SplitAttachmentRepository) CreateAttachment(ctx context.Context, attachment *Domain.SplitAttachment, maxPerSplit int) (*Domain.SplitAttachment, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var split Domain.Split
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&split, "id = ?", attachment.SplitID).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
+	}
+
+	var count int64
+	if err := tx.Model(&Domain.SplitAttachment{}).Where("split_id = ?", attachment.SplitID).Count(&count).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if count >= int64(maxPerSplit) {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusConflict, Errors.ErrTooManyAttachments)
+	}
+
+	if err := tx.Omit("Split", "UploadedBy").Create(attachment).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Preload("UploadedBy").First(attachment, "id = ?", attachment.Id).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return attachment, nil
+}
+
+func (repo *SplitAttachmentRepository) GetAttachmentById(ctx context.Context, splitId, attachmentId uuid.UUID) (*Domain.SplitAttachment, error) {
+	var attachment Domain.SplitAttachment
+	if err := repo.db.DB.WithContext(ctx).Preload("UploadedBy").
+		Where("id = ? AND split_id = ?", attachmentId, splitId).
+		First(&attachment).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrAttachmentNotFound)
+	}
+	return &attachment, nil
+}
+
+func (repo *SplitAttachmentRepository) GetAttachments(ctx context.Context, splitId uuid.UUID) ([]Domain.SplitAttachment, error) {
+	var attachments []Domain.SplitAttachment
+	if err := repo.db.DB.WithContext(ctx).Preload("UploadedBy").
+		Where("split_id = ?", splitId).
+		Order("created_at ASC, id ASC").
+		Find(&attachments).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return attachments, nil
+}
+
+// DeleteAttachment removes the row for good, since its blob is deleted along
+// with it.
+func (repo *SplitAttachmentRepository) DeleteAttachment(ctx context.Context, splitId, attachmentId uuid.UUID) error {
+	result := repo.db.DB.WithContext(ctx).Unscoped().
+		Where("id = ? AND split_id = ?", attachmentId, splitId).
+		Delete(&Domain.SplitAttachment{})
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrAttachmentNotFound)
+	}
+	return nil
+}
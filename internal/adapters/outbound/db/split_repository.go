@@ -2,7 +2,6 @@ package RepositoryAdapters
 
 import (
 	"context"
-	"errors"
 
 	"github.com/gofiber/fiber/v2"
 
@@ -34,7 +33,17 @@ func (repo *SplitRepository) GetSplitById(ctx context.Context, splitId uuid.UUID
 
 func (repo *SplitRepository) GetSplitByIdempotencyKey(ctx context.Context, idempotencyKey string) (*Domain.Split, error) {
 	var split Domain.Split
-	if err := repo.db.DB.WithContext(ctx).Preload("Participants.User").Preload("CreatedBy").First(&split, "idempotency_key = ?", idempotencyKey).Error; err != nil {
+	if err := repo.db.DB.WithContext(ctx).Preload("Items.Assignees").Preload("Payers.User").Preload("Participants.User").Preload("Category").Preload("CreatedBy").First(&split, "idempotency_key = ?", idempotencyKey).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
+	}
+	return &split, nil
+}
+
+// GetSplitByIdempotencyKeyUnscoped also finds deleted splits. Only the split
+// itself is loaded.
+func (repo *SplitRepository) GetSplitByIdempotencyKeyUnscoped(ctx context.Context, idempotencyKey string) (*Domain.Split, error) {
+	var split Domain.Split
+	if err := repo.db.DB.WithContext(ctx).Unscoped().Order("deleted_at DESC NULLS FIRST").First(&split, "idempotency_key = ?", idempotencyKey).Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
 	}
 	return &split, nil
@@ -42,7 +51,7 @@ func (repo *SplitRepository) GetSplitByIdempotencyKey(ctx context.Context, idemp
 
 func (repo *SplitRepository) GetSplitWithParticipants(ctx context.Context, splitId uuid.UUID) (*Domain.Split, error) {
 	var split Domain.Split
-	if err := repo.db.DB.WithContext(ctx).Preload("Participants.User").Preload("CreatedBy").First(&split, "id = ?", splitId).Error; err != nil {
+	if err := repo.db.DB.WithContext(ctx).Preload("Items.Assignees").Preload("Payers.User").Preload("Participants.User").Preload("Category").Preload("CreatedBy").First(&split, "id = ?", splitId).Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
 	}
 	return &split, nil
@@ -62,7 +71,7 @@ func (repo *SplitRepository) GetSplitsByGroupId(ctx context.Context, groupId uui
 		return []Domain.Split{}, 0, nil
 	}
 
-	if err := query.Preload("Participants.User").Order("created_at DESC").Limit(limit).Offset(offset).Find(&splits).Error; err != nil {
+	if err := query.Preload("Items.Assignees").Preload("Payers.User").Preload("Participants.User").Preload("Category").Order("created_at DESC").Limit(limit).Offset(offset).Find(&splits).Error; err != nil {
 		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 	return splits, total, nil
@@ -89,7 +98,10 @@ func (repo *SplitRepository) GetSplitsByUserId(ctx context.Context, userId uuid.
 	}
 
 	if err := baseQuery.
+		Preload("Items.Assignees").
+		Preload("Payers.User").
 		Preload("Participants.User").
+		Preload("Category").
 		Order("created_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -111,7 +123,7 @@ func (repo *SplitRepository) GetPendingSettlementCountBySplitId(ctx context.Cont
 	var count int64
 	if err := repo.db.DB.WithContext(ctx).
 		Model(&Domain.Settlement{}).
-		Where("split_id = ? AND confirmed = ?", splitId, false).
+		Where("split_id = ? AND status = ?", splitId, Domain.SettlementPending).
 		Count(&count).Error; err != nil {
 		return 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
@@ -119,31 +131,22 @@ func (repo *SplitRepository) GetPendingSettlementCountBySplitId(ctx context.Cont
 	return count, nil
 }
 
-func (repo *SplitRepository) GetConfirmedSettlementTotalsByPayer(ctx context.Context, splitId uuid.UUID) (map[uuid.UUID]int64, error) {
-	type payerTotalRow struct {
-		PayerID uuid.UUID
-		Total   int64
-	}
-
-	rows := make([]payerTotalRow, 0)
+func (repo *SplitRepository) GetConfirmedSettlementTotals(ctx context.Context, splitId uuid.UUID) ([]RepositoryPorts.SettlementTotal, error) {
+	rows := make([]RepositoryPorts.SettlementTotal, 0)
 	if err := repo.db.DB.WithContext(ctx).
 		Model(&Domain.Settlement{}).
-		Select("payer_id, COALESCE(SUM(amount), 0) AS total").
+		Select("payer_id, payee_id, COALESCE(SUM(amount), 0) AS total").
 		Where("split_id = ? AND confirmed = ?", splitId, true).
-		Group("payer_id").
+		Group("payer_id, payee_id").
+		Order("payer_id, payee_id").
 		Scan(&rows).Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	result := make(map[uuid.UUID]int64, len(rows))
-	for _, row := range rows {
-		result[row.PayerID] = row.Total
-	}
-
-	return result, nil
+	return rows, nil
 }
 
-func (repo *SplitRepository) DeleteSplitWithBalanceRollback(ctx context.Context, split *Domain.Split, participants []Domain.SplitParticipant) error {
+func (repo *SplitRepository) DeleteSplitWithBalanceRollback(ctx context.Context, split *Domain.Split, participants []Domain.SplitParticipant, activity *Domain.ActivityEvent) error {
 	tx := repo.db.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -151,22 +154,6 @@ func (repo *SplitRepository) DeleteSplitWithBalanceRollback(ctx context.Context,
 		}
 	}()
 
-	inverseSplit := &Domain.Split{
-		CreatedByID: split.CreatedByID,
-		TotalAmount: -split.TotalAmount,
-		Currency:    split.Currency,
-		GroupID:     split.GroupID,
-	}
-
-	inverseParticipants := make([]Domain.SplitParticipant, len(participants))
-	for i, p := range participants {
-		inverseParticipants[i] = Domain.SplitParticipant{
-			UserID:      p.UserID,
-			ShareAmount: -p.ShareAmount,
-			Currency:    p.Currency,
-		}
-	}
-
 	confirmedSettlements := make([]Domain.Settlement, 0)
 	if err := tx.Where("split_id = ? AND confirmed = ?", split.Id, true).Find(&confirmedSettlements).Error; err != nil {
 		tx.Rollback()
@@ -180,7 +167,7 @@ func (repo *SplitRepository) DeleteSplitWithBalanceRollback(ctx context.Context,
 		}
 	}
 
-	if err := repo.applyBalanceUpdatesForSplitTx(tx, inverseSplit, inverseParticipants); err != nil {
+	if err := repo.rollbackSplitBalanceTx(tx, split, participants); err != nil {
 		tx.Rollback()
 		return err
 	}
@@ -195,6 +182,11 @@ func (repo *SplitRepository) DeleteSplitWithBalanceRollback(ctx context.Context,
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
@@ -202,94 +194,85 @@ func (repo *SplitRepository) DeleteSplitWithBalanceRollback(ctx context.Context,
 	return nil
 }
 
+func (repo *SplitRepository) rollbackSplitBalanceTx(tx *gorm.DB, split *Domain.Split, participants []Domain.SplitParticipant) error {
+	return repo.applyBalanceUpdatesForSplitTx(tx, split, split.Payers, participants, -1)
+}
+
 func (repo *SplitRepository) rollbackConfirmedSettlementBalanceTx(tx *gorm.DB, groupID *uuid.UUID, settlement *Domain.Settlement) error {
-	amount := settlement.Amount
-	currency := settlement.Currency
+	return postLedgerEntriesTx(tx, Domain.SettlementLedgerEntries(settlement, groupID, -1))
+}
 
-	var payerToPayee Domain.UserBalance
-	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-		Where("user_id = ? AND other_user_id = ? AND currency = ?", settlement.PayerID, settlement.PayeeID, currency).
-		First(&payerToPayee).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			payerToPayee = Domain.UserBalance{UserID: settlement.PayerID, OtherUserID: settlement.PayeeID, NetAmount: 0, Currency: currency}
-			if createErr := tx.Create(&payerToPayee).Error; createErr != nil {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		} else {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+func (repo *SplitRepository) CreateSplitWithParticipants(ctx context.Context, split *Domain.Split, payers []Domain.SplitPayer, participants []Domain.SplitParticipant, activity *Domain.ActivityEvent) (*Domain.Split, []Domain.SplitParticipant, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
 		}
+	}()
+
+	items := split.Items
+	split.Items = nil
+	if err := tx.Create(split).Error; err != nil {
+		tx.Rollback()
+		return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
-	payerToPayee.NetAmount -= amount
-	if err := tx.Save(&payerToPayee).Error; err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+
+	if err := repo.createSplitItemsTx(tx, split.Id, items); err != nil {
+		tx.Rollback()
+		return nil, nil, err
 	}
+	split.Items = items
 
-	var payeeToPayer Domain.UserBalance
-	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-		Where("user_id = ? AND other_user_id = ? AND currency = ?", settlement.PayeeID, settlement.PayerID, currency).
-		First(&payeeToPayer).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			payeeToPayer = Domain.UserBalance{UserID: settlement.PayeeID, OtherUserID: settlement.PayerID, NetAmount: 0, Currency: currency}
-			if createErr := tx.Create(&payeeToPayer).Error; createErr != nil {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		} else {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	createdPayers := make([]Domain.SplitPayer, len(payers))
+	for i := range payers {
+		payers[i].SplitID = split.Id
+		if err := tx.Create(&payers[i]).Error; err != nil {
+			tx.Rollback()
+			return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 		}
-	}
-	payeeToPayer.NetAmount += amount
-	if err := tx.Save(&payeeToPayer).Error; err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-	}
 
-	if groupID == nil {
-		return nil
+		if err := tx.Preload("User").First(&payers[i], "id = ?", payers[i].Id).Error; err != nil {
+			tx.Rollback()
+			return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+		createdPayers[i] = payers[i]
 	}
+	split.Payers = createdPayers
 
-	var payerGroupBalance Domain.GroupBalance
-	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-		Where("group_id = ? AND user_id = ? AND currency = ?", *groupID, settlement.PayerID, currency).
-		First(&payerGroupBalance).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			payerGroupBalance = Domain.GroupBalance{GroupID: *groupID, UserID: settlement.PayerID, NetAmount: 0, Currency: currency}
-			if createErr := tx.Create(&payerGroupBalance).Error; createErr != nil {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		} else {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	createdParticipants := make([]Domain.SplitParticipant, len(participants))
+	for i := range participants {
+		participants[i].SplitID = split.Id
+		if err := tx.Create(&participants[i]).Error; err != nil {
+			tx.Rollback()
+			return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+
+		if err := tx.Preload("User").First(&participants[i], "id = ?", participants[i].Id).Error; err != nil {
+			tx.Rollback()
+			return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 		}
+		createdParticipants[i] = participants[i]
 	}
-	payerGroupBalance.NetAmount -= amount
-	if err := tx.Save(&payerGroupBalance).Error; err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+
+	if err := repo.applyBalanceUpdatesForSplitTx(tx, split, createdPayers, createdParticipants, 1); err != nil {
+		tx.Rollback()
+		return nil, nil, err
 	}
 
-	var payeeGroupBalance Domain.GroupBalance
-	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-		Where("group_id = ? AND user_id = ? AND currency = ?", *groupID, settlement.PayeeID, currency).
-		First(&payeeGroupBalance).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			payeeGroupBalance = Domain.GroupBalance{GroupID: *groupID, UserID: settlement.PayeeID, NetAmount: 0, Currency: currency}
-			if createErr := tx.Create(&payeeGroupBalance).Error; createErr != nil {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		} else {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-		}
+	activity.TargetID = split.Id
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, nil, err
 	}
-	payeeGroupBalance.NetAmount += amount
-	if err := tx.Save(&payeeGroupBalance).Error; err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	return nil
+	return split, createdParticipants, nil
 }
 
-func (repo *SplitRepository) CreateSplitWithParticipants(ctx context.Context, split *Domain.Split, participants []Domain.SplitParticipant) (*Domain.Split, []Domain.SplitParticipant, error) {
+func (repo *SplitRepository) UpdateSplitWithParticipants(ctx context.Context, split *Domain.Split, payers []Domain.SplitPayer, participants []Domain.SplitParticipant, activity *Domain.ActivityEvent) (*Domain.Split, error) {
 	tx := repo.db.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -297,37 +280,153 @@ func (repo *SplitRepository) CreateSplitWithParticipants(ctx context.Context, sp
 		}
 	}()
 
-	if err := tx.Create(split).Error; err != nil {
+	var original Domain.Split
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&original, "id = ?", split.Id).Error; err != nil {
 		tx.Rollback()
-		return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
+	}
+
+	if err := tx.Where("split_id = ?", split.Id).Find(&original.Payers).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	var originalParticipants []Domain.SplitParticipant
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("split_id = ?", split.Id).Find(&originalParticipants).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	// Counted under the split lock: a new settlement's foreign key check waits
+	// on it, so none can be created between this count and the commit.
+	var pendingSettlements int64
+	if err := tx.Model(&Domain.Settlement{}).Where("split_id = ? AND status = ?", split.Id, Domain.SettlementPending).Count(&pendingSettlements).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if pendingSettlements > 0 {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusConflict, Errors.ErrSplitEditHasPendingSettlements)
+	}
+
+	settledBySettlements := make(map[uuid.UUID]int64, len(originalParticipants))
+	for _, participant := range originalParticipants {
+		settledBySettlements[participant.UserID] = participant.SettledAmount - Domain.SelfPaidShare(participant, original.Payers)
 	}
 
-	createdParticipants := make([]Domain.SplitParticipant, len(participants))
 	for i := range participants {
-		participants[i].SplitID = split.Id
-		participants[i].SettledAmount = 0
-		if err := tx.Create(&participants[i]).Error; err != nil {
+		participants[i].SettledAmount = settledBySettlements[participants[i].UserID] + Domain.SelfPaidShare(participants[i], payers)
+		if participants[i].SettledAmount > participants[i].ShareAmount {
 			tx.Rollback()
-			return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+			return nil, fiber.NewError(fiber.StatusConflict, Errors.ErrSplitEditBelowSettled)
 		}
+		participants[i].IsSettled = participants[i].SettledAmount >= participants[i].ShareAmount
+		delete(settledBySettlements, participants[i].UserID)
+	}
 
-		if err := tx.Preload("User").First(&participants[i], "id = ?", participants[i].Id).Error; err != nil {
+	for _, settled := range settledBySettlements {
+		if settled > 0 {
 			tx.Rollback()
-			return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+			return nil, fiber.NewError(fiber.StatusConflict, Errors.ErrSplitEditBelowSettled)
 		}
-		createdParticipants[i] = participants[i]
 	}
 
-	if err := repo.applyBalanceUpdatesForSplitTx(tx, split, createdParticipants); err != nil {
+	if err := repo.rollbackSplitBalanceTx(tx, &original, originalParticipants); err != nil {
 		tx.Rollback()
-		return nil, nil, err
+		return nil, err
+	}
+
+	if err := tx.Unscoped().Delete(&Domain.SplitPayer{}, "split_id = ?", split.Id).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Unscoped().Delete(&Domain.SplitParticipant{}, "split_id = ?", split.Id).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Unscoped().Delete(&Domain.SplitItem{}, "split_id = ?", split.Id).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Model(&original).Updates(map[string]interface{}{
+		"description":           split.Description,
+		"category_id":           split.CategoryID,
+		"division_type":         split.DivisionType,
+		"total_amount":          split.TotalAmount,
+		"tax_amount":            split.TaxAmount,
+		"tip_amount":            split.TipAmount,
+		"service_charge_amount": split.ServiceChargeAmount,
+	}).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := repo.createSplitItemsTx(tx, split.Id, split.Items); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	for i := range payers {
+		payers[i].SplitID = split.Id
+		if err := tx.Create(&payers[i]).Error; err != nil {
+			tx.Rollback()
+			return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	for i := range participants {
+		participants[i].SplitID = split.Id
+		if err := tx.Create(&participants[i]).Error; err != nil {
+			tx.Rollback()
+			return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	original.Payers = payers
+	if err := updateBalancesForSplitTx(tx, &original, participants); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := recordActivityTx(tx, activity); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var updated Domain.Split
+	if err := tx.Preload("Items.Assignees").Preload("Payers.User").Preload("Participants.User").Preload("Category").Preload("CreatedBy").First(&updated, "id = ?", split.Id).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
 	if err := tx.Commit().Error; err != nil {
-		return nil, nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	return split, createdParticipants, nil
+	return &updated, nil
+}
+
+func (repo *SplitRepository) createSplitItemsTx(tx *gorm.DB, splitId uuid.UUID, items []Domain.SplitItem) error {
+	for i := range items {
+		assignees := items[i].Assignees
+		items[i].Assignees = nil
+		items[i].SplitID = splitId
+		if err := tx.Create(&items[i]).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+
+		for j := range assignees {
+			assignees[j].SplitItemID = items[i].Id
+			if err := tx.Create(&assignees[j]).Error; err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+			}
+		}
+		items[i].Assignees = assignees
+	}
+	return nil
 }
 
 func (repo *SplitRepository) HasPendingSplitsInGroup(ctx context.Context, userId, groupId uuid.UUID) (bool, error) {
@@ -346,102 +445,93 @@ func (repo *SplitRepository) HasPendingSplitsInGroup(ctx context.Context, userId
 	return count > 0, nil
 }
 
-func (repo *SplitRepository) applyBalanceUpdatesForSplitTx(tx *gorm.DB, split *Domain.Split, participants []Domain.SplitParticipant) error {
-	creatorId := split.CreatedByID
-	currency := split.Currency
+// GetOutstandingParticipantsInGroup returns userId's unsettled shares in the
+// group's splits that payeeId paid for, oldest first, with the split's payers
+// loaded.
+func (repo *SplitRepository) GetOutstandingParticipantsInGroup(ctx context.Context, groupId, userId, payeeId uuid.UUID, currency Domain.Currency) ([]Domain.SplitParticipant, error) {
+	var participants []Domain.SplitParticipant
 
-	for _, participant := range participants {
-		if participant.UserID == creatorId {
-			continue
-		}
+	err := repo.db.DB.WithContext(ctx).
+		Preload("Split.Payers").
+		Joins("JOIN splits ON splits.id = split_participants.split_id AND splits.deleted_at IS NULL").
+		Where("splits.group_id = ? AND split_participants.user_id = ? AND split_participants.currency = ?", groupId, userId, currency).
+		Where("split_participants.settled_amount < split_participants.share_amount").
+		Where("EXISTS (SELECT 1 FROM split_payers WHERE split_payers.split_id = splits.id AND split_payers.user_id = ? AND split_payers.deleted_at IS NULL)", payeeId).
+		Order("splits.created_at ASC, splits.id ASC").
+		Find(&participants).Error
 
-		var participantToCreator Domain.UserBalance
-		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("user_id = ? AND other_user_id = ? AND currency = ?", participant.UserID, creatorId, currency).
-			First(&participantToCreator).Error
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				participantToCreator = Domain.UserBalance{
-					UserID:      participant.UserID,
-					OtherUserID: creatorId,
-					NetAmount:   0,
-					Currency:    currency,
-				}
-				if createErr := tx.Create(&participantToCreator).Error; createErr != nil {
-					return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-				}
-			} else {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		}
-		participantToCreator.NetAmount -= participant.ShareAmount
-		if err := tx.Save(&participantToCreator).Error; err != nil {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-		}
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
 
-		var creatorToParticipant Domain.UserBalance
-		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("user_id = ? AND other_user_id = ? AND currency = ?", creatorId, participant.UserID, currency).
-			First(&creatorToParticipant).Error
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				creatorToParticipant = Domain.UserBalance{
-					UserID:      creatorId,
-					OtherUserID: participant.UserID,
-					NetAmount:   0,
-					Currency:    currency,
-				}
-				if createErr := tx.Create(&creatorToParticipant).Error; createErr != nil {
-					return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-				}
-			} else {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		}
-		creatorToParticipant.NetAmount += participant.ShareAmount
-		if err := tx.Save(&creatorToParticipant).Error; err != nil {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-		}
+	return participants, nil
+}
+
+func (repo *SplitRepository) applyBalanceUpdatesForSplitTx(tx *gorm.DB, split *Domain.Split, payers []Domain.SplitPayer, participants []Domain.SplitParticipant, sign int64) error {
+	return postSplitLedgerEntriesTx(tx, split, payers, participants, sign)
+}
+
+func (repo *SplitRepository) groupSpendingQuery(ctx context.Context, filter RepositoryPorts.SpendingFilter) *gorm.DB {
+	query := repo.db.DB.WithContext(ctx).Model(&Domain.Split{}).Where("splits.group_id = ?", filter.GroupID)
+	if filter.From != nil {
+		query = query.Where("splits.created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("splits.created_at < ?", *filter.To)
 	}
+	return query
+}
 
-	if split.GroupID == nil {
-		return nil
+func (repo *SplitRepository) GetGroupSpendByCategory(ctx context.Context, filter RepositoryPorts.SpendingFilter) ([]RepositoryPorts.CategorySpend, error) {
+	rows := make([]RepositoryPorts.CategorySpend, 0)
+	if err := repo.groupSpendingQuery(ctx, filter).
+		Select("splits.category_id, categories.name AS category_name, splits.currency, " +
+			"COALESCE(SUM(splits.total_amount), 0) AS total_amount, COUNT(*) AS split_count").
+		Joins("LEFT JOIN categories ON categories.id = splits.category_id").
+		Group("splits.category_id, categories.name, splits.currency").
+		Order("total_amount DESC, category_name").
+		Scan(&rows).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	groupID := *split.GroupID
-	for _, participant := range participants {
-		netChange := int64(0)
-		if participant.UserID == creatorId {
-			netChange = split.TotalAmount - participant.ShareAmount
-		} else {
-			netChange = -participant.ShareAmount
-		}
+	return rows, nil
+}
 
-		var groupBalance Domain.GroupBalance
-		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("group_id = ? AND user_id = ? AND currency = ?", groupID, participant.UserID, currency).
-			First(&groupBalance).Error
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				groupBalance = Domain.GroupBalance{
-					UserID:    participant.UserID,
-					GroupID:   groupID,
-					NetAmount: 0,
-					Currency:  currency,
-				}
-				if createErr := tx.Create(&groupBalance).Error; createErr != nil {
-					return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-				}
-			} else {
-				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-			}
-		}
+// GetGroupSpendByMember reports, per member and currency, how much they paid
+// and how much of the group's spending was their share.
+func (repo *SplitRepository) GetGroupSpendByMember(ctx context.Context, filter RepositoryPorts.SpendingFilter) ([]RepositoryPorts.MemberSpend, error) {
+	paid := repo.groupSpendingQuery(ctx, filter).
+		Select("split_payers.user_id, splits.currency, split_payers.paid_amount, 0 AS share_amount").
+		Joins("JOIN split_payers ON split_payers.split_id = splits.id")
+	shares := repo.groupSpendingQuery(ctx, filter).
+		Select("split_participants.user_id, splits.currency, 0 AS paid_amount, split_participants.share_amount").
+		Joins("JOIN split_participants ON split_participants.split_id = splits.id")
+
+	rows := make([]RepositoryPorts.MemberSpend, 0)
+	if err := repo.db.DB.WithContext(ctx).
+		Table("(? UNION ALL ?) AS spend", paid, shares).
+		Select("spend.user_id, users.name AS user_name, spend.currency, " +
+			"COALESCE(SUM(spend.paid_amount), 0) AS paid_amount, COALESCE(SUM(spend.share_amount), 0) AS share_amount").
+		Joins("JOIN users ON users.id = spend.user_id").
+		Group("spend.user_id, users.name, spend.currency").
+		Order("share_amount DESC, user_name").
+		Scan(&rows).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
 
-		groupBalance.NetAmount += netChange
-		if err := tx.Save(&groupBalance).Error; err != nil {
-			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
-		}
+	return rows, nil
+}
+
+func (repo *SplitRepository) GetGroupSpendByMonth(ctx context.Context, filter RepositoryPorts.SpendingFilter) ([]RepositoryPorts.MonthlySpend, error) {
+	rows := make([]RepositoryPorts.MonthlySpend, 0)
+	if err := repo.groupSpendingQuery(ctx, filter).
+		Select("date_trunc('month', splits.created_at) AS month, splits.currency, " +
+			"COALESCE(SUM(splits.total_amount), 0) AS total_amount, COUNT(*) AS split_count").
+		Group("month, splits.currency").
+		Order("month, splits.currency").
+		Scan(&rows).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
 	}
 
-	return nil
+	return rows, nil
 }
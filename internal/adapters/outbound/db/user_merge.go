@@ -0,0 +1,386 @@
+package RepositoryAdapters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userKeyedTable describes a table with at most one row per user and key.
+// When both users have a row for the same key, the sum columns of the source
+// row are added to the target row and the source row is dropped. The user
+// column defaults to user_id.
+type userKeyedTable struct {
+	name   string
+	column string
+	keys   []string
+	sums   []string
+}
+
+var userKeyedTables = []userKeyedTable{
+	{name: "split_participants", keys: []string{"split_id"}, sums: []string{"share_amount", "settled_amount", "percentage", "shares"}},
+	{name: "split_payers", keys: []string{"split_id"}, sums: []string{"paid_amount"}},
+	{name: "split_item_assignees", keys: []string{"split_item_id"}},
+	{name: "recurring_split_participants", keys: []string{"recurring_split_id"}, sums: []string{"share_amount", "percentage", "shares"}},
+	{name: "group_memberships", keys: []string{"group_id"}},
+	{name: "activity_event_users", keys: []string{"activity_event_id"}},
+	{name: "comment_mentions", keys: []string{"comment_id"}},
+	{name: "friendships", keys: []string{"friend_id"}},
+	{name: "friendships", column: "friend_id", keys: []string{"user_id"}},
+}
+
+// userReferences are plain user columns that can be repointed without
+// conflicts.
+var userReferences = []struct{ table, column string }{
+	{"settlements", "payer_id"},
+	{"settlements", "payee_id"},
+	{"payment_reminders", "debtor_id"},
+	{"payment_reminders", "sender_id"},
+	{"friend_requests", "sender_id"},
+	{"friend_requests", "receiver_id"},
+	{"groups", "owner_id"},
+	{"splits", "created_by_id"},
+	{"recurring_splits", "created_by_id"},
+	{"group_invites", "created_by_id"},
+	{"webhook_subscriptions", "owner_id"},
+	{"split_attachments", "uploaded_by_id"},
+	{"comments", "author_id"},
+}
+
+// groupRoleRank orders roles so that a merged membership keeps the stronger
+// of the two.
+const groupRoleRank = "CASE %s.role WHEN 'OWNER' THEN 0 WHEN 'ADMIN' THEN 1 ELSE 2 END"
+
+// reassignUserTx moves friendships, split, group and balance data from
+// sourceId to targetId. The ledger is append-only, so balances are moved by
+// posting entries that take them off sourceId and put them on targetId, and
+// what the two users owed each other cancels out.
+func reassignUserTx(tx *gorm.DB, sourceId, targetId uuid.UUID) error {
+	// The two users stop being friends with each other, and anything still
+	// pending between them is dropped.
+	if err := tx.Exec("DELETE FROM friendships WHERE (user_id = ? AND friend_id = ?) OR (user_id = ? AND friend_id = ?)",
+		sourceId, targetId, targetId, sourceId).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if err := tx.Exec("DELETE FROM friend_requests WHERE (sender_id = ? AND receiver_id = ?) OR (sender_id = ? AND receiver_id = ?)",
+		sourceId, targetId, targetId, sourceId).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if err := dropSettlementsBetweenTx(tx, sourceId, targetId); err != nil {
+		return err
+	}
+	if err := dropRemindersBetweenTx(tx, sourceId, targetId); err != nil {
+		return err
+	}
+
+	promote := fmt.Sprintf("UPDATE group_memberships t SET role = s.role, updated_at = NOW() FROM group_memberships s "+
+		"WHERE s.user_id = ? AND t.user_id = ? AND t.group_id = s.group_id AND %s < %s",
+		fmt.Sprintf(groupRoleRank, "s"), fmt.Sprintf(groupRoleRank, "t"))
+	if err := tx.Exec(promote, sourceId, targetId).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	sharedSplits, err := settledBySettlementsTx(tx, sourceId, targetId)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range userKeyedTables {
+		if err := mergeUserRowsTx(tx, table, sourceId, targetId); err != nil {
+			return err
+		}
+	}
+
+	if err := resettleSharedSplitsTx(tx, sharedSplits); err != nil {
+		return err
+	}
+	if err := tx.Exec("UPDATE split_participants SET is_settled = settled_amount >= share_amount WHERE user_id = ?", targetId).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	for _, ref := range userReferences {
+		query := fmt.Sprintf("UPDATE %s SET %s = ?, updated_at = NOW() WHERE %s = ?", ref.table, ref.column, ref.column)
+		if err := tx.Exec(query, targetId, sourceId).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	balances, err := ledgerBalancesTx(tx, sourceId)
+	if err != nil {
+		return err
+	}
+	return postLedgerEntriesTx(tx, Domain.UserMergeLedgerEntries(sourceId, targetId, balances))
+}
+
+// withDeleted preloads associations that may have been soft-deleted since.
+func withDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
+// dropSettlementsBetweenTx deletes the open settlements between the two users
+// on behalf of targetId. None of them have ledger entries: pending and
+// rejected ones were never confirmed, and disputing reversed the rest.
+func dropSettlementsBetweenTx(tx *gorm.DB, sourceId, targetId uuid.UUID) error {
+	var settlements []Domain.Settlement
+	if err := tx.Preload("Split", withDeleted).
+		Where("(payer_id = ? AND payee_id = ?) OR (payer_id = ? AND payee_id = ?)", sourceId, targetId, targetId, sourceId).
+		Find(&settlements).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	for _, settlement := range settlements {
+		if !settlement.DroppedByUserMerge(sourceId, targetId) {
+			continue
+		}
+		if err := tx.Delete(&Domain.Settlement{}, "id = ?", settlement.Id).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+
+		groupId := settlement.GroupID
+		if !settlement.IsDirect() {
+			groupId = settlement.Split.GroupID
+		}
+		activity := Domain.NewActivityEvent(targetId, Domain.ActivitySettlementDeleted, Domain.ActivityTargetSettlement, settlement.Id, groupId)
+		activity.AddAudience(settlement.PayerID, settlement.PayeeID)
+		activity.Before = Domain.ActivitySummary{
+			"payer_id": settlement.PayerID.String(),
+			"payee_id": settlement.PayeeID.String(),
+			"amount":   settlement.Amount,
+			"currency": string(settlement.Currency),
+			"status":   string(settlement.Status),
+		}
+		if err := recordActivityTx(tx, activity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropRemindersBetweenTx deletes the reminders one of the two users sent the
+// other on behalf of targetId.
+func dropRemindersBetweenTx(tx *gorm.DB, sourceId, targetId uuid.UUID) error {
+	var reminders []Domain.PaymentReminder
+	if err := tx.Preload("Split", withDeleted).
+		Where("(sender_id = ? AND debtor_id = ?) OR (sender_id = ? AND debtor_id = ?)", sourceId, targetId, targetId, sourceId).
+		Find(&reminders).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	for _, reminder := range reminders {
+		if !reminder.DroppedByUserMerge(sourceId, targetId) {
+			continue
+		}
+		if err := tx.Delete(&Domain.PaymentReminder{}, "id = ?", reminder.Id).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+
+		activity := Domain.NewActivityEvent(targetId, Domain.ActivityPaymentReminderDeleted, Domain.ActivityTargetSplit, reminder.SplitID, reminder.Split.GroupID)
+		activity.AddAudience(*reminder.SenderID, reminder.DebtorID)
+		activity.Before = Domain.ActivitySummary{
+			"description": reminder.Split.Description,
+			"sender_id":   reminder.SenderID.String(),
+			"debtor_id":   reminder.DebtorID.String(),
+			"amount":      reminder.Amount,
+			"currency":    string(reminder.Currency),
+		}
+		if err := recordActivityTx(tx, activity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ledgerBalancesTx sums userId's ledger entries per counterparty and per
+// group.
+func ledgerBalancesTx(tx *gorm.DB, userId uuid.UUID) ([]Domain.LedgerBalance, error) {
+	var balances []Domain.LedgerBalance
+	if err := tx.Model(&Domain.LedgerEntry{}).
+		Select("account, counterparty_id, currency, SUM(amount) AS amount").
+		Where("account = ? AND user_id = ?", Domain.LedgerAccountUser, userId).
+		Group("account, counterparty_id, currency").
+		Having("SUM(amount) <> 0").
+		Scan(&balances).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	var groupBalances []Domain.LedgerBalance
+	if err := tx.Model(&Domain.LedgerEntry{}).
+		Select("account, group_id, currency, SUM(amount) AS amount").
+		Where("account = ? AND user_id = ?", Domain.LedgerAccountGroup, userId).
+		Group("account, group_id, currency").
+		Having("SUM(amount) <> 0").
+		Scan(&groupBalances).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	return append(balances, groupBalances...), nil
+}
+
+// settledBySettlementsTx finds the splits both users pay for or take part in
+// and returns, per split and participant, how much of the share was settled
+// by settlements rather than by the participant's own payment. The source's
+// amounts are already counted under the target.
+func settledBySettlementsTx(tx *gorm.DB, sourceId, targetId uuid.UUID) (map[uuid.UUID]map[uuid.UUID]int64, error) {
+	var splitIds []uuid.UUID
+	if err := tx.Raw(`
+		SELECT split_id FROM (
+			SELECT split_id, user_id FROM split_participants WHERE deleted_at IS NULL
+			UNION SELECT split_id, user_id FROM split_payers WHERE deleted_at IS NULL
+		) m
+		WHERE user_id IN (?, ?)
+		GROUP BY split_id
+		HAVING COUNT(DISTINCT user_id) = 2`, sourceId, targetId).
+		Scan(&splitIds).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	settled := make(map[uuid.UUID]map[uuid.UUID]int64, len(splitIds))
+	if len(splitIds) == 0 {
+		return settled, nil
+	}
+
+	var splits []Domain.Split
+	if err := tx.Preload("Payers").
+		Preload("Participants", func(db *gorm.DB) *gorm.DB {
+			return db.Clauses(clause.Locking{Strength: "UPDATE"})
+		}).
+		Where("id IN ?", splitIds).
+		Find(&splits).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	for _, split := range splits {
+		bySettlements := make(map[uuid.UUID]int64, len(split.Participants))
+		for _, participant := range split.Participants {
+			userId := participant.UserID
+			if userId == sourceId {
+				userId = targetId
+			}
+			bySettlements[userId] += participant.SettledAmount - Domain.SelfPaidShare(participant, split.Payers)
+		}
+		settled[split.Id] = bySettlements
+	}
+	return settled, nil
+}
+
+// resettleSharedSplitsTx works out the settled amounts of merged splits again.
+// Once merged, the target's own payment covers a share the source used to owe
+// them, so it counts as settled.
+func resettleSharedSplitsTx(tx *gorm.DB, settled map[uuid.UUID]map[uuid.UUID]int64) error {
+	for splitId, bySettlements := range settled {
+		var payers []Domain.SplitPayer
+		if err := tx.Where("split_id = ?", splitId).Find(&payers).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+		var participants []Domain.SplitParticipant
+		if err := tx.Where("split_id = ?", splitId).Find(&participants).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+
+		for _, participant := range participants {
+			settledAmount := min(participant.ShareAmount, bySettlements[participant.UserID]+Domain.SelfPaidShare(participant, payers))
+			if err := tx.Model(&Domain.SplitParticipant{}).
+				Where("id = ?", participant.Id).
+				Updates(map[string]any{
+					"settled_amount": settledAmount,
+					"is_settled":     settledAmount >= participant.ShareAmount,
+				}).Error; err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+			}
+		}
+	}
+	return nil
+}
+
+func mergeUserRowsTx(tx *gorm.DB, table userKeyedTable, sourceId, targetId uuid.UUID) error {
+	column := table.column
+	if column == "" {
+		column = "user_id"
+	}
+
+	match := make([]string, len(table.keys))
+	for i, key := range table.keys {
+		match[i] = fmt.Sprintf("t.%s = s.%s", key, key)
+	}
+	sameKey := strings.Join(match, " AND ")
+
+	if len(table.sums) > 0 {
+		sets := make([]string, len(table.sums))
+		for i, column := range table.sums {
+			sets[i] = fmt.Sprintf("%s = t.%s + s.%s", column, column, column)
+		}
+		query := fmt.Sprintf("UPDATE %s t SET %s, updated_at = NOW() FROM %s s WHERE s.%s = ? AND t.%s = ? AND %s",
+			table.name, strings.Join(sets, ", "), table.name, column, column, sameKey)
+		if err := tx.Exec(query, sourceId, targetId).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s s WHERE s.%s = ? AND EXISTS (SELECT 1 FROM %s t WHERE t.%s = ? AND %s)",
+		table.name, column, table.name, column, sameKey)
+	if err := tx.Exec(query, sourceId, targetId).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	query = fmt.Sprintf("UPDATE %s SET %s = ?, updated_at = NOW() WHERE %s = ?", table.name, column, column)
+	if err := tx.Exec(query, targetId, sourceId).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
+// claimGuestTx folds a guest into a registered user and retires the guest.
+func claimGuestTx(tx *gorm.DB, guestId, userId uuid.UUID) error {
+	result := tx.Model(&Domain.User{}).
+		Where("id = ? AND status = ?", guestId, Domain.AccountGuest).
+		Updates(map[string]any{"status": Domain.AccountDeactivated, "merged_into_id": userId})
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrGuestNotFound)
+	}
+
+	return reassignUserTx(tx, guestId, userId)
+}
+
+// mergeAccountTx folds one active account into another and retires the
+// source. Both rows are locked so neither account can change status halfway
+// through the merge.
+func mergeAccountTx(tx *gorm.DB, sourceId, targetId uuid.UUID) error {
+	var users []Domain.User
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ? AND status = ?", []uuid.UUID{sourceId, targetId}, Domain.AccountActive).
+		Order("id").
+		Find(&users).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if len(users) != 2 {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrUserNotFound)
+	}
+
+	if err := reassignUserTx(tx, sourceId, targetId); err != nil {
+		return err
+	}
+
+	if err := tx.Model(&Domain.RefreshToken{}).
+		Where("user_id = ? AND revoked = ?", sourceId, false).
+		Update("revoked", true).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Model(&Domain.User{}).
+		Where("id = ?", sourceId).
+		Updates(map[string]any{"status": Domain.AccountDeactivated, "merged_into_id": targetId}).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
@@ -48,3 +48,19 @@ func (repo *UserRepository) UpdateUser(ctx context.Context, id uuid.UUID, update
 	}
 	return &user, nil
 }
+
+func (repo *UserRepository) UpdateNotificationPreferences(ctx context.Context, id uuid.UUID, updates map[string]any) (*Domain.User, error) {
+	var user Domain.User
+	if repo.db.DB.WithContext(ctx).Where("id = ? AND status = ?", id, Domain.AccountActive).First(&user).Error != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrUserNotFound)
+	}
+
+	if err := repo.db.DB.WithContext(ctx).Model(&user).Updates(updates).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := repo.db.DB.WithContext(ctx).First(&user, "id = ?", id).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return &user, nil
+}
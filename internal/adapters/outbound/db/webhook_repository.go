@@ -0,0 +1,277 @@
+package RepositoryAdapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Domain "autobill-service/internal/domain"
+	DB "autobill-service/internal/infrastructure/db"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookRepository struct {
+	db DB.PostgresDB
+}
+
+func CreateWebhookRepository(db DB.PostgresDB) RepositoryPorts.WebhookRepositoryPort {
+	return &WebhookRepository{db: db}
+}
+
+func (repo *WebhookRepository) CreateSubscription(ctx context.Context, subscription *Domain.WebhookSubscription) (*Domain.WebhookSubscription, error) {
+	if err := repo.db.DB.WithContext(ctx).Omit("Owner", "Group").Create(subscription).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return subscription, nil
+}
+
+func (repo *WebhookRepository) GetSubscriptionById(ctx context.Context, subscriptionId uuid.UUID) (*Domain.WebhookSubscription, error) {
+	var subscription Domain.WebhookSubscription
+	if err := repo.db.DB.WithContext(ctx).First(&subscription, "id = ?", subscriptionId).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrWebhookNotFound)
+	}
+	return &subscription, nil
+}
+
+func (repo *WebhookRepository) GetSubscriptionsByOwnerId(ctx context.Context, ownerId uuid.UUID, limit, offset int) ([]Domain.WebhookSubscription, int64, error) {
+	var subscriptions []Domain.WebhookSubscription
+	var total int64
+
+	query := repo.db.DB.WithContext(ctx).Model(&Domain.WebhookSubscription{}).Where("owner_id = ?", ownerId)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if total == 0 {
+		return []Domain.WebhookSubscription{}, 0, nil
+	}
+
+	if err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&subscriptions).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return subscriptions, total, nil
+}
+
+func (repo *WebhookRepository) UpdateSubscription(ctx context.Context, subscriptionId uuid.UUID, updates map[string]any) (*Domain.WebhookSubscription, error) {
+	if len(updates) > 0 {
+		if err := repo.db.DB.WithContext(ctx).Model(&Domain.WebhookSubscription{}).Where("id = ?", subscriptionId).Updates(updates).Error; err != nil {
+			return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+	return repo.GetSubscriptionById(ctx, subscriptionId)
+}
+
+func (repo *WebhookRepository) DeleteSubscription(ctx context.Context, subscriptionId uuid.UUID) error {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Delete(&Domain.WebhookDelivery{}, "subscription_id = ? AND status = ?", subscriptionId, Domain.WebhookDeliveryPending).Error; err != nil {
+		tx.Rollback()
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Delete(&Domain.WebhookSubscription{}, "id = ?", subscriptionId).Error; err != nil {
+		tx.Rollback()
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
+func (repo *WebhookRepository) GetDeliveries(ctx context.Context, subscriptionId uuid.UUID, status *Domain.WebhookDeliveryStatus, limit, offset int) ([]Domain.WebhookDelivery, int64, error) {
+	query := repo.db.DB.WithContext(ctx).Model(&Domain.WebhookDelivery{}).Where("subscription_id = ?", subscriptionId)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	return repo.findDeliveries(query, limit, offset)
+}
+
+func (repo *WebhookRepository) GetDeadDeliveriesByOwnerId(ctx context.Context, ownerId uuid.UUID, limit, offset int) ([]Domain.WebhookDelivery, int64, error) {
+	subscriptions := repo.db.DB.WithContext(ctx).Model(&Domain.WebhookSubscription{}).
+		Select("id").
+		Where("owner_id = ?", ownerId)
+
+	query := repo.db.DB.WithContext(ctx).Model(&Domain.WebhookDelivery{}).
+		Where("status = ? AND subscription_id IN (?)", Domain.WebhookDeliveryDead, subscriptions)
+	return repo.findDeliveries(query, limit, offset)
+}
+
+func (repo *WebhookRepository) findDeliveries(query *gorm.DB, limit, offset int) ([]Domain.WebhookDelivery, int64, error) {
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if total == 0 {
+		return []Domain.WebhookDelivery{}, 0, nil
+	}
+
+	var deliveries []Domain.WebhookDelivery
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&deliveries).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return deliveries, total, nil
+}
+
+func (repo *WebhookRepository) GetDeliveryById(ctx context.Context, deliveryId uuid.UUID) (*Domain.WebhookDelivery, error) {
+	var delivery Domain.WebhookDelivery
+	if err := repo.db.DB.WithContext(ctx).Preload("Subscription").First(&delivery, "id = ?", deliveryId).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrWebhookDeliveryNotFound)
+	}
+	return &delivery, nil
+}
+
+func (repo *WebhookRepository) RequeueDelivery(ctx context.Context, deliveryId uuid.UUID, now time.Time) (*Domain.WebhookDelivery, error) {
+	result := repo.db.DB.WithContext(ctx).Model(&Domain.WebhookDelivery{}).
+		Where("id = ? AND status = ?", deliveryId, Domain.WebhookDeliveryDead).
+		Updates(map[string]any{
+			"status":          Domain.WebhookDeliveryPending,
+			"attempts":        0,
+			"next_attempt_at": now,
+		})
+	if result.Error != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrWebhookDeliveryNotDead)
+	}
+	return repo.GetDeliveryById(ctx, deliveryId)
+}
+
+func (repo *WebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Domain.WebhookDelivery, error) {
+	tx := repo.db.DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	activeSubscriptions := tx.Model(&Domain.WebhookSubscription{}).
+		Select("id").
+		Where("active = ?", true)
+
+	var deliveries []Domain.WebhookDelivery
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_attempt_at <= ?", Domain.WebhookDeliveryPending, now).
+		Where("subscription_id IN (?)", activeSubscriptions).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if len(deliveries) == 0 {
+		tx.Rollback()
+		return deliveries, nil
+	}
+
+	deliveryIds := make([]uuid.UUID, len(deliveries))
+	subscriptionIds := make([]uuid.UUID, 0, len(deliveries))
+	for i, delivery := range deliveries {
+		deliveryIds[i] = delivery.Id
+		subscriptionIds = append(subscriptionIds, delivery.SubscriptionID)
+	}
+
+	if err := tx.Model(&Domain.WebhookDelivery{}).
+		Where("id IN ?", deliveryIds).
+		Update("next_attempt_at", now.Add(lease)).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	var subscriptions []Domain.WebhookSubscription
+	if err := tx.Where("id IN ?", subscriptionIds).Find(&subscriptions).Error; err != nil {
+		tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	subscriptionsById := make(map[uuid.UUID]Domain.WebhookSubscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		subscriptionsById[subscription.Id] = subscription
+	}
+	for i := range deliveries {
+		deliveries[i].Subscription = subscriptionsById[deliveries[i].SubscriptionID]
+	}
+
+	return deliveries, nil
+}
+
+func (repo *WebhookRepository) SaveDeliveryAttempt(ctx context.Context, delivery *Domain.WebhookDelivery) error {
+	if err := repo.db.DB.WithContext(ctx).Model(&Domain.WebhookDelivery{}).
+		Where("id = ?", delivery.Id).
+		Updates(map[string]any{
+			"status":           delivery.Status,
+			"attempts":         delivery.Attempts,
+			"next_attempt_at":  delivery.NextAttemptAt,
+			"last_attempt_at":  delivery.LastAttemptAt,
+			"last_status_code": delivery.LastStatusCode,
+			"last_error":       delivery.LastError,
+			"delivered_at":     delivery.DeliveredAt,
+		}).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+	return nil
+}
+
+// enqueueWebhookDeliveriesTx writes an outbox row for every active
+// subscription interested in the activity: group-scoped subscriptions whose
+// owner is still a member, and user-scoped subscriptions of its audience.
+func enqueueWebhookDeliveriesTx(tx *gorm.DB, activity *Domain.ActivityEvent) error {
+	if !Domain.IsWebhookEvent(activity.Action) || len(activity.Audience) == 0 {
+		return nil
+	}
+
+	audience := make([]uuid.UUID, len(activity.Audience))
+	for i, member := range activity.Audience {
+		audience[i] = member.UserID
+	}
+
+	query := tx.Where("active = ?", true)
+	if activity.GroupID != nil {
+		query = query.Where(`(group_id IS NULL AND owner_id IN ?) OR (group_id = ? AND EXISTS (
+			SELECT 1 FROM group_memberships gm
+			WHERE gm.group_id = webhook_subscriptions.group_id AND gm.user_id = webhook_subscriptions.owner_id AND gm.deleted_at IS NULL
+		))`, audience, *activity.GroupID)
+	} else {
+		query = query.Where("group_id IS NULL AND owner_id IN ?", audience)
+	}
+
+	var subscriptions []Domain.WebhookSubscription
+	if err := query.Find(&subscriptions).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+	}
+
+	now := time.Now().UTC()
+	for i := range subscriptions {
+		if !subscriptions[i].Matches(activity.Action) {
+			continue
+		}
+		delivery, err := Domain.NewWebhookDelivery(&subscriptions[i], activity, now)
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrInternal)
+		}
+		if err := tx.Omit("Subscription").Create(delivery).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrDatabaseFailure)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package EventAdapters
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	Domain "autobill-service/internal/domain"
+	EventPorts "autobill-service/internal/ports/outbound/events"
+)
+
+const subscriberBufferSize = 64
+
+type memorySubscriber struct {
+	userId uuid.UUID
+	events chan Domain.Event
+}
+
+// MemoryEventBus fans events out to subscribers in this process and retains
+// the most recent ones so reconnecting clients can resume.
+type MemoryEventBus struct {
+	mu          sync.Mutex
+	lastId      uint64
+	history     []Domain.Event
+	historySize int
+	subscribers map[*memorySubscriber]struct{}
+	closed      bool
+}
+
+func CreateMemoryEventBus(historySize int) EventPorts.EventBus {
+	return &MemoryEventBus{
+		// Seeding IDs from the clock keeps them increasing across restarts, so
+		// a Last-Event-ID issued before a restart still orders correctly.
+		lastId:      uint64(time.Now().UnixMicro()),
+		historySize: historySize,
+		subscribers: make(map[*memorySubscriber]struct{}),
+	}
+}
+
+func (b *MemoryEventBus) Publish(event Domain.Event) {
+	if len(event.Recipients) == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.lastId++
+	event.ID = b.lastId
+
+	b.history = append(b.history, event)
+	if len(b.history) >= 2*b.historySize {
+		b.history = append(b.history[:0], b.history[len(b.history)-b.historySize:]...)
+	}
+
+	for sub := range b.subscribers {
+		if !event.IsFor(sub.userId) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			// A subscriber that cannot keep up is dropped; the client reconnects
+			// with its Last-Event-ID and catches up from history.
+			b.remove(sub)
+		}
+	}
+}
+
+func (b *MemoryEventBus) Subscribe(userId uuid.UUID, lastEventId uint64) ([]Domain.Event, <-chan Domain.Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	backlog := []Domain.Event{}
+	if lastEventId > 0 {
+		for _, event := range b.history {
+			if event.ID > lastEventId && event.IsFor(userId) {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	sub := &memorySubscriber{
+		userId: userId,
+		events: make(chan Domain.Event, subscriberBufferSize),
+	}
+	if b.closed {
+		close(sub.events)
+		return backlog, sub.events, func() {}
+	}
+	b.subscribers[sub] = struct{}{}
+
+	return backlog, sub.events, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(sub)
+	}
+}
+
+func (b *MemoryEventBus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
+
+func (b *MemoryEventBus) remove(sub *memorySubscriber) {
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
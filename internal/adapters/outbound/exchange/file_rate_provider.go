@@ -0,0 +1,208 @@
+package ExchangeAdapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	Domain "autobill-service/internal/domain"
+	ExchangePorts "autobill-service/internal/ports/outbound/exchange"
+	Errors "autobill-service/pkg/errors"
+	Logger "autobill-service/pkg/logger"
+)
+
+type FileExchangeRateProvider struct {
+	path string
+	mu   sync.RWMutex
+}
+
+type fileExchangeRate struct {
+	Id            uuid.UUID       `json:"id"`
+	BaseCurrency  Domain.Currency `json:"base_currency"`
+	QuoteCurrency Domain.Currency `json:"quote_currency"`
+	Rate          float64         `json:"rate"`
+	EffectiveDate string          `json:"effective_date"`
+	Source        string          `json:"source"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+const effectiveDateLayout = "2006-01-02"
+
+func CreateFileExchangeRateProvider(path string) ExchangePorts.ExchangeRateProvider {
+	return &FileExchangeRateProvider{path: path}
+}
+
+func (p *FileExchangeRateProvider) GetRate(ctx context.Context, base, quote Domain.Currency, asOf time.Time) (*Domain.ExchangeRate, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	rates, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+
+	rate, found := Domain.FindExchangeRate(rates, base, quote, asOf)
+	if !found {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, Errors.ErrExchangeRateNotFound)
+	}
+	return &rate, nil
+}
+
+func (p *FileExchangeRateProvider) GetRates(ctx context.Context, asOf time.Time) ([]Domain.ExchangeRate, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	rates, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+
+	type pair struct{ base, quote Domain.Currency }
+	latest := make(map[pair]Domain.ExchangeRate)
+	for _, r := range rates {
+		if r.EffectiveDate.After(asOf) {
+			continue
+		}
+		key := pair{r.BaseCurrency, r.QuoteCurrency}
+		if existing, ok := latest[key]; !ok || r.EffectiveDate.After(existing.EffectiveDate) {
+			latest[key] = r
+		}
+	}
+
+	result := make([]Domain.ExchangeRate, 0, len(latest))
+	for _, r := range latest {
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].BaseCurrency != result[j].BaseCurrency {
+			return result[i].BaseCurrency < result[j].BaseCurrency
+		}
+		return result[i].QuoteCurrency < result[j].QuoteCurrency
+	})
+	return result, nil
+}
+
+func (p *FileExchangeRateProvider) SaveRates(ctx context.Context, rates []Domain.ExchangeRate) ([]Domain.ExchangeRate, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	existing, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	for i := range rates {
+		rates[i].UpdatedAt = now
+
+		replaced := false
+		for j := range existing {
+			if existing[j].BaseCurrency == rates[i].BaseCurrency &&
+				existing[j].QuoteCurrency == rates[i].QuoteCurrency &&
+				existing[j].EffectiveDate.Equal(rates[i].EffectiveDate) {
+				rates[i].Id = existing[j].Id
+				rates[i].CreatedAt = existing[j].CreatedAt
+				existing[j] = rates[i]
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			rates[i].Id = uuid.New()
+			rates[i].CreatedAt = now
+			existing = append(existing, rates[i])
+		}
+	}
+
+	if err := p.store(existing); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (p *FileExchangeRateProvider) load() ([]Domain.ExchangeRate, error) {
+	data, err := os.ReadFile(p.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Domain.ExchangeRate{}, nil
+	}
+	if err != nil {
+		Logger.Error().Err(err).Str("path", p.path).Msg("Failed to read exchange rate file")
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrExchangeRateStoreFailure)
+	}
+
+	var entries []fileExchangeRate
+	if err := json.Unmarshal(data, &entries); err != nil {
+		Logger.Error().Err(err).Str("path", p.path).Msg("Failed to parse exchange rate file")
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrExchangeRateStoreFailure)
+	}
+
+	rates := make([]Domain.ExchangeRate, 0, len(entries))
+	for _, e := range entries {
+		effectiveDate, err := time.Parse(effectiveDateLayout, e.EffectiveDate)
+		if err != nil {
+			Logger.Warn().Str("path", p.path).Str("effectiveDate", e.EffectiveDate).Msg("Skipping exchange rate with invalid effective date")
+			continue
+		}
+
+		rate := Domain.ExchangeRate{
+			BaseCurrency:  e.BaseCurrency,
+			QuoteCurrency: e.QuoteCurrency,
+			Rate:          e.Rate,
+			EffectiveDate: effectiveDate,
+			Source:        e.Source,
+		}
+		rate.Id = e.Id
+		rate.UpdatedAt = e.UpdatedAt
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+func (p *FileExchangeRateProvider) store(rates []Domain.ExchangeRate) error {
+	entries := make([]fileExchangeRate, len(rates))
+	for i, r := range rates {
+		entries[i] = fileExchangeRate{
+			Id:            r.Id,
+			BaseCurrency:  r.BaseCurrency,
+			QuoteCurrency: r.QuoteCurrency,
+			Rate:          r.Rate,
+			EffectiveDate: r.EffectiveDate.Format(effectiveDateLayout),
+			Source:        r.Source,
+			UpdatedAt:     r.UpdatedAt,
+		}
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrExchangeRateStoreFailure)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p.path), filepath.Base(p.path)+".*.tmp")
+	if err != nil {
+		Logger.Error().Err(err).Str("path", p.path).Msg("Failed to create exchange rate temp file")
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrExchangeRateStoreFailure)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		Logger.Error().Err(err).Str("path", p.path).Msg("Failed to write exchange rate file")
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrExchangeRateStoreFailure)
+	}
+	if err := tmp.Close(); err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrExchangeRateStoreFailure)
+	}
+	if err := os.Rename(tmp.Name(), p.path); err != nil {
+		Logger.Error().Err(err).Str("path", p.path).Msg("Failed to replace exchange rate file")
+		return fiber.NewError(fiber.StatusInternalServerError, Errors.ErrExchangeRateStoreFailure)
+	}
+	return nil
+}
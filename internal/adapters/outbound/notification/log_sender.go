@@ -0,0 +1,54 @@
+package NotificationAdapters
+
+import (
+	"context"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"sync"
+
+	NotificationPorts "autobill-service/internal/ports/outbound/notification"
+	Logger "autobill-service/pkg/logger"
+)
+
+// LogNotificationSender writes messages to the log and, when a path is set,
+// appends them to a file instead of sending them. It is meant for
+// development and tests.
+type LogNotificationSender struct {
+	path string
+	from mail.Address
+	mu   sync.Mutex
+}
+
+func CreateLogNotificationSender(path, from string) NotificationPorts.NotificationSender {
+	return &LogNotificationSender{path: path, from: mail.Address{Name: senderName, Address: from}}
+}
+
+func (s *LogNotificationSender) Send(ctx context.Context, message NotificationPorts.Message) error {
+	Logger.Info().
+		Str("to", message.To).
+		Str("subject", message.Subject).
+		Msg("Notification email")
+
+	if s.path == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	content := formatMessage(s.from, message)
+	if _, err := file.Write(append(content, "\r\n\r\n"...)); err != nil {
+		return err
+	}
+	return nil
+}
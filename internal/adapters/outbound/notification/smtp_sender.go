@@ -0,0 +1,105 @@
+package NotificationAdapters
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	NotificationPorts "autobill-service/internal/ports/outbound/notification"
+)
+
+const senderName = "AutoBill"
+
+type SMTPNotificationSender struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     mail.Address
+	timeout  time.Duration
+}
+
+func CreateSMTPNotificationSender(host, port, username, password, from string, timeout time.Duration) NotificationPorts.NotificationSender {
+	return &SMTPNotificationSender{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     mail.Address{Name: senderName, Address: from},
+		timeout:  timeout,
+	}
+}
+
+func (s *SMTPNotificationSender) Send(ctx context.Context, message NotificationPorts.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.host, s.port))
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(formatMessage(s.from, message)); err != nil {
+		writer.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func formatMessage(from mail.Address, message NotificationPorts.Message) []byte {
+	to := mail.Address{Name: message.ToName, Address: message.To}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "From: %s\r\n", from.String())
+	fmt.Fprintf(&builder, "To: %s\r\n", to.String())
+	fmt.Fprintf(&builder, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&builder, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	builder.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	builder.WriteString("\r\n")
+	builder.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(builder.String())
+}
@@ -0,0 +1,113 @@
+package WebhookAdapters
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+	WebhookPorts "autobill-service/internal/ports/outbound/webhook"
+)
+
+const (
+	SignatureHeader = "X-Autobill-Signature"
+	EventHeader     = "X-Autobill-Event"
+	DeliveryHeader  = "X-Autobill-Delivery"
+
+	maxResponseBytes = 64 << 10
+)
+
+var errWebhookTargetNotAllowed = errors.New("webhook url must be https and point to a public address")
+
+type HTTPWebhookSender struct {
+	client *http.Client
+	// allowInternal lets tests deliver to plain http receivers on loopback.
+	allowInternal bool
+}
+
+// CreateHTTPWebhookSender only delivers over https to public addresses. The
+// address is checked when connecting, after DNS resolution, and redirects are
+// not followed, so a receiver cannot point a delivery back inside the
+// deployment.
+func CreateHTTPWebhookSender(timeout time.Duration) WebhookPorts.WebhookSender {
+	return newHTTPWebhookSender(timeout, false)
+}
+
+func newHTTPWebhookSender(timeout time.Duration, allowInternal bool) *HTTPWebhookSender {
+	sender := &HTTPWebhookSender{allowInternal: allowInternal}
+	dialer := &net.Dialer{Timeout: timeout, Control: sender.checkAddress}
+	sender.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return sender
+}
+
+func (s *HTTPWebhookSender) checkAddress(network, address string, conn syscall.RawConn) error {
+	if s.allowInternal {
+		return nil
+	}
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil || !Domain.IsPublicWebhookAddress(addrPort.Addr()) {
+		return errWebhookTargetNotAllowed
+	}
+	return nil
+}
+
+func (s *HTTPWebhookSender) Send(ctx context.Context, request WebhookPorts.WebhookRequest) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Payload))
+	if err != nil {
+		return 0, err
+	}
+	if req.URL.Scheme != "https" && !s.allowInternal {
+		return 0, errWebhookTargetNotAllowed
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "autobill-webhooks/1.0")
+	req.Header.Set(EventHeader, request.EventType)
+	req.Header.Set(DeliveryHeader, request.DeliveryID.String())
+	req.Header.Set(SignatureHeader, fmt.Sprintf("t=%d,v1=%s", timestamp, Sign(request.Secret, timestamp, request.Payload)))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the hex HMAC-SHA256 of "<timestamp>.<payload>". Receivers
+// recompute it from the t= value of the signature header and the raw body.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,174 @@
+package WebhookAdapters
+
+import (
+	"context"
+	"crypto/hmac"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	WebhookPorts "autobill-service/internal/ports/outbound/webhook"
+
+	"github.com/google/uuid"
+)
+
+func parseSignature(t *testing.T, header string) (int64, string) {
+	t.Helper()
+
+	var timestamp int64
+	var signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			t.Fatalf("malformed signature header %q", header)
+		}
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				t.Fatalf("malformed timestamp in %q", header)
+			}
+			timestamp = parsed
+		case "v1":
+			signature = value
+		}
+	}
+	return timestamp, signature
+}
+
+func TestSendSignsPayload(t *testing.T) {
+	secret := "whsec_test"
+	payload := []byte(`{"event":"SPLIT_CREATED"}`)
+	deliveryId := uuid.New()
+
+	received := make(chan *http.Request, 1)
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		received <- r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	sender := newHTTPWebhookSender(time.Second, true)
+	status, err := sender.Send(context.Background(), WebhookPorts.WebhookRequest{
+		URL:        receiver.URL,
+		Secret:     secret,
+		DeliveryID: deliveryId,
+		EventType:  "SPLIT_CREATED",
+		Payload:    payload,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, status)
+	}
+
+	r := <-received
+	if r.Method != http.MethodPost {
+		t.Fatalf("expected POST, got %s", r.Method)
+	}
+	if got := r.Header.Get(EventHeader); got != "SPLIT_CREATED" {
+		t.Fatalf("unexpected event header %q", got)
+	}
+	if got := r.Header.Get(DeliveryHeader); got != deliveryId.String() {
+		t.Fatalf("unexpected delivery header %q", got)
+	}
+	if string(body) != string(payload) {
+		t.Fatalf("unexpected body %q", body)
+	}
+
+	timestamp, signature := parseSignature(t, r.Header.Get(SignatureHeader))
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body))) {
+		t.Fatalf("signature does not verify")
+	}
+	if hmac.Equal([]byte(signature), []byte(Sign("other", timestamp, body))) {
+		t.Fatalf("signature verifies with the wrong secret")
+	}
+}
+
+func TestSendReportsFailures(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+	}{
+		{"server error", http.StatusInternalServerError},
+		{"client error", http.StatusGone},
+		{"redirect", http.StatusNotModified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			status, err := newHTTPWebhookSender(time.Second, true).Send(context.Background(), WebhookPorts.WebhookRequest{
+				URL:     receiver.URL,
+				Secret:  "whsec_test",
+				Payload: []byte(`{}`),
+			})
+			if err == nil {
+				t.Fatalf("expected an error for status %d", tt.status)
+			}
+			if status != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, status)
+			}
+		})
+	}
+}
+
+func TestSendTimesOut(t *testing.T) {
+	release := make(chan struct{})
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer receiver.Close()
+	defer close(release)
+
+	status, err := newHTTPWebhookSender(50*time.Millisecond, true).Send(context.Background(), WebhookPorts.WebhookRequest{
+		URL:     receiver.URL,
+		Secret:  "whsec_test",
+		Payload: []byte(`{}`),
+	})
+	if err == nil {
+		t.Fatalf("expected a timeout error")
+	}
+	if status != 0 {
+		t.Fatalf("expected no status, got %d", status)
+	}
+}
+
+func TestSendRefusesInternalReceivers(t *testing.T) {
+	called := false
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	sender := CreateHTTPWebhookSender(time.Second)
+	for _, url := range []string{receiver.URL, strings.Replace(receiver.URL, "https://", "http://", 1)} {
+		status, err := sender.Send(context.Background(), WebhookPorts.WebhookRequest{
+			URL:     url,
+			Secret:  "whsec_test",
+			Payload: []byte(`{}`),
+		})
+		if !errors.Is(err, errWebhookTargetNotAllowed) {
+			t.Errorf("%s: expected the receiver to be refused, got %v", url, err)
+		}
+		if status != 0 {
+			t.Errorf("%s: expected no status, got %d", url, status)
+		}
+	}
+	if called {
+		t.Fatalf("receiver on loopback was called")
+	}
+}
@@ -0,0 +1,23 @@
+package ActivityApplicationDtos
+
+import "time"
+
+type ActivityResult struct {
+	ID         string
+	ActorID    string
+	ActorName  string
+	Action     string
+	TargetType string
+	TargetID   string
+	GroupID    *string
+	Before     map[string]interface{}
+	After      map[string]interface{}
+	CreatedAt  time.Time
+}
+
+type ActivityListResult struct {
+	Activities []ActivityResult
+	Page       int
+	PageSize   int
+	TotalItems int64
+}
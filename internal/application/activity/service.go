@@ -0,0 +1,76 @@
+package ActivityApplication
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/activity/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/google/uuid"
+)
+
+type ActivityService struct {
+	repo      RepositoryPorts.ActivityRepositoryPort
+	groupRepo RepositoryPorts.GroupRepositoryPort
+}
+
+func CreateActivityService(repo RepositoryPorts.ActivityRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort) HttpPorts.ActivityUseCase {
+	return &ActivityService{
+		repo:      repo,
+		groupRepo: groupRepo,
+	}
+}
+
+func (s *ActivityService) GetMyActivity(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.ActivityListResult, error) {
+	events, total, dbErr := s.repo.GetUserActivity(ctx, userId, pagination.PageSize, pagination.Offset())
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return toActivityListResult(events, total, pagination), nil
+}
+
+func (s *ActivityService) GetGroupActivity(ctx context.Context, userId, groupId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.ActivityListResult, error) {
+	_, memberErr := s.groupRepo.GetMembership(ctx, groupId, userId)
+	if memberErr != nil {
+		return nil, memberErr
+	}
+
+	events, total, dbErr := s.repo.GetGroupActivity(ctx, groupId, pagination.PageSize, pagination.Offset())
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return toActivityListResult(events, total, pagination), nil
+}
+
+func toActivityListResult(events []Domain.ActivityEvent, total int64, pagination Helpers.PaginationParams) *Dtos.ActivityListResult {
+	activities := make([]Dtos.ActivityResult, len(events))
+	for i, event := range events {
+		activities[i] = Dtos.ActivityResult{
+			ID:         event.Id.String(),
+			ActorID:    event.ActorID.String(),
+			ActorName:  event.Actor.Name,
+			Action:     string(event.Action),
+			TargetType: string(event.TargetType),
+			TargetID:   event.TargetID.String(),
+			Before:     event.Before,
+			After:      event.After,
+			CreatedAt:  event.CreatedAt,
+		}
+		if event.GroupID != nil {
+			groupId := event.GroupID.String()
+			activities[i].GroupID = &groupId
+		}
+	}
+
+	return &Dtos.ActivityListResult{
+		Activities: activities,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalItems: total,
+	}
+}
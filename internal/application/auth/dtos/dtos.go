@@ -15,6 +15,12 @@ type RefreshTokenInput struct {
 	RefreshToken string
 }
 
+type MergeAccountInput struct {
+	Password       string
+	SourceEmail    string
+	SourcePassword string
+}
+
 type AuthResult struct {
 	ID           string
 	Token        string
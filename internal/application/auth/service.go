@@ -2,12 +2,14 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 
 	Dtos "autobill-service/internal/application/auth/dtos"
 	Domain "autobill-service/internal/domain"
 	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	Errors "autobill-service/pkg/errors"
 	JWTUtil "autobill-service/pkg/jwt"
 	Logger "autobill-service/pkg/logger"
@@ -16,12 +18,15 @@ import (
 )
 
 type AuthService struct {
-	db   RepositoryPorts.AuthRepositoryPort
-	util JWTUtil.JWTUtil
+	db      RepositoryPorts.AuthRepositoryPort
+	groups  RepositoryPorts.GroupRepositoryPort
+	invites RepositoryPorts.InviteRepositoryPort
+	events  EventPorts.EventPublisher
+	util    JWTUtil.JWTUtil
 }
 
-func CreateAuthService(db RepositoryPorts.AuthRepositoryPort, util JWTUtil.JWTUtil) *AuthService {
-	return &AuthService{db: db, util: util}
+func CreateAuthService(db RepositoryPorts.AuthRepositoryPort, groups RepositoryPorts.GroupRepositoryPort, invites RepositoryPorts.InviteRepositoryPort, events EventPorts.EventPublisher, util JWTUtil.JWTUtil) *AuthService {
+	return &AuthService{db: db, groups: groups, invites: invites, events: events, util: util}
 }
 
 func (service *AuthService) generateTokenPair(ctx context.Context, userId uuid.UUID) (*Dtos.AuthResult, error) {
@@ -57,6 +62,8 @@ func (service *AuthService) RegisterUser(ctx context.Context, input Dtos.Registe
 	if dbErr != nil {
 		return nil, dbErr
 	}
+	service.claimGuests(ctx, user)
+	service.applyEmailInvites(ctx, user)
 
 	result, err := service.generateTokenPair(ctx, user.Id)
 	if err != nil {
@@ -72,6 +79,54 @@ func (service *AuthService) RegisterUser(ctx context.Context, input Dtos.Registe
 	return result, nil
 }
 
+// claimGuests merges guests created with the new user's email address into
+// the account, so their splits and balances carry over.
+func (service *AuthService) claimGuests(ctx context.Context, user *Domain.User) {
+	guests, err := service.groups.GetGuestsByEmail(ctx, user.Email)
+	if err != nil {
+		Logger.Error().Err(err).Str("userId", user.Id.String()).Msg("Failed to look up guests")
+		return
+	}
+
+	for _, guest := range guests {
+		if len(guest.GroupMemberships) == 0 {
+			continue
+		}
+		activity := Domain.GuestClaimActivity(user.Id, guest.Id, guest.GroupMemberships[0].GroupID)
+		if err := service.groups.ClaimGuest(ctx, guest.Id, user.Id, activity); err != nil {
+			Logger.Error().Err(err).
+				Str("userId", user.Id.String()).
+				Str("guestId", guest.Id.String()).
+				Msg("Failed to claim guest")
+			continue
+		}
+		service.events.Publish(Domain.NewEventFromActivity(Domain.EventGroupMembership, activity))
+	}
+}
+
+// applyEmailInvites adds a newly registered user to every group that invited
+// their email address. A failed invite is logged and does not block
+// registration.
+func (service *AuthService) applyEmailInvites(ctx context.Context, user *Domain.User) {
+	invites, err := service.invites.GetPendingEmailInvites(ctx, user.Email, time.Now())
+	if err != nil {
+		Logger.Error().Err(err).Str("userId", user.Id.String()).Msg("Failed to look up email invites")
+		return
+	}
+
+	for i := range invites {
+		activity := invites[i].JoinActivity(user.Id)
+		if _, err := service.invites.AcceptInvite(ctx, invites[i].Id, user, activity); err != nil {
+			Logger.Error().Err(err).
+				Str("userId", user.Id.String()).
+				Str("inviteId", invites[i].Id.String()).
+				Msg("Failed to apply email invite")
+			continue
+		}
+		service.events.Publish(Domain.NewEventFromActivity(Domain.EventGroupMembership, activity))
+	}
+}
+
 func (service *AuthService) AuthenticateUser(ctx context.Context, input Dtos.LoginInput) (*Dtos.AuthResult, error) {
 	id, dbErr := service.db.FindUser(ctx, input.Email, input.Password)
 	if dbErr != nil {
@@ -145,3 +200,33 @@ func (service *AuthService) DeactivateUser(ctx context.Context, id uuid.UUID, pa
 func (service *AuthService) ReactivateUser(ctx context.Context, email, password string) error {
 	return service.db.ReactivateUser(ctx, email, password)
 }
+
+// MergeAccount moves everything owned by the account behind the source
+// credentials into the caller's account and deactivates the source. The
+// caller has to prove ownership of both accounts.
+func (service *AuthService) MergeAccount(ctx context.Context, id uuid.UUID, input Dtos.MergeAccountInput) error {
+	if err := service.db.VerifyPassword(ctx, id, input.Password); err != nil {
+		return err
+	}
+
+	sourceId, findErr := service.db.FindUser(ctx, input.SourceEmail, input.SourcePassword)
+	if findErr != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, Errors.ErrUnauthorized)
+	}
+	if sourceId == id.String() {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrCannotMergeSameAccount)
+	}
+
+	source, _ := uuid.Parse(sourceId)
+	if err := service.db.MergeUsers(ctx, source, id); err != nil {
+		return err
+	}
+
+	Logger.Debug().
+		Str("operation", "MergeAccount").
+		Str("userId", id.String()).
+		Str("sourceId", sourceId).
+		Msg("Accounts merged successfully")
+
+	return nil
+}
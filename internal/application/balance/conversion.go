@@ -0,0 +1,114 @@
+package balance
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	Dtos "autobill-service/internal/application/balance/dtos"
+	Domain "autobill-service/internal/domain"
+	ExchangePorts "autobill-service/internal/ports/outbound/exchange"
+	Errors "autobill-service/pkg/errors"
+)
+
+type currencyConverter struct {
+	provider ExchangePorts.ExchangeRateProvider
+	target   Domain.Currency
+	asOf     time.Time
+	rates    map[Domain.Currency]Domain.ExchangeRate
+
+	totals     map[uuid.UUID]int64
+	names      map[uuid.UUID]string
+	totalOrder []uuid.UUID
+}
+
+func parseConvertTo(convertTo string) (*Domain.Currency, error) {
+	if convertTo == "" {
+		return nil, nil
+	}
+	if !Domain.IsValidCurrency(convertTo) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidCurrency)
+	}
+	currency := Domain.Currency(convertTo)
+	return &currency, nil
+}
+
+func newCurrencyConverter(provider ExchangePorts.ExchangeRateProvider, target Domain.Currency) *currencyConverter {
+	return &currencyConverter{
+		provider: provider,
+		target:   target,
+		asOf:     time.Now().UTC(),
+		rates:    make(map[Domain.Currency]Domain.ExchangeRate),
+		totals:   make(map[uuid.UUID]int64),
+		names:    make(map[uuid.UUID]string),
+	}
+}
+
+func (c *currencyConverter) convert(ctx context.Context, amount int64, from Domain.Currency) (int64, error) {
+	if from == c.target {
+		return amount, nil
+	}
+
+	rate, ok := c.rates[from]
+	if !ok {
+		fetched, err := c.provider.GetRate(ctx, from, c.target, c.asOf)
+		if err != nil {
+			return 0, err
+		}
+		rate = *fetched
+		c.rates[from] = rate
+	}
+	return rate.Convert(amount), nil
+}
+
+func (c *currencyConverter) add(ctx context.Context, userId uuid.UUID, userName string, amount int64, from Domain.Currency) (int64, error) {
+	converted, err := c.convert(ctx, amount, from)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, seen := c.totals[userId]; !seen {
+		c.totalOrder = append(c.totalOrder, userId)
+		c.names[userId] = userName
+	}
+	c.totals[userId] += converted
+	return converted, nil
+}
+
+func (c *currencyConverter) result() *Dtos.ConversionResult {
+	var totalAmount int64
+	totals := make([]Dtos.ConvertedTotalResult, len(c.totalOrder))
+	for i, userId := range c.totalOrder {
+		totals[i] = Dtos.ConvertedTotalResult{
+			UserID:    userId.String(),
+			UserName:  c.names[userId],
+			NetAmount: c.totals[userId],
+		}
+		totalAmount += c.totals[userId]
+	}
+
+	rates := make([]Dtos.ExchangeRateResult, 0, len(c.rates))
+	for _, r := range c.rates {
+		rates = append(rates, Dtos.ExchangeRateResult{
+			BaseCurrency:  string(r.BaseCurrency),
+			QuoteCurrency: string(r.QuoteCurrency),
+			Rate:          r.Rate,
+			EffectiveDate: r.EffectiveDate,
+			Source:        r.Source,
+		})
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].BaseCurrency < rates[j].BaseCurrency
+	})
+
+	return &Dtos.ConversionResult{
+		Currency:    string(c.target),
+		AsOf:        c.asOf,
+		TotalAmount: totalAmount,
+		Totals:      totals,
+		Rates:       rates,
+	}
+}
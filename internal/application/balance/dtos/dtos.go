@@ -1,28 +1,34 @@
 package BalanceApplicationDtos
 
+import "time"
+
 type UserBalanceItemResult struct {
-	OtherUserID   string
-	OtherUserName string
-	NetAmount     int64
-	Currency      string
+	OtherUserID     string
+	OtherUserName   string
+	NetAmount       int64
+	Currency        string
+	ConvertedAmount *int64
 }
 
 type UserBalanceResult struct {
-	UserID   string
-	Balances []UserBalanceItemResult
+	UserID     string
+	Balances   []UserBalanceItemResult
+	Conversion *ConversionResult
 }
 
 type GroupBalanceItemResult struct {
-	UserID    string
-	UserName  string
-	NetAmount int64
-	Currency  string
+	UserID          string
+	UserName        string
+	NetAmount       int64
+	Currency        string
+	ConvertedAmount *int64
 }
 
 type GroupBalanceResult struct {
-	GroupID   string
-	GroupName string
-	Balances  []GroupBalanceItemResult
+	GroupID    string
+	GroupName  string
+	Balances   []GroupBalanceItemResult
+	Conversion *ConversionResult
 }
 
 type SimplifiedDebtResult struct {
@@ -35,6 +41,77 @@ type SimplifiedDebtResult struct {
 }
 
 type SimplifiedDebtsResult struct {
-	GroupID string
-	Debts   []SimplifiedDebtResult
+	GroupID    string
+	Debts      []SimplifiedDebtResult
+	Conversion *ConversionResult
+}
+
+type ConversionResult struct {
+	Currency    string
+	AsOf        time.Time
+	TotalAmount int64
+	Totals      []ConvertedTotalResult
+	Rates       []ExchangeRateResult
+}
+
+type ConvertedTotalResult struct {
+	UserID    string
+	UserName  string
+	NetAmount int64
+}
+
+type ExchangeRateResult struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          float64
+	EffectiveDate time.Time
+	Source        string
+}
+
+type BalanceMismatchResult struct {
+	UserID          string
+	OtherUserID     *string
+	GroupID         *string
+	Currency        string
+	LedgerAmount    int64
+	ProjectedAmount int64
+}
+
+type UnbalancedTransactionResult struct {
+	TransactionID string
+	Account       string
+	Currency      string
+	Total         int64
+}
+
+type LedgerVerificationResult struct {
+	Consistent             bool
+	Mismatches             []BalanceMismatchResult
+	UnbalancedTransactions []UnbalancedTransactionResult
+}
+
+type OverdueReceivableResult struct {
+	SplitID        string
+	Description    string
+	GroupID        *string
+	DebtorID       string
+	DebtorName     string
+	Amount         int64
+	Currency       string
+	SplitCreatedAt time.Time
+	AgeDays        int
+	Bucket         string
+}
+
+type AgingBucketResult struct {
+	Bucket   string
+	Currency string
+	Amount   int64
+	Count    int
+}
+
+type OverdueReceivablesResult struct {
+	UserID      string
+	Receivables []OverdueReceivableResult
+	Buckets     []AgingBucketResult
 }
@@ -0,0 +1,90 @@
+package balance
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/balance/dtos"
+	Domain "autobill-service/internal/domain"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetOverdueReceivables lists what others still owe the user on splits they
+// paid for, aged by split creation date, with totals per age bucket and
+// currency.
+func (s *BalanceService) GetOverdueReceivables(ctx context.Context, userId uuid.UUID, minDays int) (*Dtos.OverdueReceivablesResult, error) {
+	if minDays < 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidMinDays)
+	}
+
+	receivables, dbErr := s.repo.GetOutstandingReceivables(ctx, userId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	type bucketKey struct {
+		bucket   Domain.AgingBucket
+		currency Domain.Currency
+	}
+	totals := make(map[bucketKey]*Dtos.AgingBucketResult)
+
+	now := time.Now()
+	results := make([]Dtos.OverdueReceivableResult, 0, len(receivables))
+	for _, receivable := range receivables {
+		ageDays := Domain.AgeInDays(receivable.SplitCreatedAt, now)
+		if ageDays < minDays {
+			continue
+		}
+		bucket := Domain.AgingBucketFor(ageDays)
+
+		result := Dtos.OverdueReceivableResult{
+			SplitID:        receivable.SplitID.String(),
+			Description:    receivable.Description,
+			DebtorID:       receivable.DebtorID.String(),
+			DebtorName:     receivable.DebtorName,
+			Amount:         receivable.Amount,
+			Currency:       string(receivable.Currency),
+			SplitCreatedAt: receivable.SplitCreatedAt,
+			AgeDays:        ageDays,
+			Bucket:         string(bucket),
+		}
+		if receivable.GroupID != nil {
+			groupId := receivable.GroupID.String()
+			result.GroupID = &groupId
+		}
+		results = append(results, result)
+
+		key := bucketKey{bucket, receivable.Currency}
+		if totals[key] == nil {
+			totals[key] = &Dtos.AgingBucketResult{Bucket: string(bucket), Currency: string(receivable.Currency)}
+		}
+		totals[key].Amount += receivable.Amount
+		totals[key].Count++
+	}
+
+	bucketOrder := make(map[string]int, len(Domain.AgingBuckets))
+	for i, bucket := range Domain.AgingBuckets {
+		bucketOrder[string(bucket)] = i
+	}
+	buckets := make([]Dtos.AgingBucketResult, 0, len(totals))
+	for _, total := range totals {
+		buckets = append(buckets, *total)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].Bucket != buckets[j].Bucket {
+			return bucketOrder[buckets[i].Bucket] < bucketOrder[buckets[j].Bucket]
+		}
+		return buckets[i].Currency < buckets[j].Currency
+	})
+
+	return &Dtos.OverdueReceivablesResult{
+		UserID:      userId.String(),
+		Receivables: results,
+		Buckets:     buckets,
+	}, nil
+}
@@ -2,57 +2,68 @@ package balance
 
 import (
 	"context"
+	"sort"
 
 	"github.com/gofiber/fiber/v2"
 
 	Dtos "autobill-service/internal/application/balance/dtos"
 	Domain "autobill-service/internal/domain"
+	Simplification "autobill-service/internal/domain/simplification"
 	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	ExchangePorts "autobill-service/internal/ports/outbound/exchange"
 	Errors "autobill-service/pkg/errors"
 
 	"github.com/google/uuid"
 )
 
 type BalanceService struct {
-	repo      RepositoryPorts.BalanceRepositoryPort
-	groupRepo RepositoryPorts.GroupRepositoryPort
+	repo         RepositoryPorts.BalanceRepositoryPort
+	groupRepo    RepositoryPorts.GroupRepositoryPort
+	rateProvider ExchangePorts.ExchangeRateProvider
 }
 
-func CreateBalanceService(repo RepositoryPorts.BalanceRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort) *BalanceService {
+func CreateBalanceService(repo RepositoryPorts.BalanceRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort, rateProvider ExchangePorts.ExchangeRateProvider) *BalanceService {
 	return &BalanceService{
-		repo:      repo,
-		groupRepo: groupRepo,
+		repo:         repo,
+		groupRepo:    groupRepo,
+		rateProvider: rateProvider,
 	}
 }
 
-func (s *BalanceService) GetMyBalance(ctx context.Context, userId uuid.UUID) (*Dtos.UserBalanceResult, error) {
+func (s *BalanceService) GetMyBalance(ctx context.Context, userId uuid.UUID, convertTo string) (*Dtos.UserBalanceResult, error) {
+	target, err := parseConvertTo(convertTo)
+	if err != nil {
+		return nil, err
+	}
+
 	balances, dbErr := s.repo.GetUserBalances(ctx, userId)
 	if dbErr != nil {
 		return nil, dbErr
 	}
 
-	balanceItems := make([]Dtos.UserBalanceItemResult, len(balances))
-	for i, b := range balances {
-		balanceItems[i] = Dtos.UserBalanceItemResult{
-			OtherUserID:   b.OtherUserID.String(),
-			OtherUserName: b.OtherUser.Name,
-			NetAmount:     b.NetAmount,
-			Currency:      string(b.Currency),
-		}
-	}
-
-	return &Dtos.UserBalanceResult{
-		UserID:   userId.String(),
-		Balances: balanceItems,
-	}, nil
+	return s.toUserBalanceResult(ctx, userId, balances, target)
 }
 
-func (s *BalanceService) GetBalanceWithUser(ctx context.Context, userId, otherUserId uuid.UUID) (*Dtos.UserBalanceResult, error) {
+func (s *BalanceService) GetBalanceWithUser(ctx context.Context, userId, otherUserId uuid.UUID, convertTo string) (*Dtos.UserBalanceResult, error) {
+	target, err := parseConvertTo(convertTo)
+	if err != nil {
+		return nil, err
+	}
+
 	balances, dbErr := s.repo.GetUserBalancesWithOtherUser(ctx, userId, otherUserId)
 	if dbErr != nil {
 		return nil, dbErr
 	}
 
+	return s.toUserBalanceResult(ctx, userId, balances, target)
+}
+
+func (s *BalanceService) toUserBalanceResult(ctx context.Context, userId uuid.UUID, balances []Domain.UserBalance, target *Domain.Currency) (*Dtos.UserBalanceResult, error) {
+	var converter *currencyConverter
+	if target != nil {
+		converter = newCurrencyConverter(s.rateProvider, *target)
+	}
+
 	balanceItems := make([]Dtos.UserBalanceItemResult, len(balances))
 	for i, b := range balances {
 		balanceItems[i] = Dtos.UserBalanceItemResult{
@@ -61,15 +72,32 @@ func (s *BalanceService) GetBalanceWithUser(ctx context.Context, userId, otherUs
 			NetAmount:     b.NetAmount,
 			Currency:      string(b.Currency),
 		}
+
+		if converter != nil {
+			converted, err := converter.add(ctx, b.OtherUserID, b.OtherUser.Name, b.NetAmount, b.Currency)
+			if err != nil {
+				return nil, err
+			}
+			balanceItems[i].ConvertedAmount = &converted
+		}
 	}
 
-	return &Dtos.UserBalanceResult{
+	result := &Dtos.UserBalanceResult{
 		UserID:   userId.String(),
 		Balances: balanceItems,
-	}, nil
+	}
+	if converter != nil {
+		result.Conversion = converter.result()
+	}
+	return result, nil
 }
 
-func (s *BalanceService) GetGroupBalance(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.GroupBalanceResult, error) {
+func (s *BalanceService) GetGroupBalance(ctx context.Context, userId, groupId uuid.UUID, convertTo string) (*Dtos.GroupBalanceResult, error) {
+	target, err := parseConvertTo(convertTo)
+	if err != nil {
+		return nil, err
+	}
+
 	_, memberErr := s.groupRepo.GetMembership(ctx, groupId, userId)
 	if memberErr != nil {
 		return nil, memberErr
@@ -85,6 +113,11 @@ func (s *BalanceService) GetGroupBalance(ctx context.Context, userId, groupId uu
 		return nil, dbErr
 	}
 
+	var converter *currencyConverter
+	if target != nil {
+		converter = newCurrencyConverter(s.rateProvider, *target)
+	}
+
 	balanceItems := make([]Dtos.GroupBalanceItemResult, len(balances))
 	for i, b := range balances {
 		userName := b.User.Name
@@ -94,13 +127,25 @@ func (s *BalanceService) GetGroupBalance(ctx context.Context, userId, groupId uu
 			NetAmount: b.NetAmount,
 			Currency:  string(b.Currency),
 		}
+
+		if converter != nil {
+			converted, err := converter.add(ctx, b.UserID, userName, b.NetAmount, b.Currency)
+			if err != nil {
+				return nil, err
+			}
+			balanceItems[i].ConvertedAmount = &converted
+		}
 	}
 
-	return &Dtos.GroupBalanceResult{
+	result := &Dtos.GroupBalanceResult{
 		GroupID:   groupId.String(),
 		GroupName: group.Name,
 		Balances:  balanceItems,
-	}, nil
+	}
+	if converter != nil {
+		result.Conversion = converter.result()
+	}
+	return result, nil
 }
 
 func (s *BalanceService) RecalculateGroupBalance(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.GroupBalanceResult, error) {
@@ -117,24 +162,7 @@ func (s *BalanceService) RecalculateGroupBalance(ctx context.Context, userId, gr
 		return nil, groupErr
 	}
 
-	splits, splitErr := s.repo.GetSplitsWithParticipants(ctx, groupId)
-	if splitErr != nil {
-		return nil, splitErr
-	}
-
-	var splitIDs []uuid.UUID
-	for _, split := range splits {
-		splitIDs = append(splitIDs, split.Id)
-	}
-
-	settlements, settlementErr := s.repo.GetSettlementsForSplits(ctx, splitIDs)
-	if settlementErr != nil {
-		return nil, settlementErr
-	}
-
-	calculatedBalances := s.calculateGroupBalances(groupId, splits, settlements)
-
-	balances, dbErr := s.repo.ReplaceGroupBalances(ctx, groupId, calculatedBalances)
+	balances, dbErr := s.repo.RebuildGroupBalances(ctx, groupId)
 	if dbErr != nil {
 		return nil, dbErr
 	}
@@ -157,59 +185,82 @@ func (s *BalanceService) RecalculateGroupBalance(ctx context.Context, userId, gr
 	}, nil
 }
 
-func (s *BalanceService) calculateGroupBalances(groupId uuid.UUID, splits []Domain.Split, settlements []Domain.Settlement) []Domain.GroupBalance {
-	balanceMap := make(map[uuid.UUID]map[Domain.Currency]int64)
+func (s *BalanceService) RecalculateMyBalance(ctx context.Context, userId uuid.UUID) (*Dtos.UserBalanceResult, error) {
+	balances, dbErr := s.repo.RebuildUserBalances(ctx, userId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
 
-	for _, split := range splits {
-		if balanceMap[split.CreatedByID] == nil {
-			balanceMap[split.CreatedByID] = make(map[Domain.Currency]int64)
-		}
+	return s.toUserBalanceResult(ctx, userId, balances, nil)
+}
 
-		for _, participant := range split.Participants {
-			if participant.IsSettled {
-				continue
-			}
+func (s *BalanceService) VerifyMyBalance(ctx context.Context, userId uuid.UUID) (*Dtos.LedgerVerificationResult, error) {
+	mismatches, dbErr := s.repo.GetUserBalanceMismatches(ctx, userId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
 
-			if balanceMap[participant.UserID] == nil {
-				balanceMap[participant.UserID] = make(map[Domain.Currency]int64)
-			}
+	unbalanced, dbErr := s.repo.GetUnbalancedTransactionsForUser(ctx, userId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
 
-			if participant.UserID == split.CreatedByID {
-				balanceMap[participant.UserID][split.Currency] += (split.TotalAmount - participant.ShareAmount)
-			} else {
-				balanceMap[participant.UserID][split.Currency] -= participant.ShareAmount
-			}
-		}
+	return toLedgerVerificationResult(mismatches, unbalanced), nil
+}
+
+func (s *BalanceService) VerifyGroupBalance(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.LedgerVerificationResult, error) {
+	_, memberErr := s.groupRepo.GetMembership(ctx, groupId, userId)
+	if memberErr != nil {
+		return nil, memberErr
 	}
 
-	for _, settlement := range settlements {
-		if balanceMap[settlement.PayerID] == nil {
-			balanceMap[settlement.PayerID] = make(map[Domain.Currency]int64)
+	mismatches, dbErr := s.repo.GetGroupBalanceMismatches(ctx, groupId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	unbalanced, dbErr := s.repo.GetUnbalancedTransactionsForGroup(ctx, groupId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return toLedgerVerificationResult(mismatches, unbalanced), nil
+}
+
+func toLedgerVerificationResult(mismatches []RepositoryPorts.BalanceMismatch, unbalanced []RepositoryPorts.UnbalancedTransaction) *Dtos.LedgerVerificationResult {
+	mismatchResults := make([]Dtos.BalanceMismatchResult, len(mismatches))
+	for i, m := range mismatches {
+		mismatchResults[i] = Dtos.BalanceMismatchResult{
+			UserID:          m.UserID.String(),
+			Currency:        string(m.Currency),
+			LedgerAmount:    m.LedgerAmount,
+			ProjectedAmount: m.ProjectedAmount,
 		}
-		if balanceMap[settlement.PayeeID] == nil {
-			balanceMap[settlement.PayeeID] = make(map[Domain.Currency]int64)
+		if m.OtherUserID != nil {
+			otherUserId := m.OtherUserID.String()
+			mismatchResults[i].OtherUserID = &otherUserId
+		}
+		if m.GroupID != nil {
+			groupId := m.GroupID.String()
+			mismatchResults[i].GroupID = &groupId
 		}
-
-		balanceMap[settlement.PayerID][settlement.Currency] += settlement.Amount
-		balanceMap[settlement.PayeeID][settlement.Currency] -= settlement.Amount
 	}
 
-	var balances []Domain.GroupBalance
-	for userId, currencies := range balanceMap {
-		for currency, amount := range currencies {
-			if amount == 0 {
-				continue
-			}
-			balances = append(balances, Domain.GroupBalance{
-				UserID:    userId,
-				GroupID:   groupId,
-				NetAmount: amount,
-				Currency:  currency,
-			})
+	transactionResults := make([]Dtos.UnbalancedTransactionResult, len(unbalanced))
+	for i, t := range unbalanced {
+		transactionResults[i] = Dtos.UnbalancedTransactionResult{
+			TransactionID: t.TransactionID.String(),
+			Account:       string(t.Account),
+			Currency:      string(t.Currency),
+			Total:         t.Total,
 		}
 	}
 
-	return balances
+	return &Dtos.LedgerVerificationResult{
+		Consistent:             len(mismatches) == 0 && len(unbalanced) == 0,
+		Mismatches:             mismatchResults,
+		UnbalancedTransactions: transactionResults,
+	}
 }
 
 func (s *BalanceService) GetSimplifiedDebts(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.SimplifiedDebtsResult, error) {
@@ -218,11 +269,32 @@ func (s *BalanceService) GetSimplifiedDebts(ctx context.Context, userId, groupId
 		return nil, memberErr
 	}
 
-	debts, dbErr := s.repo.GetSimplifiedDebts(ctx, groupId)
+	group, groupErr := s.groupRepo.GetGroupById(ctx, groupId)
+	if groupErr != nil {
+		return nil, groupErr
+	}
+
+	var debts []Domain.SimplifiedDebt
+	var conversion *Dtos.ConversionResult
+	balances, dbErr := s.repo.GetGroupBalances(ctx, groupId)
 	if dbErr != nil {
 		return nil, dbErr
 	}
 
+	if group.SettlementCurrency != nil {
+		converter := newCurrencyConverter(s.rateProvider, *group.SettlementCurrency)
+		for _, b := range balances {
+			if _, err := converter.add(ctx, b.UserID, b.User.Name, b.NetAmount, b.Currency); err != nil {
+				return nil, err
+			}
+		}
+
+		conversion = converter.result()
+		debts = simplifyConvertedBalances(converter)
+	} else {
+		debts = simplifyGroupBalances(balances)
+	}
+
 	debtResults := make([]Dtos.SimplifiedDebtResult, len(debts))
 	for i, d := range debts {
 		debtResults[i] = Dtos.SimplifiedDebtResult{
@@ -236,7 +308,56 @@ func (s *BalanceService) GetSimplifiedDebts(ctx context.Context, userId, groupId
 	}
 
 	return &Dtos.SimplifiedDebtsResult{
-		GroupID: groupId.String(),
-		Debts:   debtResults,
+		GroupID:    groupId.String(),
+		Debts:      debtResults,
+		Conversion: conversion,
 	}, nil
 }
+
+func simplifyConvertedBalances(converter *currencyConverter) []Domain.SimplifiedDebt {
+	entries := make([]Simplification.Balance, 0, len(converter.totalOrder))
+	for _, userId := range converter.totalOrder {
+		entries = append(entries, Simplification.Balance{UserID: userId, Amount: converter.totals[userId]})
+	}
+
+	return toSimplifiedDebts(Simplification.Simplify(entries), converter.names, converter.target)
+}
+
+func simplifyGroupBalances(balances []Domain.GroupBalance) []Domain.SimplifiedDebt {
+	names := make(map[uuid.UUID]string)
+	byCurrency := make(map[Domain.Currency][]Simplification.Balance)
+	for _, b := range balances {
+		names[b.UserID] = b.User.Name
+		byCurrency[b.Currency] = append(byCurrency[b.Currency], Simplification.Balance{UserID: b.UserID, Amount: b.NetAmount})
+	}
+
+	currencies := make([]Domain.Currency, 0, len(byCurrency))
+	for currency := range byCurrency {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i] < currencies[j]
+	})
+
+	debts := []Domain.SimplifiedDebt{}
+	for _, currency := range currencies {
+		transfers := Simplification.Simplify(byCurrency[currency])
+		debts = append(debts, toSimplifiedDebts(transfers, names, currency)...)
+	}
+	return debts
+}
+
+func toSimplifiedDebts(transfers []Simplification.Transfer, names map[uuid.UUID]string, currency Domain.Currency) []Domain.SimplifiedDebt {
+	debts := make([]Domain.SimplifiedDebt, len(transfers))
+	for i, t := range transfers {
+		debts[i] = Domain.SimplifiedDebt{
+			FromUserID:   t.FromUserID,
+			FromUserName: names[t.FromUserID],
+			ToUserID:     t.ToUserID,
+			ToUserName:   names[t.ToUserID],
+			Amount:       t.Amount,
+			Currency:     currency,
+		}
+	}
+	return debts
+}
@@ -0,0 +1,19 @@
+package CategoryApplicationDtos
+
+import "time"
+
+type CreateCategoryInput struct {
+	Name string
+}
+
+type CategoryResult struct {
+	ID        string
+	Name      string
+	GroupID   *string
+	IsSystem  bool
+	CreatedAt time.Time
+}
+
+type CategoryListResult struct {
+	Categories []CategoryResult
+}
@@ -0,0 +1,132 @@
+package CategoryApplication
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/category/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type CategoryService struct {
+	repo      RepositoryPorts.CategoryRepositoryPort
+	groupRepo RepositoryPorts.GroupRepositoryPort
+}
+
+func CreateCategoryService(repo RepositoryPorts.CategoryRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort) HttpPorts.CategoryUseCase {
+	return &CategoryService{
+		repo:      repo,
+		groupRepo: groupRepo,
+	}
+}
+
+func (s *CategoryService) GetSystemCategories(ctx context.Context) (*Dtos.CategoryListResult, error) {
+	categories, err := s.repo.GetCategories(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return categoryListToDto(categories), nil
+}
+
+func (s *CategoryService) GetGroupCategories(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.CategoryListResult, error) {
+	if _, err := s.groupRepo.GetMembership(ctx, groupId, userId); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.repo.GetCategories(ctx, &groupId)
+	if err != nil {
+		return nil, err
+	}
+	return categoryListToDto(categories), nil
+}
+
+func (s *CategoryService) CreateCategory(ctx context.Context, userId, groupId uuid.UUID, input Dtos.CreateCategoryInput) (*Dtos.CategoryResult, error) {
+	if _, err := s.groupRepo.GetMembership(ctx, groupId, userId); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+
+	// Custom categories may not shadow a system default either, which the
+	// unique index alone cannot catch.
+	existing, err := s.repo.GetCategories(ctx, &groupId)
+	if err != nil {
+		return nil, err
+	}
+	for _, category := range existing {
+		if strings.EqualFold(category.Name, name) {
+			return nil, fiber.NewError(fiber.StatusConflict, Errors.ErrCategoryAlreadyExists)
+		}
+	}
+
+	created, dbErr := s.repo.CreateCategory(ctx, &Domain.Category{
+		Name:        name,
+		GroupID:     &groupId,
+		CreatedByID: &userId,
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	Logger.Debug().
+		Str("operation", "CreateCategory").
+		Str("userId", userId.String()).
+		Str("groupId", groupId.String()).
+		Str("categoryId", created.Id.String()).
+		Msg("Category created successfully")
+
+	return categoryToDto(created), nil
+}
+
+func (s *CategoryService) DeleteCategory(ctx context.Context, userId, groupId, categoryId uuid.UUID) error {
+	isAdmin, err := s.groupRepo.IsGroupAdmin(ctx, groupId, userId)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrGroupNotFound)
+	}
+
+	if err := s.repo.DeleteCategory(ctx, groupId, categoryId); err != nil {
+		return err
+	}
+
+	Logger.Debug().
+		Str("operation", "DeleteCategory").
+		Str("userId", userId.String()).
+		Str("groupId", groupId.String()).
+		Str("categoryId", categoryId.String()).
+		Msg("Category deleted")
+
+	return nil
+}
+
+func categoryToDto(category *Domain.Category) *Dtos.CategoryResult {
+	result := &Dtos.CategoryResult{
+		ID:        category.Id.String(),
+		Name:      category.Name,
+		IsSystem:  category.IsSystem(),
+		CreatedAt: category.CreatedAt,
+	}
+	if category.GroupID != nil {
+		groupId := category.GroupID.String()
+		result.GroupID = &groupId
+	}
+	return result
+}
+
+func categoryListToDto(categories []Domain.Category) *Dtos.CategoryListResult {
+	results := make([]Dtos.CategoryResult, len(categories))
+	for i := range categories {
+		results[i] = *categoryToDto(&categories[i])
+	}
+	return &Dtos.CategoryListResult{Categories: results}
+}
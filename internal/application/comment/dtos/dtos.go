@@ -0,0 +1,39 @@
+package CommentApplicationDtos
+
+import "time"
+
+type CreateCommentInput struct {
+	Body     string
+	ParentID string
+}
+
+type UpdateCommentInput struct {
+	Body string
+}
+
+type MentionResult struct {
+	UserID   string
+	UserName string
+}
+
+// CommentResult is blanked when the comment has been deleted but is still
+// shown because it has replies.
+type CommentResult struct {
+	ID         string
+	ParentID   *string
+	AuthorID   string
+	AuthorName string
+	Body       string
+	Mentions   []MentionResult
+	IsDeleted  bool
+	EditedAt   *time.Time
+	CreatedAt  time.Time
+	Replies    []CommentResult
+}
+
+type CommentListResult struct {
+	Comments   []CommentResult
+	Page       int
+	PageSize   int
+	TotalItems int64
+}
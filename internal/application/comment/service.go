@@ -0,0 +1,267 @@
+package CommentApplication
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/comment/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// ThreadResolver loads the split or settlement targetId for userId, failing
+// with a not found error when the user may not see it.
+type ThreadResolver interface {
+	ResolveThread(ctx context.Context, userId, targetId uuid.UUID) (*Domain.CommentThread, error)
+}
+
+type CommentService struct {
+	repo      RepositoryPorts.CommentRepositoryPort
+	groupRepo RepositoryPorts.GroupRepositoryPort
+	threads   ThreadResolver
+	events    EventPorts.EventPublisher
+}
+
+func CreateCommentService(repo RepositoryPorts.CommentRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort, threads ThreadResolver, events EventPorts.EventPublisher) HttpPorts.CommentUseCase {
+	return &CommentService{
+		repo:      repo,
+		groupRepo: groupRepo,
+		threads:   threads,
+		events:    events,
+	}
+}
+
+func (s *CommentService) GetComments(ctx context.Context, userId, targetId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.CommentListResult, error) {
+	thread, err := s.threads.ResolveThread(ctx, userId, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, total, dbErr := s.repo.GetComments(ctx, thread.TargetType, thread.TargetID, pagination.PageSize, pagination.Offset())
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	results := make([]Dtos.CommentResult, len(comments))
+	for i := range comments {
+		results[i] = *commentToDto(&comments[i])
+	}
+
+	return &Dtos.CommentListResult{
+		Comments:   results,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalItems: total,
+	}, nil
+}
+
+func (s *CommentService) CreateComment(ctx context.Context, userId, targetId uuid.UUID, input Dtos.CreateCommentInput) (*Dtos.CommentResult, error) {
+	thread, err := s.threads.ResolveThread(ctx, userId, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(input.Body)
+	if body == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrEmptyComment)
+	}
+	comment := thread.NewComment(userId, body)
+
+	// Replying to a reply continues the same thread.
+	if input.ParentID != "" {
+		parentId, err := Helpers.ParseUUID(input.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		parent, dbErr := s.repo.GetCommentById(ctx, parentId)
+		if dbErr != nil || !thread.Contains(parent) {
+			return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrCommentNotFound)
+		}
+		if parent.ParentID != nil {
+			parentId = *parent.ParentID
+		}
+		comment.ParentID = &parentId
+	}
+
+	comment.Id = uuid.New()
+	mentionIds := thread.ResolveMentions(comment.Body)
+	activity := s.mentionActivity(thread, userId, comment, mentionIds)
+
+	created, dbErr := s.repo.CreateComment(ctx, comment, mentionIds, activity)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	s.publish(activity)
+
+	Logger.Debug().
+		Str("operation", "CreateComment").
+		Str("userId", userId.String()).
+		Str("targetType", string(thread.TargetType)).
+		Str("targetId", targetId.String()).
+		Str("commentId", created.Id.String()).
+		Int("mentions", len(mentionIds)).
+		Msg("Comment created successfully")
+
+	return commentToDto(created), nil
+}
+
+// UpdateComment only notifies users who were not already mentioned before the
+// edit.
+func (s *CommentService) UpdateComment(ctx context.Context, userId, targetId, commentId uuid.UUID, input Dtos.UpdateCommentInput) (*Dtos.CommentResult, error) {
+	body := strings.TrimSpace(input.Body)
+	if body == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrEmptyComment)
+	}
+
+	thread, comment, err := s.threadComment(ctx, userId, targetId, commentId)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AuthorID != userId {
+		return nil, fiber.NewError(fiber.StatusForbidden, Errors.ErrNotCommentAuthor)
+	}
+
+	previous := make(map[uuid.UUID]bool, len(comment.Mentions))
+	for _, mention := range comment.Mentions {
+		previous[mention.UserID] = true
+	}
+
+	now := time.Now()
+	comment.Body = body
+	comment.EditedAt = &now
+
+	mentionIds := thread.ResolveMentions(comment.Body)
+	var newMentions []uuid.UUID
+	for _, mentionId := range mentionIds {
+		if !previous[mentionId] {
+			newMentions = append(newMentions, mentionId)
+		}
+	}
+	activity := s.mentionActivity(thread, userId, comment, newMentions)
+
+	updated, dbErr := s.repo.UpdateComment(ctx, comment, mentionIds, activity)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	s.publish(activity)
+
+	Logger.Debug().
+		Str("operation", "UpdateComment").
+		Str("userId", userId.String()).
+		Str("commentId", commentId.String()).
+		Int("newMentions", len(newMentions)).
+		Msg("Comment updated successfully")
+
+	return commentToDto(updated), nil
+}
+
+func (s *CommentService) DeleteComment(ctx context.Context, userId, targetId, commentId uuid.UUID) error {
+	thread, comment, err := s.threadComment(ctx, userId, targetId, commentId)
+	if err != nil {
+		return err
+	}
+
+	if comment.AuthorID != userId {
+		isAdmin := false
+		if thread.GroupID != nil {
+			isAdmin, _ = s.groupRepo.IsGroupAdmin(ctx, *thread.GroupID, userId)
+		}
+		if !isAdmin {
+			return fiber.NewError(fiber.StatusForbidden, Errors.ErrNotAllowedToDeleteComment)
+		}
+	}
+
+	if err := s.repo.DeleteComment(ctx, commentId); err != nil {
+		return err
+	}
+
+	Logger.Debug().
+		Str("operation", "DeleteComment").
+		Str("userId", userId.String()).
+		Str("commentId", commentId.String()).
+		Msg("Comment deleted successfully")
+
+	return nil
+}
+
+func (s *CommentService) threadComment(ctx context.Context, userId, targetId, commentId uuid.UUID) (*Domain.CommentThread, *Domain.Comment, error) {
+	thread, err := s.threads.ResolveThread(ctx, userId, targetId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment, dbErr := s.repo.GetCommentById(ctx, commentId)
+	if dbErr != nil {
+		return nil, nil, dbErr
+	}
+	if !thread.Contains(comment) {
+		return nil, nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrCommentNotFound)
+	}
+	return thread, comment, nil
+}
+
+// mentionActivity returns nil when nobody other than the author is mentioned.
+func (s *CommentService) mentionActivity(thread *Domain.CommentThread, authorId uuid.UUID, comment *Domain.Comment, mentionIds []uuid.UUID) *Domain.ActivityEvent {
+	var notified []uuid.UUID
+	for _, mentionId := range mentionIds {
+		if mentionId != authorId {
+			notified = append(notified, mentionId)
+		}
+	}
+	if len(notified) == 0 {
+		return nil
+	}
+	return thread.MentionActivity(authorId, comment, notified)
+}
+
+func (s *CommentService) publish(activity *Domain.ActivityEvent) {
+	if activity != nil {
+		s.events.Publish(Domain.NewEventFromActivity(Domain.EventCommentMentioned, activity))
+	}
+}
+
+func commentToDto(comment *Domain.Comment) *Dtos.CommentResult {
+	result := &Dtos.CommentResult{
+		ID:         comment.Id.String(),
+		AuthorID:   comment.AuthorID.String(),
+		AuthorName: comment.Author.Name,
+		Body:       comment.Body,
+		Mentions:   []Dtos.MentionResult{},
+		EditedAt:   comment.EditedAt,
+		CreatedAt:  comment.CreatedAt,
+	}
+	if comment.ParentID != nil {
+		parentId := comment.ParentID.String()
+		result.ParentID = &parentId
+	}
+
+	if comment.DeletedAt.Valid {
+		result.IsDeleted = true
+		result.Body = ""
+	} else {
+		for _, mention := range comment.Mentions {
+			result.Mentions = append(result.Mentions, Dtos.MentionResult{
+				UserID:   mention.UserID.String(),
+				UserName: mention.User.Name,
+			})
+		}
+	}
+
+	if comment.ParentID == nil {
+		result.Replies = make([]Dtos.CommentResult, len(comment.Replies))
+		for i := range comment.Replies {
+			result.Replies[i] = *commentToDto(&comment.Replies[i])
+		}
+	}
+	return result
+}
@@ -0,0 +1,11 @@
+package CurrencyApplicationDtos
+
+type CurrencyResult struct {
+	Code     string
+	Name     string
+	Exponent int
+}
+
+type CurrencyListResult struct {
+	Currencies []CurrencyResult
+}
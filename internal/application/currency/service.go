@@ -0,0 +1,30 @@
+package CurrencyApplication
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/currency/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+)
+
+type CurrencyService struct{}
+
+func CreateCurrencyService() HttpPorts.CurrencyUseCase {
+	return &CurrencyService{}
+}
+
+func (s *CurrencyService) GetCurrencies(ctx context.Context) (*Dtos.CurrencyListResult, error) {
+	currencies := Domain.Currencies()
+
+	results := make([]Dtos.CurrencyResult, len(currencies))
+	for i, c := range currencies {
+		results[i] = Dtos.CurrencyResult{
+			Code:     string(c.Code),
+			Name:     c.Name,
+			Exponent: c.Exponent,
+		}
+	}
+
+	return &Dtos.CurrencyListResult{Currencies: results}, nil
+}
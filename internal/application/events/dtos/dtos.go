@@ -0,0 +1,9 @@
+package EventApplicationDtos
+
+import Domain "autobill-service/internal/domain"
+
+type EventSubscription struct {
+	Backlog []Domain.Event
+	Events  <-chan Domain.Event
+	Close   func()
+}
@@ -0,0 +1,50 @@
+package EventApplication
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/events/dtos"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	EventPorts "autobill-service/internal/ports/outbound/events"
+	Errors "autobill-service/pkg/errors"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type EventService struct {
+	bus EventPorts.EventSubscriber
+}
+
+func CreateEventService(bus EventPorts.EventSubscriber) HttpPorts.EventUseCase {
+	return &EventService{bus: bus}
+}
+
+func (s *EventService) Subscribe(ctx context.Context, userId uuid.UUID, lastEventId string) (*Dtos.EventSubscription, error) {
+	var afterId uint64
+	if lastEventId != "" {
+		parsed, err := strconv.ParseUint(lastEventId, 10, 64)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidLastEventId)
+		}
+		afterId = parsed
+	}
+
+	backlog, events, unsubscribe := s.bus.Subscribe(userId, afterId)
+
+	Logger.Debug().
+		Str("operation", "Subscribe").
+		Str("userId", userId.String()).
+		Uint64("lastEventId", afterId).
+		Int("backlog", len(backlog)).
+		Msg("Event stream opened")
+
+	return &Dtos.EventSubscription{
+		Backlog: backlog,
+		Events:  events,
+		Close:   unsubscribe,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package ExchangeRateApplicationDtos
+
+import "time"
+
+type ExchangeRateInput struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          float64
+	EffectiveDate time.Time
+}
+
+type UploadExchangeRatesInput struct {
+	Source string
+	Rates  []ExchangeRateInput
+}
+
+type ExchangeRateResult struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          float64
+	EffectiveDate time.Time
+	Source        string
+	UpdatedAt     time.Time
+}
+
+type ExchangeRateListResult struct {
+	AsOf  time.Time
+	Rates []ExchangeRateResult
+}
@@ -0,0 +1,105 @@
+package ExchangeRateApplication
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/exchange/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	ExchangePorts "autobill-service/internal/ports/outbound/exchange"
+	Errors "autobill-service/pkg/errors"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type ExchangeRateService struct {
+	provider     ExchangePorts.ExchangeRateProvider
+	adminUserIds map[uuid.UUID]bool
+}
+
+func CreateExchangeRateService(provider ExchangePorts.ExchangeRateProvider, adminUserIds []uuid.UUID) HttpPorts.ExchangeRateUseCase {
+	admins := make(map[uuid.UUID]bool, len(adminUserIds))
+	for _, id := range adminUserIds {
+		admins[id] = true
+	}
+	return &ExchangeRateService{provider: provider, adminUserIds: admins}
+}
+
+func (s *ExchangeRateService) GetExchangeRates(ctx context.Context, asOf *time.Time) (*Dtos.ExchangeRateListResult, error) {
+	effectiveAsOf := time.Now().UTC()
+	if asOf != nil {
+		effectiveAsOf = *asOf
+	}
+
+	rates, err := s.provider.GetRates(ctx, effectiveAsOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Dtos.ExchangeRateListResult{
+		AsOf:  effectiveAsOf,
+		Rates: toExchangeRateResults(rates),
+	}, nil
+}
+
+func (s *ExchangeRateService) UploadExchangeRates(ctx context.Context, userId uuid.UUID, input Dtos.UploadExchangeRatesInput) (*Dtos.ExchangeRateListResult, error) {
+	if !s.adminUserIds[userId] {
+		return nil, fiber.NewError(fiber.StatusForbidden, Errors.ErrForbidden)
+	}
+
+	if len(input.Rates) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNoExchangeRates)
+	}
+
+	rates := make([]Domain.ExchangeRate, len(input.Rates))
+	for i, r := range input.Rates {
+		if !Domain.IsValidCurrency(r.BaseCurrency) || !Domain.IsValidCurrency(r.QuoteCurrency) ||
+			r.BaseCurrency == r.QuoteCurrency || r.Rate <= 0 || r.EffectiveDate.IsZero() {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidExchangeRate)
+		}
+
+		rates[i] = Domain.ExchangeRate{
+			BaseCurrency:  Domain.Currency(r.BaseCurrency),
+			QuoteCurrency: Domain.Currency(r.QuoteCurrency),
+			Rate:          r.Rate,
+			EffectiveDate: r.EffectiveDate.UTC().Truncate(24 * time.Hour),
+			Source:        input.Source,
+		}
+	}
+
+	saved, err := s.provider.SaveRates(ctx, rates)
+	if err != nil {
+		return nil, err
+	}
+
+	Logger.Info().
+		Str("operation", "UploadExchangeRates").
+		Str("userId", userId.String()).
+		Int("count", len(saved)).
+		Str("source", input.Source).
+		Msg("Exchange rates uploaded")
+
+	return &Dtos.ExchangeRateListResult{
+		AsOf:  time.Now().UTC(),
+		Rates: toExchangeRateResults(saved),
+	}, nil
+}
+
+func toExchangeRateResults(rates []Domain.ExchangeRate) []Dtos.ExchangeRateResult {
+	results := make([]Dtos.ExchangeRateResult, len(rates))
+	for i, r := range rates {
+		results[i] = Dtos.ExchangeRateResult{
+			BaseCurrency:  string(r.BaseCurrency),
+			QuoteCurrency: string(r.QuoteCurrency),
+			Rate:          r.Rate,
+			EffectiveDate: r.EffectiveDate,
+			Source:        r.Source,
+			UpdatedAt:     r.UpdatedAt,
+		}
+	}
+	return results
+}
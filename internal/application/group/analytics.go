@@ -0,0 +1,85 @@
+package GroupApplication
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/group/dtos"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetGroupAnalytics breaks a group's spending down by category, member and
+// month. Both dates of the range are inclusive, and totals are kept per
+// currency rather than converted.
+func (s *GroupService) GetGroupAnalytics(ctx context.Context, userId, groupId uuid.UUID, input Dtos.GroupAnalyticsInput) (*Dtos.GroupAnalyticsResult, error) {
+	if _, err := s.repo.GetMembership(ctx, groupId, userId); err != nil {
+		return nil, err
+	}
+	if input.From != nil && input.To != nil && input.From.After(*input.To) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidDateRange)
+	}
+
+	filter := RepositoryPorts.SpendingFilter{GroupID: groupId, From: input.From}
+	if input.To != nil {
+		end := input.To.AddDate(0, 0, 1)
+		filter.To = &end
+	}
+
+	byCategory, err := s.splitRepo.GetGroupSpendByCategory(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	byMember, err := s.splitRepo.GetGroupSpendByMember(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	byMonth, err := s.splitRepo.GetGroupSpendByMonth(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Dtos.GroupAnalyticsResult{
+		GroupID:    groupId.String(),
+		From:       input.From,
+		To:         input.To,
+		ByCategory: make([]Dtos.CategorySpendResult, len(byCategory)),
+		ByMember:   make([]Dtos.MemberSpendResult, len(byMember)),
+		ByMonth:    make([]Dtos.MonthlySpendResult, len(byMonth)),
+	}
+
+	for i, row := range byCategory {
+		result.ByCategory[i] = Dtos.CategorySpendResult{
+			CategoryName: row.CategoryName,
+			Currency:     string(row.Currency),
+			TotalAmount:  row.TotalAmount,
+			SplitCount:   row.SplitCount,
+		}
+		if row.CategoryID != nil {
+			categoryId := row.CategoryID.String()
+			result.ByCategory[i].CategoryID = &categoryId
+		}
+	}
+	for i, row := range byMember {
+		result.ByMember[i] = Dtos.MemberSpendResult{
+			UserID:      row.UserID.String(),
+			Name:        row.UserName,
+			Currency:    string(row.Currency),
+			PaidAmount:  row.PaidAmount,
+			ShareAmount: row.ShareAmount,
+		}
+	}
+	for i, row := range byMonth {
+		result.ByMonth[i] = Dtos.MonthlySpendResult{
+			Month:       row.Month,
+			Currency:    string(row.Currency),
+			TotalAmount: row.TotalAmount,
+			SplitCount:  row.SplitCount,
+		}
+	}
+
+	return result, nil
+}
@@ -3,35 +3,40 @@ package GroupApplicationDtos
 import "time"
 
 type CreateGroupInput struct {
-	Name          string
-	SimplifyDebts *bool
+	Name               string
+	SimplifyDebts      *bool
+	SettlementCurrency *string
 }
 
 type UpdateGroupInput struct {
-	Name          *string
-	SimplifyDebts *bool
+	Name               *string
+	SimplifyDebts      *bool
+	SettlementCurrency *string
 }
 
 type GroupResult struct {
-	ID            string
-	Name          string
-	SimplifyDebts bool
-	CreatedAt     time.Time
+	ID                 string
+	Name               string
+	SimplifyDebts      bool
+	SettlementCurrency *string
+	CreatedAt          time.Time
 }
 
 type GroupDetailResult struct {
-	ID            string
-	Name          string
-	SimplifyDebts bool
-	CreatedAt     time.Time
-	Members       []MemberResult
+	ID                 string
+	Name               string
+	SimplifyDebts      bool
+	SettlementCurrency *string
+	CreatedAt          time.Time
+	Members            []MemberResult
 }
 
 type MemberResult struct {
-	UserID string
-	Name   string
-	Email  string
-	Role   string
+	UserID  string
+	Name    string
+	Email   string
+	Role    string
+	IsGuest bool
 }
 
 type GroupListResult struct {
@@ -45,3 +50,45 @@ type AddMemberInput struct {
 	UserID string
 	Role   string
 }
+
+type AddGuestInput struct {
+	Name  string
+	Email string
+}
+
+type GroupAnalyticsInput struct {
+	From *time.Time
+	To   *time.Time
+}
+
+type CategorySpendResult struct {
+	CategoryID   *string
+	CategoryName *string
+	Currency     string
+	TotalAmount  int64
+	SplitCount   int64
+}
+
+type MemberSpendResult struct {
+	UserID      string
+	Name        string
+	Currency    string
+	PaidAmount  int64
+	ShareAmount int64
+}
+
+type MonthlySpendResult struct {
+	Month       time.Time
+	Currency    string
+	TotalAmount int64
+	SplitCount  int64
+}
+
+type GroupAnalyticsResult struct {
+	GroupID    string
+	From       *time.Time
+	To         *time.Time
+	ByCategory []CategorySpendResult
+	ByMember   []MemberSpendResult
+	ByMonth    []MonthlySpendResult
+}
@@ -2,6 +2,7 @@ package GroupApplication
 
 import (
 	"context"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 
@@ -9,6 +10,7 @@ import (
 	Domain "autobill-service/internal/domain"
 	HttpPorts "autobill-service/internal/ports/inbound/http"
 	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	Errors "autobill-service/pkg/errors"
 	Helpers "autobill-service/pkg/helpers"
 	Logger "autobill-service/pkg/logger"
@@ -19,10 +21,12 @@ import (
 type GroupService struct {
 	repo      RepositoryPorts.GroupRepositoryPort
 	splitRepo RepositoryPorts.SplitRepositoryPort
+	userRepo  RepositoryPorts.UserRepositoryPort
+	events    EventPorts.EventPublisher
 }
 
-func CreateGroupService(repo RepositoryPorts.GroupRepositoryPort, splitRepo RepositoryPorts.SplitRepositoryPort) HttpPorts.GroupUseCase {
-	return &GroupService{repo: repo, splitRepo: splitRepo}
+func CreateGroupService(repo RepositoryPorts.GroupRepositoryPort, splitRepo RepositoryPorts.SplitRepositoryPort, userRepo RepositoryPorts.UserRepositoryPort, events EventPorts.EventPublisher) HttpPorts.GroupUseCase {
+	return &GroupService{repo: repo, splitRepo: splitRepo, userRepo: userRepo, events: events}
 }
 
 func (s *GroupService) CreateGroup(ctx context.Context, userId uuid.UUID, input Dtos.CreateGroupInput) (*Dtos.GroupResult, error) {
@@ -35,7 +39,15 @@ func (s *GroupService) CreateGroup(ctx context.Context, userId uuid.UUID, input
 		simplifyDebts = *input.SimplifyDebts
 	}
 
-	group, dbErr := s.repo.CreateGroup(ctx, input.Name, userId, simplifyDebts)
+	settlementCurrency, err := parseSettlementCurrency(input.SettlementCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityGroupCreated, Domain.ActivityTargetGroup, uuid.Nil, nil)
+	activity.After = groupSummary(&Domain.Group{Name: input.Name, SimplifyDebts: simplifyDebts, SettlementCurrency: settlementCurrency})
+
+	group, dbErr := s.repo.CreateGroup(ctx, input.Name, userId, simplifyDebts, settlementCurrency, activity)
 	if dbErr != nil {
 		return nil, dbErr
 	}
@@ -48,13 +60,44 @@ func (s *GroupService) CreateGroup(ctx context.Context, userId uuid.UUID, input
 		Msg("Group created successfully")
 
 	return &Dtos.GroupResult{
-		ID:            group.Id.String(),
-		Name:          group.Name,
-		SimplifyDebts: group.SimplifyDebts,
-		CreatedAt:     group.CreatedAt,
+		ID:                 group.Id.String(),
+		Name:               group.Name,
+		SimplifyDebts:      group.SimplifyDebts,
+		SettlementCurrency: settlementCurrencyResult(group),
+		CreatedAt:          group.CreatedAt,
 	}, nil
 }
 
+func parseSettlementCurrency(currency *string) (*Domain.Currency, error) {
+	if currency == nil || *currency == "" {
+		return nil, nil
+	}
+	if !Domain.IsValidCurrency(*currency) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidCurrency)
+	}
+	settlementCurrency := Domain.Currency(*currency)
+	return &settlementCurrency, nil
+}
+
+func groupSummary(group *Domain.Group) Domain.ActivitySummary {
+	summary := Domain.ActivitySummary{
+		"name":           group.Name,
+		"simplify_debts": group.SimplifyDebts,
+	}
+	if group.SettlementCurrency != nil {
+		summary["settlement_currency"] = string(*group.SettlementCurrency)
+	}
+	return summary
+}
+
+func settlementCurrencyResult(group *Domain.Group) *string {
+	if group.SettlementCurrency == nil {
+		return nil
+	}
+	currency := string(*group.SettlementCurrency)
+	return &currency
+}
+
 func (s *GroupService) UpdateGroup(ctx context.Context, userId, groupId uuid.UUID, input Dtos.UpdateGroupInput) (*Dtos.GroupResult, error) {
 	isAdmin, adminErr := s.repo.IsGroupAdmin(ctx, groupId, userId)
 	if adminErr != nil {
@@ -71,21 +114,49 @@ func (s *GroupService) UpdateGroup(ctx context.Context, userId, groupId uuid.UUI
 	if input.SimplifyDebts != nil {
 		updates["simplify_debts"] = *input.SimplifyDebts
 	}
+	if input.SettlementCurrency != nil {
+		settlementCurrency, err := parseSettlementCurrency(input.SettlementCurrency)
+		if err != nil {
+			return nil, err
+		}
+		updates["settlement_currency"] = settlementCurrency
+	}
 
 	if len(updates) == 0 {
 		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNoFieldsToUpdate)
 	}
 
-	group, dbErr := s.repo.UpdateGroup(ctx, groupId, updates)
+	before, dbErr := s.repo.GetGroupById(ctx, groupId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityGroupUpdated, Domain.ActivityTargetGroup, groupId, &groupId)
+	activity.Before = groupSummary(before)
+
+	after := *before
+	if input.Name != nil {
+		after.Name = *input.Name
+	}
+	if input.SimplifyDebts != nil {
+		after.SimplifyDebts = *input.SimplifyDebts
+	}
+	if currency, ok := updates["settlement_currency"]; ok {
+		after.SettlementCurrency = currency.(*Domain.Currency)
+	}
+	activity.After = groupSummary(&after)
+
+	group, dbErr := s.repo.UpdateGroup(ctx, groupId, updates, activity)
 	if dbErr != nil {
 		return nil, dbErr
 	}
 
 	return &Dtos.GroupResult{
-		ID:            group.Id.String(),
-		Name:          group.Name,
-		SimplifyDebts: group.SimplifyDebts,
-		CreatedAt:     group.CreatedAt,
+		ID:                 group.Id.String(),
+		Name:               group.Name,
+		SimplifyDebts:      group.SimplifyDebts,
+		SettlementCurrency: settlementCurrencyResult(group),
+		CreatedAt:          group.CreatedAt,
 	}, nil
 }
 
@@ -99,10 +170,11 @@ func (s *GroupService) GetGroups(ctx context.Context, userId uuid.UUID, paginati
 	groupResults := make([]Dtos.GroupResult, len(groups))
 	for i, g := range groups {
 		groupResults[i] = Dtos.GroupResult{
-			ID:            g.Id.String(),
-			Name:          g.Name,
-			SimplifyDebts: g.SimplifyDebts,
-			CreatedAt:     g.CreatedAt,
+			ID:                 g.Id.String(),
+			Name:               g.Name,
+			SimplifyDebts:      g.SimplifyDebts,
+			SettlementCurrency: settlementCurrencyResult(&g),
+			CreatedAt:          g.CreatedAt,
 		}
 	}
 
@@ -128,19 +200,21 @@ func (s *GroupService) GetGroup(ctx context.Context, userId, groupId uuid.UUID)
 	members := make([]Dtos.MemberResult, len(group.Memberships))
 	for i, m := range group.Memberships {
 		members[i] = Dtos.MemberResult{
-			UserID: m.UserID.String(),
-			Name:   m.User.Name,
-			Email:  m.User.Email,
-			Role:   string(m.Role),
+			UserID:  m.UserID.String(),
+			Name:    m.User.Name,
+			Email:   m.User.Email,
+			Role:    string(m.Role),
+			IsGuest: m.User.IsGuest(),
 		}
 	}
 
 	return &Dtos.GroupDetailResult{
-		ID:            group.Id.String(),
-		Name:          group.Name,
-		SimplifyDebts: group.SimplifyDebts,
-		CreatedAt:     group.CreatedAt,
-		Members:       members,
+		ID:                 group.Id.String(),
+		Name:               group.Name,
+		SimplifyDebts:      group.SimplifyDebts,
+		SettlementCurrency: settlementCurrencyResult(group),
+		CreatedAt:          group.CreatedAt,
+		Members:            members,
 	}, nil
 }
 
@@ -153,7 +227,15 @@ func (s *GroupService) DeleteGroup(ctx context.Context, userId, groupId uuid.UUI
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrGroupNotFound)
 	}
 
-	return s.repo.DeleteGroup(ctx, groupId)
+	group, dbErr := s.repo.GetGroupById(ctx, groupId)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityGroupDeleted, Domain.ActivityTargetGroup, groupId, &groupId)
+	activity.Before = groupSummary(group)
+
+	return s.repo.DeleteGroup(ctx, groupId, activity)
 }
 
 func (s *GroupService) AddMember(ctx context.Context, userId, groupId uuid.UUID, input Dtos.AddMemberInput) (*Dtos.MemberResult, error) {
@@ -175,16 +257,68 @@ func (s *GroupService) AddMember(ctx context.Context, userId, groupId uuid.UUID,
 	}
 
 	role := Domain.GroupRole(input.Role)
-	membership, dbErr := s.repo.AddMember(ctx, groupId, newMemberUUID, role)
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityMemberAdded, Domain.ActivityTargetGroupMember, newMemberUUID, &groupId)
+	activity.AddAudience(newMemberUUID)
+	activity.After = Domain.ActivitySummary{"role": input.Role}
+
+	membership, dbErr := s.repo.AddMember(ctx, groupId, newMemberUUID, role, activity)
 	if dbErr != nil {
 		return nil, dbErr
 	}
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventGroupMembership, activity))
 
 	return &Dtos.MemberResult{
-		UserID: membership.UserID.String(),
-		Name:   membership.User.Name,
-		Email:  membership.User.Email,
-		Role:   string(membership.Role),
+		UserID:  membership.UserID.String(),
+		Name:    membership.User.Name,
+		Email:   membership.User.Email,
+		Role:    string(membership.Role),
+		IsGuest: membership.User.IsGuest(),
+	}, nil
+}
+
+// AddGuest creates a placeholder member for someone without an account. If an
+// email is given, the guest is claimed when that address registers.
+func (s *GroupService) AddGuest(ctx context.Context, userId, groupId uuid.UUID, input Dtos.AddGuestInput) (*Dtos.MemberResult, error) {
+	isAdmin, adminErr := s.repo.IsGroupAdmin(ctx, groupId, userId)
+	if adminErr != nil {
+		return nil, adminErr
+	}
+	if !isAdmin {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrGroupNotFound)
+	}
+
+	guest := Domain.NewGuest(strings.TrimSpace(input.Name), input.Email)
+	if guest.Name == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrGuestNameRequired)
+	}
+	if guest.Email != "" {
+		if _, err := s.userRepo.FindUserByEmail(ctx, guest.Email); err == nil {
+			return nil, fiber.NewError(fiber.StatusConflict, Errors.ErrInviteeAlreadyRegistered)
+		}
+	}
+
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityMemberAdded, Domain.ActivityTargetGroupMember, uuid.Nil, &groupId)
+	activity.After = Domain.ActivitySummary{"role": string(Domain.GroupRoleMember), "name": guest.Name, "guest": true}
+
+	membership, dbErr := s.repo.AddGuest(ctx, groupId, guest, activity)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventGroupMembership, activity))
+
+	Logger.Debug().
+		Str("operation", "AddGuest").
+		Str("userId", userId.String()).
+		Str("groupId", groupId.String()).
+		Str("guestId", guest.Id.String()).
+		Msg("Guest member added successfully")
+
+	return &Dtos.MemberResult{
+		UserID:  membership.UserID.String(),
+		Name:    guest.Name,
+		Email:   guest.Email,
+		Role:    string(membership.Role),
+		IsGuest: true,
 	}, nil
 }
 
@@ -209,8 +343,26 @@ func (s *GroupService) UpdateMemberRole(ctx context.Context, userId, groupId, me
 		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRole)
 	}
 
+	membership, memberErr := s.repo.GetMembership(ctx, groupId, memberId)
+	if memberErr != nil {
+		return memberErr
+	}
+	if membership.User.IsGuest() {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrGuestCannotHoldRole)
+	}
+
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityMemberRoleChanged, Domain.ActivityTargetGroupMember, memberId, &groupId)
+	activity.AddAudience(memberId)
+	activity.Before = Domain.ActivitySummary{"role": string(membership.Role)}
+	activity.After = Domain.ActivitySummary{"role": role}
+
 	groupRole := Domain.GroupRole(role)
-	return s.repo.UpdateMemberRole(ctx, groupId, memberId, groupRole)
+	if err := s.repo.UpdateMemberRole(ctx, groupId, memberId, groupRole, activity); err != nil {
+		return err
+	}
+
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventGroupMembership, activity))
+	return nil
 }
 
 func (s *GroupService) TransferOwnership(ctx context.Context, userId, groupId, newOwnerId uuid.UUID) error {
@@ -226,11 +378,25 @@ func (s *GroupService) TransferOwnership(ctx context.Context, userId, groupId, n
 		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrCannotAssignOwnerRole)
 	}
 
-	if _, err := s.repo.GetMembership(ctx, groupId, newOwnerId); err != nil {
+	membership, memberErr := s.repo.GetMembership(ctx, groupId, newOwnerId)
+	if memberErr != nil {
+		return memberErr
+	}
+	if membership.User.IsGuest() {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrGuestCannotHoldRole)
+	}
+
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityOwnershipTransferred, Domain.ActivityTargetGroup, groupId, &groupId)
+	activity.AddAudience(newOwnerId)
+	activity.Before = Domain.ActivitySummary{"owner_id": userId.String()}
+	activity.After = Domain.ActivitySummary{"owner_id": newOwnerId.String()}
+
+	if err := s.repo.TransferOwnership(ctx, groupId, userId, newOwnerId, activity); err != nil {
 		return err
 	}
 
-	return s.repo.TransferOwnership(ctx, groupId, userId, newOwnerId)
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventGroupMembership, activity))
+	return nil
 }
 
 func (s *GroupService) RemoveMember(ctx context.Context, userId, groupId, memberId uuid.UUID) error {
@@ -250,7 +416,15 @@ func (s *GroupService) RemoveMember(ctx context.Context, userId, groupId, member
 		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrCannotRemoveOwner)
 	}
 
-	return s.repo.RemoveMember(ctx, groupId, memberId)
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityMemberRemoved, Domain.ActivityTargetGroupMember, memberId, &groupId)
+	activity.AddAudience(memberId)
+
+	if err := s.repo.RemoveMember(ctx, groupId, memberId, activity); err != nil {
+		return err
+	}
+
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventGroupMembership, activity))
+	return nil
 }
 
 func (s *GroupService) LeaveGroup(ctx context.Context, userId, groupId uuid.UUID) error {
@@ -275,5 +449,12 @@ func (s *GroupService) LeaveGroup(ctx context.Context, userId, groupId uuid.UUID
 		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrHasPendingSplits)
 	}
 
-	return s.repo.RemoveMember(ctx, groupId, userId)
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityMemberLeft, Domain.ActivityTargetGroupMember, userId, &groupId)
+
+	if err := s.repo.RemoveMember(ctx, groupId, userId, activity); err != nil {
+		return err
+	}
+
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventGroupMembership, activity))
+	return nil
 }
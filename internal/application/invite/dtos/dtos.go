@@ -0,0 +1,39 @@
+package InviteApplicationDtos
+
+import "time"
+
+type CreateInviteInput struct {
+	Email          string
+	GuestID        string
+	Role           string
+	MaxUses        *int
+	ExpiresInHours *int
+}
+
+type InviteResult struct {
+	ID            string
+	GroupID       string
+	Email         *string
+	GuestID       *string
+	Role          string
+	MaxUses       int
+	Uses          int
+	CreatedByID   string
+	CreatedByName string
+	Token         string
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+}
+
+type InviteListResult struct {
+	Invites    []InviteResult
+	Page       int
+	PageSize   int
+	TotalItems int64
+}
+
+type JoinGroupResult struct {
+	GroupID   string
+	GroupName string
+	Role      string
+}
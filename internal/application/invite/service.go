@@ -0,0 +1,228 @@
+package InviteApplication
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/invite/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type InviteService struct {
+	repo      RepositoryPorts.InviteRepositoryPort
+	groupRepo RepositoryPorts.GroupRepositoryPort
+	userRepo  RepositoryPorts.UserRepositoryPort
+	events    EventPorts.EventPublisher
+}
+
+func CreateInviteService(repo RepositoryPorts.InviteRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort, userRepo RepositoryPorts.UserRepositoryPort, events EventPorts.EventPublisher) HttpPorts.InviteUseCase {
+	return &InviteService{
+		repo:      repo,
+		groupRepo: groupRepo,
+		userRepo:  userRepo,
+		events:    events,
+	}
+}
+
+func (s *InviteService) CreateInvite(ctx context.Context, userId, groupId uuid.UUID, input Dtos.CreateInviteInput) (*Dtos.InviteResult, error) {
+	if err := s.requireAdmin(ctx, userId, groupId); err != nil {
+		return nil, err
+	}
+
+	role := string(Domain.GroupRoleMember)
+	if input.Role != "" {
+		role = input.Role
+	}
+	if !Domain.IsValidAssignableRole(role) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRole)
+	}
+
+	ttl := Domain.DefaultInviteTTL
+	if input.ExpiresInHours != nil {
+		ttl = time.Duration(*input.ExpiresInHours) * time.Hour
+		if ttl <= 0 || ttl > Domain.MaxInviteTTL {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidInviteExpiry)
+		}
+	}
+
+	invite := &Domain.GroupInvite{
+		GroupID:     groupId,
+		CreatedByID: userId,
+		Role:        Domain.GroupRole(role),
+		MaxUses:     Domain.DefaultInviteUses,
+		ExpiresAt:   time.Now().Add(ttl),
+	}
+	if input.MaxUses != nil {
+		invite.MaxUses = *input.MaxUses
+	}
+
+	// Guest invites hand over a placeholder member, so only one person can
+	// use them.
+	if input.GuestID != "" {
+		guestId, err := Helpers.ParseUUID(input.GuestID)
+		if err != nil {
+			return nil, err
+		}
+		membership, memberErr := s.groupRepo.GetMembership(ctx, groupId, guestId)
+		if memberErr != nil || !membership.User.IsGuest() {
+			return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrGuestNotFound)
+		}
+		invite.GuestID = &guestId
+		invite.MaxUses = 1
+	}
+
+	// Email invites are single use and wait for the address to register. A
+	// guest invite may also be addressed to an existing account.
+	if input.Email != "" {
+		email := strings.ToLower(strings.TrimSpace(input.Email))
+		if invite.GuestID == nil {
+			if _, err := s.userRepo.FindUserByEmail(ctx, email); err == nil {
+				return nil, fiber.NewError(fiber.StatusConflict, Errors.ErrInviteeAlreadyRegistered)
+			}
+		}
+		invite.Email = &email
+		invite.MaxUses = 1
+	}
+
+	token, tokenHash, err := Domain.GenerateInviteToken()
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrInternal)
+	}
+	invite.TokenHash = tokenHash
+
+	created, dbErr := s.repo.CreateInvite(ctx, invite)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	Logger.Debug().
+		Str("operation", "CreateInvite").
+		Str("userId", userId.String()).
+		Str("groupId", groupId.String()).
+		Str("inviteId", created.Id.String()).
+		Msg("Group invite created successfully")
+
+	// The token is only returned when the invite is created.
+	result := inviteToDto(created)
+	result.Token = token
+	return result, nil
+}
+
+func (s *InviteService) GetInvites(ctx context.Context, userId, groupId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.InviteListResult, error) {
+	if err := s.requireAdmin(ctx, userId, groupId); err != nil {
+		return nil, err
+	}
+
+	invites, total, dbErr := s.repo.GetPendingInvites(ctx, groupId, time.Now(), pagination.PageSize, pagination.Offset())
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	results := make([]Dtos.InviteResult, len(invites))
+	for i := range invites {
+		results[i] = *inviteToDto(&invites[i])
+	}
+
+	return &Dtos.InviteListResult{
+		Invites:    results,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalItems: total,
+	}, nil
+}
+
+func (s *InviteService) RevokeInvite(ctx context.Context, userId, groupId, inviteId uuid.UUID) error {
+	if err := s.requireAdmin(ctx, userId, groupId); err != nil {
+		return err
+	}
+
+	if err := s.repo.RevokeInvite(ctx, groupId, inviteId, time.Now()); err != nil {
+		return err
+	}
+
+	Logger.Debug().
+		Str("operation", "RevokeInvite").
+		Str("userId", userId.String()).
+		Str("groupId", groupId.String()).
+		Str("inviteId", inviteId.String()).
+		Msg("Group invite revoked")
+
+	return nil
+}
+
+func (s *InviteService) JoinGroup(ctx context.Context, userId uuid.UUID, token string) (*Dtos.JoinGroupResult, error) {
+	invite, err := s.repo.GetInviteByTokenHash(ctx, Domain.HashInviteToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if !invite.IsPending(time.Now()) {
+		return nil, fiber.NewError(fiber.StatusGone, Errors.ErrInviteNoLongerValid)
+	}
+
+	user, userErr := s.userRepo.FindUserById(ctx, userId)
+	if userErr != nil {
+		return nil, userErr
+	}
+
+	activity := invite.JoinActivity(userId)
+	membership, dbErr := s.repo.AcceptInvite(ctx, invite.Id, user, activity)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventGroupMembership, activity))
+
+	Logger.Debug().
+		Str("operation", "JoinGroup").
+		Str("userId", userId.String()).
+		Str("groupId", membership.GroupID.String()).
+		Str("inviteId", invite.Id.String()).
+		Msg("User joined group through invite")
+
+	return &Dtos.JoinGroupResult{
+		GroupID:   membership.GroupID.String(),
+		GroupName: membership.Group.Name,
+		Role:      string(membership.Role),
+	}, nil
+}
+
+func (s *InviteService) requireAdmin(ctx context.Context, userId, groupId uuid.UUID) error {
+	isAdmin, err := s.groupRepo.IsGroupAdmin(ctx, groupId, userId)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrGroupNotFound)
+	}
+	return nil
+}
+
+func inviteToDto(invite *Domain.GroupInvite) *Dtos.InviteResult {
+	result := &Dtos.InviteResult{
+		ID:            invite.Id.String(),
+		GroupID:       invite.GroupID.String(),
+		Email:         invite.Email,
+		Role:          string(invite.Role),
+		MaxUses:       invite.MaxUses,
+		Uses:          invite.Uses,
+		CreatedByID:   invite.CreatedByID.String(),
+		CreatedByName: invite.CreatedBy.Name,
+		ExpiresAt:     invite.ExpiresAt,
+		CreatedAt:     invite.CreatedAt,
+	}
+	if invite.GuestID != nil {
+		guestId := invite.GuestID.String()
+		result.GuestID = &guestId
+	}
+	return result
+}
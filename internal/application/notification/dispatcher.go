@@ -0,0 +1,160 @@
+package NotificationApplication
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	NotificationPorts "autobill-service/internal/ports/outbound/notification"
+	Logger "autobill-service/pkg/logger"
+)
+
+const (
+	notificationBatchSize = 50
+	digestBatchSize       = 500
+	notificationLease     = 5 * time.Minute
+	notificationAttempts  = 5
+)
+
+type NotificationDispatcher struct {
+	repo         RepositoryPorts.NotificationRepositoryPort
+	sender       NotificationPorts.NotificationSender
+	interval     time.Duration
+	digestHour   int
+	nextDigestAt time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func CreateNotificationDispatcher(repo RepositoryPorts.NotificationRepositoryPort, sender NotificationPorts.NotificationSender, interval time.Duration, digestHour int) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		repo:       repo,
+		sender:     sender,
+		interval:   interval,
+		digestHour: digestHour,
+	}
+}
+
+func (d *NotificationDispatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.done = make(chan struct{})
+	d.nextDigestAt = Domain.NextDigestAt(time.Now(), d.digestHour)
+
+	go func() {
+		defer close(d.done)
+
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+
+		Logger.Info().
+			Dur("interval", d.interval).
+			Time("nextDigestAt", d.nextDigestAt).
+			Msg("Notification dispatcher started")
+
+		for {
+			d.dispatchInstant(ctx)
+
+			if now := time.Now().UTC(); !now.Before(d.nextDigestAt) {
+				d.dispatchDigests(ctx)
+				d.nextDigestAt = Domain.NextDigestAt(now.Add(time.Minute), d.digestHour)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (d *NotificationDispatcher) Stop() {
+	if d.cancel == nil {
+		return
+	}
+	d.cancel()
+	<-d.done
+	Logger.Info().Msg("Notification dispatcher stopped")
+}
+
+func (d *NotificationDispatcher) dispatchInstant(ctx context.Context) {
+	notifications, err := d.repo.ClaimDueNotifications(ctx, false, time.Now().UTC(), notificationLease, notificationBatchSize)
+	if err != nil {
+		Logger.Error().Err(err).Msg("Failed to claim due notifications")
+		return
+	}
+
+	for i := range notifications {
+		if ctx.Err() != nil {
+			return
+		}
+		message, err := renderNotification(&notifications[i])
+		if err == nil {
+			err = d.sender.Send(ctx, message)
+		}
+		d.record(ctx, notifications[i:i+1], err)
+	}
+}
+
+// dispatchDigests sends one email per recipient covering every pending
+// digest notification. Claims are ordered by recipient, so a recipient only
+// spans two batches at a batch boundary.
+func (d *NotificationDispatcher) dispatchDigests(ctx context.Context) {
+	for ctx.Err() == nil {
+		notifications, err := d.repo.ClaimDueNotifications(ctx, true, time.Now().UTC(), notificationLease, digestBatchSize)
+		if err != nil {
+			Logger.Error().Err(err).Msg("Failed to claim digest notifications")
+			return
+		}
+
+		for start := 0; start < len(notifications); {
+			end := start + 1
+			for end < len(notifications) && notifications[end].UserID == notifications[start].UserID {
+				end++
+			}
+
+			message, err := renderDigest(notifications[start:end])
+			if err == nil {
+				err = d.sender.Send(ctx, message)
+			}
+			d.record(ctx, notifications[start:end], err)
+			start = end
+		}
+
+		if len(notifications) < digestBatchSize {
+			return
+		}
+	}
+}
+
+func (d *NotificationDispatcher) record(ctx context.Context, notifications []Domain.Notification, sendErr error) {
+	if ctx.Err() != nil {
+		// Shutting down; the lease expires and the notifications are retried.
+		return
+	}
+
+	now := time.Now().UTC()
+	for i := range notifications {
+		notification := &notifications[i]
+		if sendErr != nil {
+			notification.MarkFailed(now, sendErr.Error(), notificationAttempts)
+		} else {
+			notification.MarkSent(now)
+		}
+
+		if err := d.repo.SaveNotificationAttempt(ctx, notification); err != nil {
+			Logger.Error().Err(err).Str("notificationId", notification.Id.String()).Msg("Failed to record notification attempt")
+		}
+	}
+
+	if sendErr != nil {
+		Logger.Warn().
+			Err(sendErr).
+			Str("userId", notifications[0].UserID.String()).
+			Int("notifications", len(notifications)).
+			Msg("Notification email failed")
+	}
+}
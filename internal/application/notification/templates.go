@@ -0,0 +1,163 @@
+package NotificationApplication
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	Domain "autobill-service/internal/domain"
+	NotificationPorts "autobill-service/internal/ports/outbound/notification"
+)
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+var (
+	eventTemplates = loadEventTemplates()
+	digestTemplate = template.Must(template.ParseFS(templateFiles, "templates/digest.tmpl"))
+)
+
+// Each event template defines a "subject" and "body" for an email of its own
+// and a "line" used to list it in a digest.
+func loadEventTemplates() map[Domain.ActivityAction]*template.Template {
+	templates := make(map[Domain.ActivityAction]*template.Template, len(Domain.NotificationEvents))
+	for _, action := range Domain.NotificationEvents {
+		name := "templates/" + strings.ToLower(string(action)) + ".tmpl"
+		templates[action] = template.Must(template.ParseFS(templateFiles, name))
+	}
+	return templates
+}
+
+type messageView struct {
+	RecipientName string
+	ActorName     string
+	Description   string
+	Amount        string
+	Reason        string
+	Comment       string
+	Settlements   int
+	Automatic     bool
+}
+
+type digestView struct {
+	RecipientName string
+	Lines         []string
+}
+
+func newMessageView(notification *Domain.Notification) messageView {
+	activity := notification.ActivityEvent
+	summary := activity.After
+
+	view := messageView{
+		RecipientName: notification.User.Name,
+		ActorName:     activity.Actor.Name,
+		Description:   summaryString(summary, "description"),
+		Reason:        summaryString(summary, "reason"),
+		Comment:       summaryString(summary, "comment"),
+		Settlements:   int(summaryInt(summary, "settlements")),
+		Automatic:     summary["automatic"] == true,
+	}
+	if view.ActorName == "" {
+		view.ActorName = "Someone"
+	}
+
+	amount := summaryInt(summary, "amount")
+	if _, ok := summary["total_amount"]; ok {
+		amount = summaryInt(summary, "total_amount")
+	}
+	currency := Domain.Currency(summaryString(summary, "currency"))
+	view.Amount = Domain.FormatAmount(amount, currency) + " " + string(currency)
+
+	return view
+}
+
+func renderNotification(notification *Domain.Notification) (NotificationPorts.Message, error) {
+	tmpl, ok := eventTemplates[notification.ActivityEvent.Action]
+	if !ok {
+		return NotificationPorts.Message{}, fmt.Errorf("no notification template for %s", notification.ActivityEvent.Action)
+	}
+
+	view := newMessageView(notification)
+	subject, err := execute(tmpl, "subject", view)
+	if err != nil {
+		return NotificationPorts.Message{}, err
+	}
+	body, err := execute(tmpl, "body", view)
+	if err != nil {
+		return NotificationPorts.Message{}, err
+	}
+
+	return NotificationPorts.Message{
+		To:      notification.User.Email,
+		ToName:  notification.User.Name,
+		Subject: subject,
+		Body:    body,
+	}, nil
+}
+
+// renderDigest combines notifications for a single recipient into one email.
+func renderDigest(notifications []Domain.Notification) (NotificationPorts.Message, error) {
+	recipient := notifications[0].User
+
+	view := digestView{RecipientName: recipient.Name}
+	for i := range notifications {
+		tmpl, ok := eventTemplates[notifications[i].ActivityEvent.Action]
+		if !ok {
+			continue
+		}
+		line, err := execute(tmpl, "line", newMessageView(&notifications[i]))
+		if err != nil {
+			return NotificationPorts.Message{}, err
+		}
+		view.Lines = append(view.Lines, line)
+	}
+
+	subject, err := execute(digestTemplate, "subject", view)
+	if err != nil {
+		return NotificationPorts.Message{}, err
+	}
+	body, err := execute(digestTemplate, "body", view)
+	if err != nil {
+		return NotificationPorts.Message{}, err
+	}
+
+	return NotificationPorts.Message{
+		To:      recipient.Email,
+		ToName:  recipient.Name,
+		Subject: subject,
+		Body:    body,
+	}, nil
+}
+
+func execute(tmpl *template.Template, name string, data any) (string, error) {
+	var buffer bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buffer, name, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buffer.String()), nil
+}
+
+func summaryString(summary Domain.ActivitySummary, key string) string {
+	value, _ := summary[key].(string)
+	return value
+}
+
+// summaryInt reads a number from a summary, which holds int64 values when
+// built in memory and float64 or json.Number values once read back.
+func summaryInt(summary Domain.ActivitySummary, key string) int64 {
+	switch value := summary[key].(type) {
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	case float64:
+		return int64(value)
+	case json.Number:
+		number, _ := value.Int64()
+		return number
+	}
+	return 0
+}
@@ -0,0 +1,87 @@
+package NotificationApplication
+
+import (
+	"strings"
+	"testing"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+func testNotification(action Domain.ActivityAction, targetType Domain.ActivityTargetType, after Domain.ActivitySummary) Domain.Notification {
+	return Domain.Notification{
+		UserID: uuid.New(),
+		User:   Domain.User{Name: "Bob", Email: "bob@example.com"},
+		ActivityEvent: Domain.ActivityEvent{
+			Action:     action,
+			TargetType: targetType,
+			After:      after,
+			Actor:      Domain.User{Name: "Alice"},
+		},
+	}
+}
+
+func TestRenderNotificationCoversEveryEvent(t *testing.T) {
+	for _, action := range Domain.NotificationEvents {
+		notification := testNotification(action, Domain.ActivityTargetSettlement, Domain.ActivitySummary{
+			"amount":   int64(1250),
+			"currency": "USD",
+		})
+
+		message, err := renderNotification(&notification)
+		if err != nil {
+			t.Fatalf("%s: %v", action, err)
+		}
+		if message.To != "bob@example.com" || message.Subject == "" || !strings.Contains(message.Body, "Hi Bob") {
+			t.Errorf("%s: unexpected message %+v", action, message)
+		}
+	}
+}
+
+func TestRenderNotificationSplitCreated(t *testing.T) {
+	notification := testNotification(Domain.ActivitySplitCreated, Domain.ActivityTargetSplit, Domain.ActivitySummary{
+		"description":  "Dinner",
+		"total_amount": float64(4200),
+		"currency":     "EUR",
+	})
+
+	message, err := renderNotification(&notification)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.Subject != `Alice added "Dinner"` {
+		t.Errorf("subject = %q", message.Subject)
+	}
+	if !strings.Contains(message.Body, "Total: 42.00 EUR") {
+		t.Errorf("body missing total:\n%s", message.Body)
+	}
+}
+
+func TestRenderDigestListsEachNotification(t *testing.T) {
+	notifications := []Domain.Notification{
+		testNotification(Domain.ActivitySplitCreated, Domain.ActivityTargetSplit, Domain.ActivitySummary{
+			"description":  "Taxi",
+			"total_amount": int64(900),
+			"currency":     "USD",
+		}),
+		testNotification(Domain.ActivitySettlementRejected, Domain.ActivityTargetSettlement, Domain.ActivitySummary{
+			"amount":   int64(300),
+			"currency": "USD",
+			"reason":   "wrong amount",
+		}),
+	}
+
+	message, err := renderDigest(notifications)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.Subject != "Your AutoBill summary: 2 updates" {
+		t.Errorf("subject = %q", message.Subject)
+	}
+	for _, line := range []string{`- Alice added "Taxi" (9.00 USD)`, "- Alice rejected a settlement of 3.00 USD"} {
+		if !strings.Contains(message.Body, line) {
+			t.Errorf("body missing %q:\n%s", line, message.Body)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package ReminderApplicationDtos
+
+import "time"
+
+type ReminderType string
+
+const (
+	ReminderTypeSent     ReminderType = "sent"
+	ReminderTypeReceived ReminderType = "received"
+)
+
+type SendReminderInput struct {
+	SplitID  string
+	DebtorID string
+}
+
+type ReminderResult struct {
+	ID               string
+	SplitID          string
+	SplitDescription string
+	SenderID         *string
+	SenderName       string
+	DebtorID         string
+	DebtorName       string
+	Amount           int64
+	Currency         string
+	Automatic        bool
+	CreatedAt        time.Time
+}
+
+type ReminderListResult struct {
+	Reminders  []ReminderResult
+	Page       int
+	PageSize   int
+	TotalItems int64
+}
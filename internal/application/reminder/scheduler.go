@@ -0,0 +1,124 @@
+package ReminderApplication
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const automaticReminderBatchSize = 100
+
+// ReminderScheduler reminds debtors whose share of a split has been
+// outstanding for longer than the configured age, and again every time that
+// age passes without a reminder. A zero age disables it.
+type ReminderScheduler struct {
+	repo     RepositoryPorts.ReminderRepositoryPort
+	after    time.Duration
+	interval time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func CreateReminderScheduler(repo RepositoryPorts.ReminderRepositoryPort, afterDays int, interval time.Duration) *ReminderScheduler {
+	return &ReminderScheduler{
+		repo:     repo,
+		after:    time.Duration(afterDays) * 24 * time.Hour,
+		interval: interval,
+	}
+}
+
+func (s *ReminderScheduler) Start() {
+	if s.after <= 0 {
+		Logger.Info().Msg("Automatic payment reminders disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		Logger.Info().
+			Dur("interval", s.interval).
+			Dur("after", s.after).
+			Msg("Payment reminder scheduler started")
+
+		for {
+			s.remindOverdue(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *ReminderScheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+	Logger.Info().Msg("Payment reminder scheduler stopped")
+}
+
+func (s *ReminderScheduler) remindOverdue(ctx context.Context) {
+	cutoff := time.Now().UTC().Add(-s.after)
+
+	participants, err := s.repo.GetParticipantsDueForReminder(ctx, cutoff, cutoff, automaticReminderBatchSize)
+	if err != nil {
+		Logger.Error().Err(err).Msg("Failed to load participants due for a reminder")
+		return
+	}
+
+	for i := range participants {
+		if ctx.Err() != nil {
+			return
+		}
+
+		participant := &participants[i]
+		reminder := &Domain.PaymentReminder{
+			SplitID:   participant.SplitID,
+			DebtorID:  participant.UserID,
+			Amount:    participant.Outstanding(),
+			Currency:  participant.Currency,
+			Automatic: true,
+		}
+		activity := reminderActivity(automaticReminderActor(&participant.Split, participant.UserID), &participant.Split, reminder)
+
+		if _, err := s.repo.CreateReminder(ctx, reminder, activity); err != nil {
+			Logger.Error().
+				Err(err).
+				Str("splitId", participant.SplitID.String()).
+				Str("debtorId", participant.UserID.String()).
+				Msg("Failed to send automatic payment reminder")
+		}
+	}
+}
+
+// automaticReminderActor sends automatic reminders on behalf of the split
+// creator, or of a payer when the creator is the debtor.
+func automaticReminderActor(split *Domain.Split, debtorId uuid.UUID) uuid.UUID {
+	if split.CreatedByID != debtorId {
+		return split.CreatedByID
+	}
+	for _, payer := range split.Payers {
+		if payer.UserID != debtorId {
+			return payer.UserID
+		}
+	}
+	return split.CreatedByID
+}
@@ -0,0 +1,180 @@
+package ReminderApplication
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/reminder/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type ReminderService struct {
+	repo      RepositoryPorts.ReminderRepositoryPort
+	splitRepo RepositoryPorts.SplitRepositoryPort
+	groupRepo RepositoryPorts.GroupRepositoryPort
+	cooldown  time.Duration
+}
+
+func CreateReminderService(repo RepositoryPorts.ReminderRepositoryPort, splitRepo RepositoryPorts.SplitRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort, cooldown time.Duration) HttpPorts.ReminderUseCase {
+	return &ReminderService{
+		repo:      repo,
+		splitRepo: splitRepo,
+		groupRepo: groupRepo,
+		cooldown:  cooldown,
+	}
+}
+
+func (s *ReminderService) SendReminder(ctx context.Context, userId uuid.UUID, input Dtos.SendReminderInput) (*Dtos.ReminderResult, error) {
+	splitId, err := Helpers.ParseUUID(input.SplitID)
+	if err != nil {
+		return nil, err
+	}
+	debtorId, err := Helpers.ParseUUID(input.DebtorID)
+	if err != nil {
+		return nil, err
+	}
+	if debtorId == userId {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrCannotRemindSelf)
+	}
+
+	split, splitErr := s.splitRepo.GetSplitWithParticipants(ctx, splitId)
+	if splitErr != nil {
+		return nil, splitErr
+	}
+	if err := s.authorizeReminder(ctx, split, userId); err != nil {
+		return nil, err
+	}
+
+	participant := findParticipant(split, debtorId)
+	if participant == nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrParticipantNotFound)
+	}
+	if participant.Outstanding() <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNothingOutstanding)
+	}
+
+	reminder := &Domain.PaymentReminder{
+		SplitID:  split.Id,
+		SenderID: &userId,
+		DebtorID: debtorId,
+		Amount:   participant.Outstanding(),
+		Currency: participant.Currency,
+	}
+
+	created, dbErr := s.repo.CreateReminderWithCooldown(ctx, reminder, reminderActivity(userId, split, reminder), s.cooldown)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	Logger.Debug().
+		Str("operation", "SendReminder").
+		Str("splitId", split.Id.String()).
+		Str("senderId", userId.String()).
+		Str("debtorId", debtorId.String()).
+		Msg("Payment reminder sent")
+
+	created.Split = *split
+	created.Debtor = participant.User
+	return toReminderResult(created), nil
+}
+
+func (s *ReminderService) GetReminders(ctx context.Context, userId uuid.UUID, reminderType Dtos.ReminderType, pagination Helpers.PaginationParams) (*Dtos.ReminderListResult, error) {
+	var reminders []Domain.PaymentReminder
+	var total int64
+	var dbErr error
+	if reminderType == Dtos.ReminderTypeSent {
+		reminders, total, dbErr = s.repo.GetSentReminders(ctx, userId, pagination.PageSize, pagination.Offset())
+	} else {
+		reminders, total, dbErr = s.repo.GetReceivedReminders(ctx, userId, pagination.PageSize, pagination.Offset())
+	}
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	results := make([]Dtos.ReminderResult, len(reminders))
+	for i := range reminders {
+		results[i] = *toReminderResult(&reminders[i])
+	}
+
+	return &Dtos.ReminderListResult{
+		Reminders:  results,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalItems: total,
+	}, nil
+}
+
+// authorizeReminder allows the split creator and admins of the split's
+// group. Users who cannot see the split get a not found error.
+func (s *ReminderService) authorizeReminder(ctx context.Context, split *Domain.Split, userId uuid.UUID) error {
+	if split.CreatedByID == userId {
+		return nil
+	}
+	if split.GroupID != nil {
+		isAdmin, err := s.groupRepo.IsGroupAdmin(ctx, *split.GroupID, userId)
+		if err != nil {
+			return fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
+		}
+		if isAdmin {
+			return nil
+		}
+		return fiber.NewError(fiber.StatusForbidden, Errors.ErrNotAllowedToRemind)
+	}
+	if findParticipant(split, userId) != nil {
+		return fiber.NewError(fiber.StatusForbidden, Errors.ErrNotAllowedToRemind)
+	}
+	return fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
+}
+
+func findParticipant(split *Domain.Split, userId uuid.UUID) *Domain.SplitParticipant {
+	for i := range split.Participants {
+		if split.Participants[i].UserID == userId {
+			return &split.Participants[i]
+		}
+	}
+	return nil
+}
+
+func reminderActivity(actorId uuid.UUID, split *Domain.Split, reminder *Domain.PaymentReminder) *Domain.ActivityEvent {
+	activity := Domain.NewActivityEvent(actorId, Domain.ActivityPaymentReminded, Domain.ActivityTargetSplit, split.Id, split.GroupID)
+	activity.AddAudience(reminder.DebtorID)
+	activity.After = Domain.ActivitySummary{
+		"description": split.Description,
+		"debtor_id":   reminder.DebtorID.String(),
+		"amount":      reminder.Amount,
+		"currency":    string(reminder.Currency),
+		"automatic":   reminder.Automatic,
+	}
+	return activity
+}
+
+func toReminderResult(reminder *Domain.PaymentReminder) *Dtos.ReminderResult {
+	result := &Dtos.ReminderResult{
+		ID:               reminder.Id.String(),
+		SplitID:          reminder.SplitID.String(),
+		SplitDescription: reminder.Split.Description,
+		DebtorID:         reminder.DebtorID.String(),
+		DebtorName:       reminder.Debtor.Name,
+		Amount:           reminder.Amount,
+		Currency:         string(reminder.Currency),
+		Automatic:        reminder.Automatic,
+		CreatedAt:        reminder.CreatedAt,
+	}
+	if reminder.SenderID != nil {
+		senderId := reminder.SenderID.String()
+		result.SenderID = &senderId
+	}
+	if reminder.Sender != nil {
+		result.SenderName = reminder.Sender.Name
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package SettlementApplication
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	CommentApplication "autobill-service/internal/application/comment"
+	Domain "autobill-service/internal/domain"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// SettlementThreadResolver limits a settlement's comments to its payer and
+// payee, the same users who can see its history.
+type SettlementThreadResolver struct {
+	repo      RepositoryPorts.SettlementRepositoryPort
+	splitRepo RepositoryPorts.SplitRepositoryPort
+	userRepo  RepositoryPorts.UserRepositoryPort
+}
+
+func CreateSettlementThreadResolver(repo RepositoryPorts.SettlementRepositoryPort, splitRepo RepositoryPorts.SplitRepositoryPort, userRepo RepositoryPorts.UserRepositoryPort) CommentApplication.ThreadResolver {
+	return &SettlementThreadResolver{
+		repo:      repo,
+		splitRepo: splitRepo,
+		userRepo:  userRepo,
+	}
+}
+
+func (r *SettlementThreadResolver) ResolveThread(ctx context.Context, userId, settlementId uuid.UUID) (*Domain.CommentThread, error) {
+	settlement, dbErr := r.repo.GetSettlementById(ctx, settlementId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	if settlement.PayerID != userId && settlement.PayeeID != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
+	}
+
+	// The thread is left without a group so that mentions stay out of the
+	// group's activity feed, which other members can read.
+	thread := &Domain.CommentThread{
+		TargetType: Domain.ActivityTargetSettlement,
+		TargetID:   settlement.Id,
+	}
+	if !settlement.IsDirect() {
+		split, err := r.splitRepo.GetSplitById(ctx, *settlement.SplitID)
+		if err != nil {
+			return nil, err
+		}
+		thread.Description = split.Description
+	}
+
+	for _, id := range []uuid.UUID{settlement.PayerID, settlement.PayeeID} {
+		user, err := r.userRepo.FindUserById(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !user.IsGuest() {
+			thread.Mentionable = append(thread.Mentionable, *user)
+		}
+	}
+	return thread, nil
+}
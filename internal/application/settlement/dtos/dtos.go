@@ -4,6 +4,7 @@ import "time"
 
 type CreateSettlementInput struct {
 	SplitID        string
+	GroupID        string
 	PayeeID        string
 	Amount         int64
 	Currency       string
@@ -11,16 +12,57 @@ type CreateSettlementInput struct {
 }
 
 type SettlementResult struct {
-	ID        string
-	SplitID   string
-	PayerID   string
-	PayerName string
-	PayeeID   string
-	PayeeName string
-	Amount    int64
-	Currency  string
-	Date      time.Time
-	Confirmed bool
+	ID           string
+	SplitID      string
+	GroupID      string
+	PayerID      string
+	PayerName    string
+	PayeeID      string
+	PayeeName    string
+	Amount       int64
+	Currency     string
+	Date         time.Time
+	Confirmed    bool
+	Status       string
+	StatusReason string
+	SettleUpID   string
+}
+
+type SettlementStatusChangeInput struct {
+	Reason string
+}
+
+type SettlementEventResult struct {
+	ID         string
+	ActorID    string
+	ActorName  string
+	FromStatus string
+	ToStatus   string
+	Reason     string
+	CreatedAt  time.Time
+}
+
+type SettlementEventListResult struct {
+	SettlementID string
+	Events       []SettlementEventResult
+}
+
+type SettleUpInput struct {
+	FromUserID string
+	ToUserID   string
+	Amount     int64
+	Currency   string
+}
+
+type SettleUpResult struct {
+	ID          string
+	GroupID     string
+	PayerID     string
+	PayeeID     string
+	Amount      int64
+	Currency    string
+	Confirmed   bool
+	Settlements []SettlementResult
 }
 
 type SettlementListResult struct {
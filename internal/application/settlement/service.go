@@ -10,6 +10,7 @@ import (
 	Domain "autobill-service/internal/domain"
 	HttpPorts "autobill-service/internal/ports/inbound/http"
 	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	Errors "autobill-service/pkg/errors"
 	Helpers "autobill-service/pkg/helpers"
 	Logger "autobill-service/pkg/logger"
@@ -20,12 +21,18 @@ import (
 type SettlementService struct {
 	repo      RepositoryPorts.SettlementRepositoryPort
 	splitRepo RepositoryPorts.SplitRepositoryPort
+	groupRepo RepositoryPorts.GroupRepositoryPort
+	userRepo  RepositoryPorts.UserRepositoryPort
+	events    EventPorts.EventPublisher
 }
 
-func CreateSettlementService(repo RepositoryPorts.SettlementRepositoryPort, splitRepo RepositoryPorts.SplitRepositoryPort) HttpPorts.SettlementUseCase {
+func CreateSettlementService(repo RepositoryPorts.SettlementRepositoryPort, splitRepo RepositoryPorts.SplitRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort, userRepo RepositoryPorts.UserRepositoryPort, events EventPorts.EventPublisher) HttpPorts.SettlementUseCase {
 	return &SettlementService{
 		repo:      repo,
 		splitRepo: splitRepo,
+		groupRepo: groupRepo,
+		userRepo:  userRepo,
+		events:    events,
 	}
 }
 
@@ -42,11 +49,6 @@ func (s *SettlementService) CreateSettlement(ctx context.Context, userId uuid.UU
 		}
 	}
 
-	splitUUID, err := Helpers.ParseUUID(input.SplitID)
-	if err != nil {
-		return nil, err
-	}
-
 	payeeUUID, err := Helpers.ParseUUID(input.PayeeID)
 	if err != nil {
 		return nil, err
@@ -56,11 +58,24 @@ func (s *SettlementService) CreateSettlement(ctx context.Context, userId uuid.UU
 		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidCurrency)
 	}
 
-	if input.Amount <= 0 {
+	if !Domain.IsValidAmount(input.Amount, Domain.Currency(input.Currency)) {
 		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSettlementAmount)
 	}
 
-	split, splitErr := s.splitRepo.GetSplitById(ctx, splitUUID)
+	if input.SplitID == "" {
+		return s.createDirectSettlement(ctx, userId, payeeUUID, input)
+	}
+
+	if input.GroupID != "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementTargetConflict)
+	}
+
+	splitUUID, err := Helpers.ParseUUID(input.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	split, splitErr := s.splitRepo.GetSplitWithParticipants(ctx, splitUUID)
 	if splitErr != nil {
 		return nil, splitErr
 	}
@@ -69,8 +84,13 @@ func (s *SettlementService) CreateSettlement(ctx context.Context, userId uuid.UU
 		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrCannotSettleWithSelf)
 	}
 
-	if payeeUUID != split.CreatedByID {
-		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementPayeeMustBeSplitOwner)
+	if !isSplitPayer(split, payeeUUID) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementPayeeMustBePayer)
+	}
+
+	payee, userErr := s.userRepo.FindUserById(ctx, payeeUUID)
+	if userErr != nil {
+		return nil, userErr
 	}
 
 	if Domain.Currency(input.Currency) != split.Currency {
@@ -90,26 +110,39 @@ func (s *SettlementService) CreateSettlement(ctx context.Context, userId uuid.UU
 		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSettlementAmount)
 	}
 
+	settledToPayee, settledErr := s.repo.GetConfirmedSettlementTotal(ctx, splitUUID, userId, payeeUUID)
+	if settledErr != nil {
+		return nil, settledErr
+	}
+	if input.Amount > Domain.OwedToPayer(*payerParticipant, split.Payers, payeeUUID)-settledToPayee {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSettlementAmount)
+	}
+
 	var idempotencyKeyPtr *string
 	if input.IdempotencyKey != "" {
 		idempotencyKeyPtr = &input.IdempotencyKey
 	}
 
 	settlement := &Domain.Settlement{
-		SplitID:        splitUUID,
+		SplitID:        &splitUUID,
 		PayerID:        userId,
 		PayeeID:        payeeUUID,
 		Amount:         input.Amount,
 		Currency:       Domain.Currency(input.Currency),
 		Date:           time.Now(),
 		Confirmed:      false,
+		Status:         createdSettlementStatus(payee),
 		IdempotencyKey: idempotencyKeyPtr,
 	}
 
-	created, dbErr := s.repo.CreateSettlement(ctx, settlement)
+	activity := settlementActivity(userId, Domain.ActivitySettlementCreated, settlement, split.GroupID)
+	activity.After = settlementSummary(settlement, settlement.Status)
+
+	created, dbErr := s.repo.CreateSettlement(ctx, settlement, activity)
 	if dbErr != nil {
 		return nil, dbErr
 	}
+	s.events.Publish(Domain.NewEventFromActivity(settlementCreatedEvent(created.Status), activity))
 
 	Logger.Debug().
 		Str("operation", "CreateSettlement").
@@ -123,6 +156,70 @@ func (s *SettlementService) CreateSettlement(ctx context.Context, userId uuid.UU
 	return s.settlementToDto(created, created.Confirmed), nil
 }
 
+func (s *SettlementService) createDirectSettlement(ctx context.Context, userId, payeeId uuid.UUID, input Dtos.CreateSettlementInput) (*Dtos.SettlementResult, error) {
+	if payeeId == userId {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrCannotSettleWithSelf)
+	}
+
+	var groupId *uuid.UUID
+	if input.GroupID != "" {
+		parsed, err := Helpers.ParseUUID(input.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if _, memberErr := s.groupRepo.GetMembership(ctx, parsed, userId); memberErr != nil {
+			return nil, memberErr
+		}
+		if _, memberErr := s.groupRepo.GetMembership(ctx, parsed, payeeId); memberErr != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNotGroupMember)
+		}
+		groupId = &parsed
+	}
+
+	payee, userErr := s.userRepo.FindUserById(ctx, payeeId)
+	if userErr != nil {
+		return nil, userErr
+	}
+
+	var idempotencyKeyPtr *string
+	if input.IdempotencyKey != "" {
+		idempotencyKeyPtr = &input.IdempotencyKey
+	}
+
+	settlement := &Domain.Settlement{
+		GroupID:        groupId,
+		PayerID:        userId,
+		PayeeID:        payeeId,
+		Amount:         input.Amount,
+		Currency:       Domain.Currency(input.Currency),
+		Date:           time.Now(),
+		Confirmed:      false,
+		Status:         createdSettlementStatus(payee),
+		IdempotencyKey: idempotencyKeyPtr,
+	}
+
+	activity := settlementActivity(userId, Domain.ActivitySettlementCreated, settlement, groupId)
+	activity.After = settlementSummary(settlement, settlement.Status)
+
+	created, dbErr := s.repo.CreateSettlement(ctx, settlement, activity)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	s.events.Publish(Domain.NewEventFromActivity(settlementCreatedEvent(created.Status), activity))
+
+	Logger.Debug().
+		Str("operation", "CreateSettlement").
+		Str("payerId", userId.String()).
+		Str("payeeId", payeeId.String()).
+		Str("settlementId", created.Id.String()).
+		Bool("direct", true).
+		Int64("amount", input.Amount).
+		Str("currency", input.Currency).
+		Msg("Direct settlement created successfully")
+
+	return s.settlementToDto(created, created.Confirmed), nil
+}
+
 func (s *SettlementService) GetPendingSettlements(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.SettlementListResult, error) {
 	offset := pagination.Offset()
 	settlements, total, dbErr := s.repo.GetPendingSettlementsByUserId(ctx, userId, pagination.PageSize, offset)
@@ -174,16 +271,25 @@ func (s *SettlementService) ConfirmSettlement(ctx context.Context, userId, settl
 		return fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
 	}
 
-	isConfirmed, _ := s.repo.IsSettlementConfirmed(ctx, settlementId)
-	if isConfirmed {
-		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementAlreadyConfirmed)
+	if settlement.SettleUpID != nil {
+		return s.ConfirmSettleUp(ctx, userId, *settlement.SettleUpID)
+	}
+
+	if settlement.Status != Domain.SettlementPending {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementNotPending)
 	}
 
-	err := s.repo.ConfirmSettlement(ctx, settlementId)
+	activity, err := s.settlementTransitionActivity(ctx, userId, Domain.ActivitySettlementConfirmed, settlement, Domain.SettlementConfirmed)
 	if err != nil {
 		return err
 	}
 
+	err = s.repo.ConfirmSettlement(ctx, settlementId, activity)
+	if err != nil {
+		return err
+	}
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventSettlementConfirmed, activity))
+
 	Logger.Debug().
 		Str("operation", "ConfirmSettlement").
 		Str("userId", userId.String()).
@@ -208,7 +314,393 @@ func (s *SettlementService) DeleteSettlement(ctx context.Context, userId, settle
 		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrCannotDeleteConfirmedSettlement)
 	}
 
-	return s.repo.DeleteSettlement(ctx, settlementId)
+	if settlement.Status == Domain.SettlementDisputed {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrCannotDeleteDisputedSettlement)
+	}
+
+	if settlement.SettleUpID != nil {
+		settlements, dbErr := s.repo.GetSettlementsBySettleUpId(ctx, *settlement.SettleUpID)
+		if dbErr != nil {
+			return dbErr
+		}
+
+		activity := settleUpActivity(userId, Domain.ActivitySettlementDeleted, *settlement.SettleUpID, settlements)
+		activity.Before = settleUpSummary(settlements)
+		return s.repo.DeleteSettleUp(ctx, *settlement.SettleUpID, activity)
+	}
+
+	groupId, err := s.settlementGroupId(ctx, settlement)
+	if err != nil {
+		return err
+	}
+
+	activity := settlementActivity(userId, Domain.ActivitySettlementDeleted, settlement, groupId)
+	activity.Before = settlementSummary(settlement, settlement.Status)
+	return s.repo.DeleteSettlement(ctx, settlementId, activity)
+}
+
+func (s *SettlementService) RejectSettlement(ctx context.Context, userId, settlementId uuid.UUID, input Dtos.SettlementStatusChangeInput) error {
+	settlement, dbErr := s.repo.GetSettlementById(ctx, settlementId)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	if settlement.PayeeID != userId {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
+	}
+
+	if settlement.Status != Domain.SettlementPending {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementNotPending)
+	}
+
+	activity, err := s.settlementTransitionActivity(ctx, userId, Domain.ActivitySettlementRejected, settlement, Domain.SettlementRejected)
+	if err != nil {
+		return err
+	}
+	activity.After["reason"] = input.Reason
+
+	if err := s.repo.RejectSettlement(ctx, settlementId, userId, input.Reason, activity); err != nil {
+		return err
+	}
+
+	Logger.Debug().
+		Str("operation", "RejectSettlement").
+		Str("userId", userId.String()).
+		Str("settlementId", settlementId.String()).
+		Msg("Settlement rejected successfully")
+
+	return nil
+}
+
+func (s *SettlementService) DisputeSettlement(ctx context.Context, userId, settlementId uuid.UUID, input Dtos.SettlementStatusChangeInput) error {
+	settlement, dbErr := s.repo.GetSettlementById(ctx, settlementId)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	if settlement.PayerID != userId && settlement.PayeeID != userId {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
+	}
+
+	if settlement.Status != Domain.SettlementConfirmed {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementNotConfirmed)
+	}
+
+	activity, err := s.settlementTransitionActivity(ctx, userId, Domain.ActivitySettlementDisputed, settlement, Domain.SettlementDisputed)
+	if err != nil {
+		return err
+	}
+	activity.After["reason"] = input.Reason
+
+	if err := s.repo.DisputeSettlement(ctx, settlementId, userId, input.Reason, activity); err != nil {
+		return err
+	}
+
+	Logger.Debug().
+		Str("operation", "DisputeSettlement").
+		Str("userId", userId.String()).
+		Str("settlementId", settlementId.String()).
+		Msg("Settlement disputed and balances reversed")
+
+	return nil
+}
+
+func (s *SettlementService) GetSettlementEvents(ctx context.Context, userId, settlementId uuid.UUID) (*Dtos.SettlementEventListResult, error) {
+	settlement, dbErr := s.repo.GetSettlementById(ctx, settlementId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	if settlement.PayerID != userId && settlement.PayeeID != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSettlementNotFound)
+	}
+
+	events, dbErr := s.repo.GetSettlementEvents(ctx, settlementId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	results := make([]Dtos.SettlementEventResult, len(events))
+	for i, event := range events {
+		reason := ""
+		if event.Reason != nil {
+			reason = *event.Reason
+		}
+		results[i] = Dtos.SettlementEventResult{
+			ID:         event.Id.String(),
+			ActorID:    event.ActorID.String(),
+			ActorName:  event.Actor.Name,
+			FromStatus: string(event.FromStatus),
+			ToStatus:   string(event.ToStatus),
+			Reason:     reason,
+			CreatedAt:  event.CreatedAt,
+		}
+	}
+
+	return &Dtos.SettlementEventListResult{
+		SettlementID: settlementId.String(),
+		Events:       results,
+	}, nil
+}
+
+func (s *SettlementService) SettleUp(ctx context.Context, userId, groupId uuid.UUID, input Dtos.SettleUpInput) (*Dtos.SettleUpResult, error) {
+	fromUUID, err := Helpers.ParseUUID(input.FromUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	toUUID, err := Helpers.ParseUUID(input.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromUUID != userId {
+		return nil, fiber.NewError(fiber.StatusForbidden, Errors.ErrSettleUpPayerMismatch)
+	}
+
+	if toUUID == userId {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrCannotSettleWithSelf)
+	}
+
+	if !Domain.IsValidCurrency(input.Currency) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidCurrency)
+	}
+
+	currency := Domain.Currency(input.Currency)
+	if !Domain.IsValidAmount(input.Amount, currency) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSettlementAmount)
+	}
+
+	if _, memberErr := s.groupRepo.GetMembership(ctx, groupId, userId); memberErr != nil {
+		return nil, memberErr
+	}
+
+	payeeMembership, memberErr := s.groupRepo.GetMembership(ctx, groupId, toUUID)
+	if memberErr != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNotGroupMember)
+	}
+
+	participants, dbErr := s.splitRepo.GetOutstandingParticipantsInGroup(ctx, groupId, userId, toUUID, currency)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	splitIds := make([]uuid.UUID, len(participants))
+	for i, p := range participants {
+		splitIds[i] = p.SplitID
+	}
+
+	pending, dbErr := s.repo.GetPendingSettlementTotalsBySplit(ctx, userId, splitIds)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	toPayee, dbErr := s.repo.GetOpenSettlementTotalsToPayeeBySplit(ctx, userId, toUUID, splitIds)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	settleUpId := uuid.New()
+	now := time.Now()
+	allocations, remaining := allocateSettleUp(participants, toUUID, input.Amount, pending, toPayee)
+
+	settlements := make([]Domain.Settlement, len(allocations))
+	for i, allocation := range allocations {
+		settlements[i] = Domain.Settlement{
+			SplitID:    &allocation.splitId,
+			PayerID:    userId,
+			PayeeID:    toUUID,
+			Amount:     allocation.amount,
+			Currency:   currency,
+			Date:       now,
+			Confirmed:  false,
+			Status:     createdSettlementStatus(&payeeMembership.User),
+			SettleUpID: &settleUpId,
+		}
+	}
+
+	if remaining > 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettleUpExceedsOutstanding)
+	}
+
+	activity := settleUpActivity(userId, Domain.ActivitySettlementCreated, settleUpId, settlements)
+	activity.GroupID = &groupId
+	activity.After = settleUpSummary(settlements)
+
+	created, dbErr := s.repo.CreateSettlements(ctx, settlements, activity)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	s.events.Publish(Domain.NewEventFromActivity(settlementCreatedEvent(created[0].Status), activity))
+
+	Logger.Debug().
+		Str("operation", "SettleUp").
+		Str("groupId", groupId.String()).
+		Str("payerId", userId.String()).
+		Str("payeeId", toUUID.String()).
+		Str("settleUpId", settleUpId.String()).
+		Int("settlements", len(created)).
+		Int64("amount", input.Amount).
+		Str("currency", input.Currency).
+		Msg("Settle-up created successfully")
+
+	return s.settleUpToDto(settleUpId, groupId, created), nil
+}
+
+func (s *SettlementService) GetSettleUp(ctx context.Context, userId, settleUpId uuid.UUID) (*Dtos.SettleUpResult, error) {
+	settlements, dbErr := s.repo.GetSettlementsBySettleUpId(ctx, settleUpId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	first := settlements[0]
+	if first.PayerID != userId && first.PayeeID != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSettleUpNotFound)
+	}
+
+	var groupId uuid.UUID
+	if first.Split.GroupID != nil {
+		groupId = *first.Split.GroupID
+	}
+
+	return s.settleUpToDto(settleUpId, groupId, settlements), nil
+}
+
+func (s *SettlementService) ConfirmSettleUp(ctx context.Context, userId, settleUpId uuid.UUID) error {
+	settlements, dbErr := s.repo.GetSettlementsBySettleUpId(ctx, settleUpId)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	if settlements[0].PayeeID != userId {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrSettleUpNotFound)
+	}
+
+	for _, settlement := range settlements {
+		if settlement.Status != Domain.SettlementPending {
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrSettlementNotPending)
+		}
+	}
+
+	activity := settleUpActivity(userId, Domain.ActivitySettlementConfirmed, settleUpId, settlements)
+	activity.Before = settleUpSummary(settlements)
+	confirmed := make([]Domain.Settlement, len(settlements))
+	for i, settlement := range settlements {
+		confirmed[i] = settlement
+		confirmed[i].Status = Domain.SettlementConfirmed
+	}
+	activity.After = settleUpSummary(confirmed)
+
+	if err := s.repo.ConfirmSettleUp(ctx, settleUpId, activity); err != nil {
+		return err
+	}
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventSettlementConfirmed, activity))
+
+	Logger.Debug().
+		Str("operation", "ConfirmSettleUp").
+		Str("userId", userId.String()).
+		Str("settleUpId", settleUpId.String()).
+		Int("settlements", len(settlements)).
+		Msg("Settle-up confirmed successfully")
+
+	return nil
+}
+
+// createdSettlementStatus confirms a payment to a guest as soon as the payer
+// records it, since the guest has no account to confirm it from.
+func createdSettlementStatus(payee *Domain.User) Domain.SettlementStatus {
+	if payee.IsGuest() {
+		return Domain.SettlementConfirmed
+	}
+	return Domain.SettlementPending
+}
+
+func settlementCreatedEvent(status Domain.SettlementStatus) Domain.EventType {
+	if status == Domain.SettlementConfirmed {
+		return Domain.EventSettlementConfirmed
+	}
+	return Domain.EventSettlementPending
+}
+
+func (s *SettlementService) settlementGroupId(ctx context.Context, settlement *Domain.Settlement) (*uuid.UUID, error) {
+	if settlement.IsDirect() {
+		return settlement.GroupID, nil
+	}
+
+	split, err := s.splitRepo.GetSplitById(ctx, *settlement.SplitID)
+	if err != nil {
+		return nil, err
+	}
+	return split.GroupID, nil
+}
+
+func (s *SettlementService) settlementTransitionActivity(ctx context.Context, actorId uuid.UUID, action Domain.ActivityAction, settlement *Domain.Settlement, status Domain.SettlementStatus) (*Domain.ActivityEvent, error) {
+	groupId, err := s.settlementGroupId(ctx, settlement)
+	if err != nil {
+		return nil, err
+	}
+
+	activity := settlementActivity(actorId, action, settlement, groupId)
+	activity.Before = settlementSummary(settlement, settlement.Status)
+	activity.After = settlementSummary(settlement, status)
+	return activity, nil
+}
+
+func settlementActivity(actorId uuid.UUID, action Domain.ActivityAction, settlement *Domain.Settlement, groupId *uuid.UUID) *Domain.ActivityEvent {
+	activity := Domain.NewActivityEvent(actorId, action, Domain.ActivityTargetSettlement, settlement.Id, groupId)
+	activity.AddAudience(settlement.PayerID, settlement.PayeeID)
+	return activity
+}
+
+func settlementSummary(settlement *Domain.Settlement, status Domain.SettlementStatus) Domain.ActivitySummary {
+	return Domain.ActivitySummary{
+		"payer_id": settlement.PayerID.String(),
+		"payee_id": settlement.PayeeID.String(),
+		"amount":   settlement.Amount,
+		"currency": string(settlement.Currency),
+		"status":   string(status),
+	}
+}
+
+func settleUpActivity(actorId uuid.UUID, action Domain.ActivityAction, settleUpId uuid.UUID, settlements []Domain.Settlement) *Domain.ActivityEvent {
+	var groupId *uuid.UUID
+	if len(settlements) > 0 {
+		groupId = settlements[0].Split.GroupID
+	}
+
+	activity := Domain.NewActivityEvent(actorId, action, Domain.ActivityTargetSettleUp, settleUpId, groupId)
+	for _, settlement := range settlements {
+		activity.AddAudience(settlement.PayerID, settlement.PayeeID)
+	}
+	return activity
+}
+
+func settleUpSummary(settlements []Domain.Settlement) Domain.ActivitySummary {
+	var amount int64
+	for _, settlement := range settlements {
+		amount += settlement.Amount
+	}
+
+	summary := Domain.ActivitySummary{
+		"amount":      amount,
+		"settlements": len(settlements),
+	}
+	if len(settlements) > 0 {
+		summary["payer_id"] = settlements[0].PayerID.String()
+		summary["payee_id"] = settlements[0].PayeeID.String()
+		summary["currency"] = string(settlements[0].Currency)
+		summary["status"] = string(settlements[0].Status)
+	}
+	return summary
+}
+
+func isSplitPayer(split *Domain.Split, userId uuid.UUID) bool {
+	for _, payer := range split.Payers {
+		if payer.UserID == userId {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *SettlementService) settlementToDto(settlement *Domain.Settlement, confirmed bool) *Dtos.SettlementResult {
@@ -221,16 +713,90 @@ func (s *SettlementService) settlementToDto(settlement *Domain.Settlement, confi
 		payeeName = settlement.Payee.Name
 	}
 
+	settleUpId := ""
+	if settlement.SettleUpID != nil {
+		settleUpId = settlement.SettleUpID.String()
+	}
+	splitId := ""
+	if settlement.SplitID != nil {
+		splitId = settlement.SplitID.String()
+	}
+	groupId := ""
+	if settlement.GroupID != nil {
+		groupId = settlement.GroupID.String()
+	}
+	statusReason := ""
+	if settlement.StatusReason != nil {
+		statusReason = *settlement.StatusReason
+	}
+
 	return &Dtos.SettlementResult{
-		ID:        settlement.Id.String(),
-		SplitID:   settlement.SplitID.String(),
-		PayerID:   settlement.PayerID.String(),
-		PayerName: payerName,
-		PayeeID:   settlement.PayeeID.String(),
-		PayeeName: payeeName,
-		Amount:    settlement.Amount,
-		Currency:  string(settlement.Currency),
-		Date:      settlement.Date,
-		Confirmed: confirmed,
+		ID:           settlement.Id.String(),
+		SplitID:      splitId,
+		GroupID:      groupId,
+		PayerID:      settlement.PayerID.String(),
+		PayerName:    payerName,
+		PayeeID:      settlement.PayeeID.String(),
+		PayeeName:    payeeName,
+		Amount:       settlement.Amount,
+		Currency:     string(settlement.Currency),
+		Date:         settlement.Date,
+		Confirmed:    confirmed,
+		Status:       string(settlement.Status),
+		StatusReason: statusReason,
+		SettleUpID:   settleUpId,
+	}
+}
+
+func (s *SettlementService) settleUpToDto(settleUpId, groupId uuid.UUID, settlements []Domain.Settlement) *Dtos.SettleUpResult {
+	result := &Dtos.SettleUpResult{
+		ID:          settleUpId.String(),
+		GroupID:     groupId.String(),
+		Confirmed:   true,
+		Settlements: make([]Dtos.SettlementResult, len(settlements)),
+	}
+
+	for i, settlement := range settlements {
+		result.PayerID = settlement.PayerID.String()
+		result.PayeeID = settlement.PayeeID.String()
+		result.Currency = string(settlement.Currency)
+		result.Amount += settlement.Amount
+		result.Confirmed = result.Confirmed && settlement.Confirmed
+		result.Settlements[i] = *s.settlementToDto(&settlement, settlement.Confirmed)
+	}
+
+	return result
+}
+
+type settleUpAllocation struct {
+	splitId uuid.UUID
+	amount  int64
+}
+
+// allocateSettleUp spreads amount over the participants' splits oldest first.
+// Each split takes at most what is left of the participant's share and of the
+// part of it owed to payeeId, after the pending settlements of the share and
+// the pending and confirmed ones to payeeId. It returns what could not be
+// allocated.
+func allocateSettleUp(participants []Domain.SplitParticipant, payeeId uuid.UUID, amount int64, pending, toPayee map[uuid.UUID]int64) ([]settleUpAllocation, int64) {
+	var allocations []settleUpAllocation
+	remaining := amount
+	for _, p := range participants {
+		if remaining == 0 {
+			break
+		}
+
+		available := min(
+			p.ShareAmount-p.SettledAmount-pending[p.SplitID],
+			Domain.OwedToPayer(p, p.Split.Payers, payeeId)-toPayee[p.SplitID],
+		)
+		if available <= 0 {
+			continue
+		}
+
+		allocated := min(available, remaining)
+		allocations = append(allocations, settleUpAllocation{splitId: p.SplitID, amount: allocated})
+		remaining -= allocated
 	}
+	return allocations, remaining
 }
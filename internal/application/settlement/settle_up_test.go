@@ -0,0 +1,58 @@
+package SettlementApplication
+
+import (
+	"testing"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+func TestAllocateSettleUpOnlyUsesSplitsThePayeePaid(t *testing.T) {
+	debtor, alice, bob := uuid.New(), uuid.New(), uuid.New()
+
+	// Alice and Bob each paid half of the first split; only Bob paid the
+	// second one.
+	shared := uuid.New()
+	bobOnly := uuid.New()
+	participants := []Domain.SplitParticipant{
+		{
+			SplitID:     shared,
+			UserID:      debtor,
+			ShareAmount: 3000,
+			Split: Domain.Split{Payers: []Domain.SplitPayer{
+				{UserID: alice, PaidAmount: 4500},
+				{UserID: bob, PaidAmount: 4500},
+			}},
+		},
+		{
+			SplitID:     bobOnly,
+			UserID:      debtor,
+			ShareAmount: 2000,
+			Split: Domain.Split{Payers: []Domain.SplitPayer{
+				{UserID: bob, PaidAmount: 6000},
+			}},
+		},
+	}
+
+	allocations, remaining := allocateSettleUp(participants, alice, 2000, nil, nil)
+	if remaining != 500 {
+		t.Fatalf("remaining = %d, want 500", remaining)
+	}
+	if len(allocations) != 1 || allocations[0].splitId != shared || allocations[0].amount != 1500 {
+		t.Fatalf("allocations = %+v, want 1500 on the shared split", allocations)
+	}
+
+	// A pending settlement to Alice leaves less of her portion; one to Bob on
+	// the same share does not, as long as the share itself is not used up.
+	allocations, remaining = allocateSettleUp(participants, alice, 1500,
+		map[uuid.UUID]int64{shared: 1000}, map[uuid.UUID]int64{shared: 1000})
+	if remaining != 1000 || len(allocations) != 1 || allocations[0].amount != 500 {
+		t.Fatalf("allocations = %+v, remaining = %d, want 500 allocated", allocations, remaining)
+	}
+
+	allocations, remaining = allocateSettleUp(participants, bob, 3500, nil, nil)
+	if remaining != 0 || len(allocations) != 2 || allocations[0].amount != 1500 || allocations[1].amount != 2000 {
+		t.Fatalf("allocations = %+v, remaining = %d, want 1500 then 2000", allocations, remaining)
+	}
+}
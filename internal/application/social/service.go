@@ -9,6 +9,7 @@ import (
 	Domain "autobill-service/internal/domain"
 	HttpPorts "autobill-service/internal/ports/inbound/http"
 	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	Errors "autobill-service/pkg/errors"
 	Helpers "autobill-service/pkg/helpers"
 	Logger "autobill-service/pkg/logger"
@@ -17,11 +18,15 @@ import (
 )
 
 type SocialService struct {
-	db RepositoryPorts.SocialRepositoryPort
+	db     RepositoryPorts.SocialRepositoryPort
+	events EventPorts.EventPublisher
 }
 
-func CreateSocialService(db RepositoryPorts.SocialRepositoryPort) HttpPorts.SocialUseCase {
-	return &SocialService{db: db}
+func CreateSocialService(db RepositoryPorts.SocialRepositoryPort, events EventPorts.EventPublisher) HttpPorts.SocialUseCase {
+	return &SocialService{
+		db:     db,
+		events: events,
+	}
 }
 
 func (s *SocialService) GetFriendRequestsList(ctx context.Context, userID uuid.UUID, requestType Dtos.RequestType, pagination Helpers.PaginationParams) (*Dtos.FriendRequestListResult, error) {
@@ -102,10 +107,15 @@ func (s *SocialService) SendFriendRequest(ctx context.Context, senderId, receive
 		idempotencyKeyPtr = &idempotencyKey
 	}
 
-	request, err := s.db.CreateFriendRequest(ctx, senderId, receiverId, idempotencyKeyPtr)
+	activity := Domain.NewActivityEvent(senderId, Domain.ActivityFriendRequestSent, Domain.ActivityTargetFriendRequest, uuid.Nil, nil)
+	activity.AddAudience(receiverId)
+	activity.After = Domain.ActivitySummary{"status": string(Domain.FriendPending)}
+
+	request, err := s.db.CreateFriendRequest(ctx, senderId, receiverId, idempotencyKeyPtr, activity)
 	if err != nil {
 		return nil, err
 	}
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventFriendRequest, activity))
 
 	Logger.Debug().
 		Str("operation", "SendFriendRequest").
@@ -118,15 +128,33 @@ func (s *SocialService) SendFriendRequest(ctx context.Context, senderId, receive
 }
 
 func (s *SocialService) AcceptFriendRequest(ctx context.Context, senderId, requestId uuid.UUID) error {
-	return s.db.AcceptFriendRequest(ctx, senderId, requestId)
+	activity := friendRequestActivity(senderId, requestId, Domain.ActivityFriendRequestAccepted, Domain.FriendAccepted)
+	if err := s.db.AcceptFriendRequest(ctx, senderId, requestId, activity); err != nil {
+		return err
+	}
+
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventFriendRequest, activity))
+	return nil
 }
 
 func (s *SocialService) RejectFriendRequest(ctx context.Context, senderId, requestId uuid.UUID) error {
-	return s.db.RejectFriendRequest(ctx, senderId, requestId)
+	activity := friendRequestActivity(senderId, requestId, Domain.ActivityFriendRequestRejected, Domain.FriendRejected)
+	if err := s.db.RejectFriendRequest(ctx, senderId, requestId, activity); err != nil {
+		return err
+	}
+
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventFriendRequest, activity))
+	return nil
 }
 
 func (s *SocialService) CancelFriendRequest(ctx context.Context, senderId, requestId uuid.UUID) error {
-	return s.db.CancelFriendRequest(ctx, senderId, requestId)
+	activity := friendRequestActivity(senderId, requestId, Domain.ActivityFriendRequestCancelled, Domain.FriendRejected)
+	if err := s.db.CancelFriendRequest(ctx, senderId, requestId, activity); err != nil {
+		return err
+	}
+
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventFriendRequest, activity))
+	return nil
 }
 
 func (s *SocialService) GetFriendsList(ctx context.Context, userID uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.FriendsListResult, error) {
@@ -156,5 +184,15 @@ func (s *SocialService) GetFriendsList(ctx context.Context, userID uuid.UUID, pa
 }
 
 func (s *SocialService) RemoveFriend(ctx context.Context, userId, friendId uuid.UUID) error {
-	return s.db.RemoveFriend(ctx, userId, friendId)
+	activity := Domain.NewActivityEvent(userId, Domain.ActivityFriendRemoved, Domain.ActivityTargetFriendship, friendId, nil)
+	activity.AddAudience(friendId)
+
+	return s.db.RemoveFriend(ctx, userId, friendId, activity)
+}
+
+func friendRequestActivity(actorId, requestId uuid.UUID, action Domain.ActivityAction, status Domain.FriendStatus) *Domain.ActivityEvent {
+	activity := Domain.NewActivityEvent(actorId, action, Domain.ActivityTargetFriendRequest, requestId, nil)
+	activity.Before = Domain.ActivitySummary{"status": string(Domain.FriendPending)}
+	activity.After = Domain.ActivitySummary{"status": string(status)}
+	return activity
 }
@@ -0,0 +1,231 @@
+package SplitApplication
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	StoragePorts "autobill-service/internal/ports/outbound/storage"
+	Errors "autobill-service/pkg/errors"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	sniffLength       = 512
+	maxFileNameLength = 255
+	defaultFileName   = "attachment"
+)
+
+type SplitAttachmentService struct {
+	repo         RepositoryPorts.SplitAttachmentRepositoryPort
+	blobs        StoragePorts.BlobStore
+	maxSize      int64
+	splitService *SplitService
+}
+
+func CreateSplitAttachmentService(repo RepositoryPorts.SplitAttachmentRepositoryPort, splitService *SplitService, blobs StoragePorts.BlobStore, maxSize int64) HttpPorts.SplitAttachmentUseCase {
+	if maxSize <= 0 {
+		maxSize = Domain.DefaultMaxAttachmentSize
+	}
+	return &SplitAttachmentService{
+		repo:         repo,
+		blobs:        blobs,
+		maxSize:      maxSize,
+		splitService: splitService,
+	}
+}
+
+// UploadAttachment stores the file before its row so that a listed attachment
+// can always be downloaded. The type is sniffed from the content; whatever the
+// client declared is ignored.
+func (s *SplitAttachmentService) UploadAttachment(ctx context.Context, userId, splitId uuid.UUID, input Dtos.UploadAttachmentInput) (*Dtos.AttachmentResult, error) {
+	if input.Size <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrAttachmentMissing)
+	}
+	if input.Size > s.maxSize {
+		return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, Errors.ErrAttachmentTooLarge)
+	}
+
+	if _, err := s.authorizedSplit(ctx, userId, splitId); err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, sniffLength)
+	n, readErr := io.ReadFull(input.Content, head)
+	if readErr != nil && readErr != io.ErrUnexpectedEOF && readErr != io.EOF {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrAttachmentMissing)
+	}
+	head = head[:n]
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if !Domain.IsAllowedAttachmentType(contentType) {
+		return nil, fiber.NewError(fiber.StatusUnsupportedMediaType, Errors.ErrUnsupportedAttachmentType)
+	}
+
+	attachment := &Domain.SplitAttachment{
+		SplitID:      splitId,
+		UploadedByID: userId,
+		FileName:     cleanFileName(input.FileName),
+		ContentType:  contentType,
+		Size:         input.Size,
+	}
+	attachment.Id = uuid.New()
+	attachment.StorageKey = Domain.AttachmentStorageKey(splitId, attachment.Id)
+
+	hash := sha256.New()
+	content := io.TeeReader(io.MultiReader(bytes.NewReader(head), input.Content), hash)
+	if err := s.blobs.Put(ctx, attachment.StorageKey, content, input.Size, contentType); err != nil {
+		Logger.Error().Err(err).Str("splitId", splitId.String()).Msg("Failed to store attachment")
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrStorageFailure)
+	}
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	created, dbErr := s.repo.CreateAttachment(ctx, attachment, Domain.MaxAttachmentsPerSplit)
+	if dbErr != nil {
+		s.deleteBlob(ctx, attachment.StorageKey)
+		return nil, dbErr
+	}
+
+	Logger.Debug().
+		Str("operation", "UploadAttachment").
+		Str("userId", userId.String()).
+		Str("splitId", splitId.String()).
+		Str("attachmentId", created.Id.String()).
+		Str("contentType", contentType).
+		Int64("size", created.Size).
+		Msg("Split attachment uploaded")
+
+	return attachmentToDto(created), nil
+}
+
+func (s *SplitAttachmentService) GetAttachments(ctx context.Context, userId, splitId uuid.UUID) (*Dtos.AttachmentListResult, error) {
+	if _, err := s.authorizedSplit(ctx, userId, splitId); err != nil {
+		return nil, err
+	}
+
+	attachments, dbErr := s.repo.GetAttachments(ctx, splitId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	results := make([]Dtos.AttachmentResult, len(attachments))
+	for i := range attachments {
+		results[i] = *attachmentToDto(&attachments[i])
+	}
+	return &Dtos.AttachmentListResult{Attachments: results}, nil
+}
+
+func (s *SplitAttachmentService) GetAttachmentContent(ctx context.Context, userId, splitId, attachmentId uuid.UUID) (*Dtos.AttachmentContent, error) {
+	if _, err := s.authorizedSplit(ctx, userId, splitId); err != nil {
+		return nil, err
+	}
+
+	attachment, dbErr := s.repo.GetAttachmentById(ctx, splitId, attachmentId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	content, err := s.blobs.Get(ctx, attachment.StorageKey)
+	if errors.Is(err, StoragePorts.ErrBlobNotFound) {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrAttachmentNotFound)
+	}
+	if err != nil {
+		Logger.Error().Err(err).Str("attachmentId", attachmentId.String()).Msg("Failed to read attachment")
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrStorageFailure)
+	}
+
+	return &Dtos.AttachmentContent{
+		Attachment: *attachmentToDto(attachment),
+		Content:    content,
+	}, nil
+}
+
+func (s *SplitAttachmentService) DeleteAttachment(ctx context.Context, userId, splitId, attachmentId uuid.UUID) error {
+	split, err := s.authorizedSplit(ctx, userId, splitId)
+	if err != nil {
+		return err
+	}
+
+	attachment, dbErr := s.repo.GetAttachmentById(ctx, splitId, attachmentId)
+	if dbErr != nil {
+		return dbErr
+	}
+	if attachment.UploadedByID != userId && split.CreatedByID != userId {
+		return fiber.NewError(fiber.StatusForbidden, Errors.ErrNotAllowedToDeleteAttachment)
+	}
+
+	if err := s.repo.DeleteAttachment(ctx, splitId, attachmentId); err != nil {
+		return err
+	}
+	s.deleteBlob(ctx, attachment.StorageKey)
+
+	Logger.Debug().
+		Str("operation", "DeleteAttachment").
+		Str("userId", userId.String()).
+		Str("splitId", splitId.String()).
+		Str("attachmentId", attachmentId.String()).
+		Msg("Split attachment deleted")
+
+	return nil
+}
+
+func (s *SplitAttachmentService) authorizedSplit(ctx context.Context, userId, splitId uuid.UUID) (*Domain.Split, error) {
+	split, dbErr := s.splitService.repo.GetSplitWithParticipants(ctx, splitId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	if !s.splitService.isUserAuthorizedForSplit(ctx, split, userId) {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
+	}
+	return split, nil
+}
+
+// deleteBlob is best effort: a leftover blob is never listed, so failing here
+// only costs storage.
+func (s *SplitAttachmentService) deleteBlob(ctx context.Context, key string) {
+	if err := s.blobs.Delete(ctx, key); err != nil {
+		Logger.Error().Err(err).Str("storageKey", key).Msg("Failed to delete attachment blob")
+	}
+}
+
+func cleanFileName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return defaultFileName
+	}
+	for len(name) > maxFileNameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
+
+func attachmentToDto(attachment *Domain.SplitAttachment) *Dtos.AttachmentResult {
+	return &Dtos.AttachmentResult{
+		ID:             attachment.Id.String(),
+		SplitID:        attachment.SplitID.String(),
+		FileName:       attachment.FileName,
+		ContentType:    attachment.ContentType,
+		Size:           attachment.Size,
+		Checksum:       attachment.Checksum,
+		UploadedByID:   attachment.UploadedByID.String(),
+		UploadedByName: attachment.UploadedBy.Name,
+		CreatedAt:      attachment.CreatedAt,
+	}
+}
@@ -0,0 +1,73 @@
+package SplitApplication
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	CommentApplication "autobill-service/internal/application/comment"
+	Domain "autobill-service/internal/domain"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// SplitThreadResolver opens the comment thread of a split to everyone who can
+// see the split.
+type SplitThreadResolver struct {
+	splitService *SplitService
+}
+
+func CreateSplitThreadResolver(splitService *SplitService) CommentApplication.ThreadResolver {
+	return &SplitThreadResolver{splitService: splitService}
+}
+
+func (r *SplitThreadResolver) ResolveThread(ctx context.Context, userId, splitId uuid.UUID) (*Domain.CommentThread, error) {
+	split, dbErr := r.splitService.repo.GetSplitWithParticipants(ctx, splitId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	if !r.splitService.isUserAuthorizedForSplit(ctx, split, userId) {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
+	}
+
+	thread := &Domain.CommentThread{
+		TargetType:  Domain.ActivityTargetSplit,
+		TargetID:    split.Id,
+		GroupID:     split.GroupID,
+		Description: split.Description,
+	}
+
+	// Group splits are visible to the whole group; other splits only to the
+	// creator and participants.
+	if split.GroupID != nil {
+		group, err := r.splitService.groupRepo.GetGroupWithMembers(ctx, *split.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		for _, membership := range group.Memberships {
+			thread.Mentionable = appendMentionable(thread.Mentionable, membership.User)
+		}
+		return thread, nil
+	}
+
+	thread.Mentionable = appendMentionable(thread.Mentionable, split.CreatedBy)
+	for _, participant := range split.Participants {
+		thread.Mentionable = appendMentionable(thread.Mentionable, participant.User)
+	}
+	return thread, nil
+}
+
+// appendMentionable skips guests, who cannot read comments, and users already
+// in the list.
+func appendMentionable(users []Domain.User, user Domain.User) []Domain.User {
+	if user.IsGuest() || user.Id == uuid.Nil {
+		return users
+	}
+	for _, existing := range users {
+		if existing.Id == user.Id {
+			return users
+		}
+	}
+	return append(users, user)
+}
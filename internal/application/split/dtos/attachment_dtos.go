@@ -0,0 +1,35 @@
+package SplitApplicationDtos
+
+import (
+	"io"
+	"time"
+)
+
+type UploadAttachmentInput struct {
+	FileName string
+	Size     int64
+	Content  io.Reader
+}
+
+type AttachmentResult struct {
+	ID             string
+	SplitID        string
+	FileName       string
+	ContentType    string
+	Size           int64
+	Checksum       string
+	UploadedByID   string
+	UploadedByName string
+	CreatedAt      time.Time
+}
+
+type AttachmentListResult struct {
+	Attachments []AttachmentResult
+}
+
+// AttachmentContent is an attachment opened for download. The caller must
+// close Content.
+type AttachmentContent struct {
+	Attachment AttachmentResult
+	Content    io.ReadCloser
+}
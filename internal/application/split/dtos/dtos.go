@@ -5,6 +5,20 @@ import "time"
 type ParticipantInput struct {
 	UserID      string
 	ShareAmount int64
+	Percentage  float64
+	Shares      int64
+}
+
+type PayerInput struct {
+	UserID     string
+	PaidAmount int64
+}
+
+type ItemInput struct {
+	Name            string
+	Amount          int64
+	Quantity        int64
+	AssignedUserIDs []string
 }
 
 type CreateSplitInput struct {
@@ -14,17 +28,57 @@ type CreateSplitInput struct {
 	Currency       string
 	Description    string
 	GroupID        string
+	CategoryID     string
 	SimplifyDebts  *bool
 	IdempotencyKey string
+	Payers         []PayerInput
 	Participants   []ParticipantInput
+
+	Items               []ItemInput
+	TaxAmount           int64
+	TipAmount           int64
+	ServiceChargeAmount int64
+
+	// CreatedAt backdates the split. Only imports set it.
+	CreatedAt *time.Time
+}
+
+type UpdateSplitInput struct {
+	Description  *string
+	CategoryID   *string
+	TotalAmount  *int64
+	DivisionType *string
+	Payers       []PayerInput
+	Participants []ParticipantInput
+
+	Items               []ItemInput
+	TaxAmount           *int64
+	TipAmount           *int64
+	ServiceChargeAmount *int64
+}
+
+type ItemResult struct {
+	ID              string
+	Name            string
+	Amount          int64
+	Quantity        int64
+	AssignedUserIDs []string
+}
+
+type PayerResult struct {
+	UserID     string
+	UserName   string
+	PaidAmount int64
+	Currency   string
 }
 
 type ParticipantResult struct {
-	UserID      string
-	UserName    string
-	ShareAmount int64
-	Currency    string
-	IsSettled   bool
+	UserID        string
+	UserName      string
+	ShareAmount   int64
+	SettledAmount int64
+	Currency      string
+	IsSettled     bool
 }
 
 type SplitResult struct {
@@ -35,10 +89,18 @@ type SplitResult struct {
 	Currency      string
 	Description   string
 	GroupID       string
+	CategoryID    string
+	CategoryName  string
 	CreatedByID   string
 	CreatedAt     time.Time
 	SimplifyDebts *bool
+	Payers        []PayerResult
 	Participants  []ParticipantResult
+
+	Items               []ItemResult
+	TaxAmount           int64
+	TipAmount           int64
+	ServiceChargeAmount int64
 }
 
 type SplitListResult struct {
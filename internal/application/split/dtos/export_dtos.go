@@ -0,0 +1,55 @@
+package SplitApplicationDtos
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	ExportRecordShare      = "share"
+	ExportRecordSettlement = "settlement"
+	ExportRecordBalance    = "balance"
+)
+
+// ExportInput restricts an export to a date range. Both dates are inclusive.
+type ExportInput struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// ExportRecord is one line of an export. Shares, settlements and balances
+// share one flat layout so every format has the same columns; fields that do
+// not apply to a record type are nil.
+type ExportRecord struct {
+	RecordType        string
+	Date              *time.Time
+	GroupID           *string
+	GroupName         *string
+	SplitID           *string
+	SettlementID      *string
+	Description       *string
+	Category          *string
+	Currency          string
+	TotalAmount       *int64
+	UserID            string
+	UserName          string
+	UserEmail         string
+	CounterpartyID    *string
+	CounterpartyName  *string
+	CounterpartyEmail *string
+	PaidAmount        *int64
+	ShareAmount       *int64
+	SettledAmount     *int64
+	IsSettled         *bool
+	Amount            *int64
+	Status            *string
+	Confirmed         *bool
+	ConfirmedAt       *time.Time
+}
+
+// ExportStream is an authorized export that has not been read yet. Write
+// emits the records in order and stops at the first error from emit.
+type ExportStream struct {
+	FileName string
+	Write    func(ctx context.Context, emit func(*ExportRecord) error) error
+}
@@ -0,0 +1,52 @@
+package SplitApplicationDtos
+
+import (
+	"io"
+	"time"
+)
+
+const (
+	ImportFormatSplitwise = "splitwise"
+	ImportFormatGeneric   = "generic"
+)
+
+const (
+	ImportRowValid           = "valid"
+	ImportRowInvalid         = "invalid"
+	ImportRowCreated         = "created"
+	ImportRowAlreadyImported = "already_imported"
+	ImportRowFailed          = "failed"
+)
+
+// ImportSplitsInput is a CSV file to import into a group. Members maps the
+// member columns of a Splitwise export to email addresses; columns that are
+// already email addresses need no entry. A dry run validates every row
+// without creating anything.
+type ImportSplitsInput struct {
+	Content io.Reader
+	Members map[string]string
+	DryRun  bool
+}
+
+type ImportRowResult struct {
+	Line        int
+	Status      string
+	Date        *time.Time
+	Description string
+	Amount      int64
+	Currency    string
+	SplitID     *string
+	Errors      []string
+}
+
+type ImportResult struct {
+	Format       string
+	DryRun       bool
+	TotalRows    int
+	ValidRows    int
+	CreatedRows  int
+	ExistingRows int
+	InvalidRows  int
+	FailedRows   int
+	Rows         []ImportRowResult
+}
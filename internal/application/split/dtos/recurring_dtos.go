@@ -0,0 +1,62 @@
+package SplitApplicationDtos
+
+import "time"
+
+type CreateRecurringSplitInput struct {
+	Type           string
+	DivisionType   string
+	TotalAmount    int64
+	Currency       string
+	Description    string
+	GroupID        string
+	SimplifyDebts  *bool
+	Cadence        string
+	CronExpression string
+	StartDate      time.Time
+	EndDate        *time.Time
+	Participants   []ParticipantInput
+}
+
+type UpdateRecurringSplitInput struct {
+	Description  *string
+	TotalAmount  *int64
+	EndDate      *time.Time
+	IsActive     *bool
+	Participants []ParticipantInput
+}
+
+type RecurringParticipantResult struct {
+	UserID      string
+	UserName    string
+	ShareAmount int64
+	Percentage  float64
+	Shares      int64
+}
+
+type RecurringSplitResult struct {
+	ID             string
+	Type           string
+	DivisionType   string
+	TotalAmount    int64
+	Currency       string
+	Description    string
+	GroupID        string
+	CreatedByID    string
+	SimplifyDebts  *bool
+	Cadence        string
+	CronExpression string
+	StartDate      time.Time
+	EndDate        *time.Time
+	NextRunAt      time.Time
+	LastRunAt      *time.Time
+	IsActive       bool
+	CreatedAt      time.Time
+	Participants   []RecurringParticipantResult
+}
+
+type RecurringSplitListResult struct {
+	RecurringSplits []RecurringSplitResult
+	Page            int
+	PageSize        int
+	TotalItems      int64
+}
@@ -0,0 +1,180 @@
+package SplitApplication
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const exportDateLayout = "2006-01-02"
+
+type SplitExportService struct {
+	repo      RepositoryPorts.ExportRepositoryPort
+	groupRepo RepositoryPorts.GroupRepositoryPort
+}
+
+func CreateSplitExportService(repo RepositoryPorts.ExportRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort) HttpPorts.SplitExportUseCase {
+	return &SplitExportService{
+		repo:      repo,
+		groupRepo: groupRepo,
+	}
+}
+
+// ExportUserData exports every split the user created, paid for or has a
+// share in, together with their settlements and current balances.
+func (s *SplitExportService) ExportUserData(ctx context.Context, userId uuid.UUID, input Dtos.ExportInput) (*Dtos.ExportStream, error) {
+	filter, err := toExportFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	filter.UserID = &userId
+
+	Logger.Debug().
+		Str("operation", "ExportUserData").
+		Str("userId", userId.String()).
+		Msg("Starting user export")
+
+	return &Dtos.ExportStream{
+		FileName: "splits-" + time.Now().UTC().Format(exportDateLayout),
+		Write: func(ctx context.Context, emit func(*Dtos.ExportRecord) error) error {
+			return s.writeExport(ctx, filter, emit)
+		},
+	}, nil
+}
+
+func (s *SplitExportService) ExportGroupData(ctx context.Context, userId, groupId uuid.UUID, input Dtos.ExportInput) (*Dtos.ExportStream, error) {
+	if _, err := s.groupRepo.GetMembership(ctx, groupId, userId); err != nil {
+		return nil, err
+	}
+	filter, err := toExportFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	filter.GroupID = &groupId
+
+	Logger.Debug().
+		Str("operation", "ExportGroupData").
+		Str("userId", userId.String()).
+		Str("groupId", groupId.String()).
+		Msg("Starting group export")
+
+	return &Dtos.ExportStream{
+		FileName: "group-" + groupId.String() + "-" + time.Now().UTC().Format(exportDateLayout),
+		Write: func(ctx context.Context, emit func(*Dtos.ExportRecord) error) error {
+			return s.writeExport(ctx, filter, emit)
+		},
+	}, nil
+}
+
+func (s *SplitExportService) writeExport(ctx context.Context, filter RepositoryPorts.ExportFilter, emit func(*Dtos.ExportRecord) error) error {
+	if err := s.repo.StreamSplitShares(ctx, filter, func(row *RepositoryPorts.ExportShareRow) error {
+		return emit(shareToRecord(row))
+	}); err != nil {
+		return err
+	}
+	if err := s.repo.StreamSettlements(ctx, filter, func(row *RepositoryPorts.ExportSettlementRow) error {
+		return emit(settlementToRecord(row))
+	}); err != nil {
+		return err
+	}
+	return s.repo.StreamBalances(ctx, filter, func(row *RepositoryPorts.ExportBalanceRow) error {
+		return emit(balanceToRecord(row))
+	})
+}
+
+func toExportFilter(input Dtos.ExportInput) (RepositoryPorts.ExportFilter, error) {
+	if input.From != nil && input.To != nil && input.From.After(*input.To) {
+		return RepositoryPorts.ExportFilter{}, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidDateRange)
+	}
+
+	filter := RepositoryPorts.ExportFilter{From: input.From}
+	if input.To != nil {
+		end := input.To.AddDate(0, 0, 1)
+		filter.To = &end
+	}
+	return filter, nil
+}
+
+func shareToRecord(row *RepositoryPorts.ExportShareRow) *Dtos.ExportRecord {
+	splitId := row.SplitID.String()
+	description := row.Description
+	return &Dtos.ExportRecord{
+		RecordType:    Dtos.ExportRecordShare,
+		Date:          &row.CreatedAt,
+		GroupID:       optionalId(row.GroupID),
+		GroupName:     row.GroupName,
+		SplitID:       &splitId,
+		Description:   &description,
+		Category:      row.CategoryName,
+		Currency:      string(row.Currency),
+		TotalAmount:   &row.TotalAmount,
+		UserID:        row.UserID.String(),
+		UserName:      row.UserName,
+		UserEmail:     row.UserEmail,
+		PaidAmount:    &row.PaidAmount,
+		ShareAmount:   &row.ShareAmount,
+		SettledAmount: &row.SettledAmount,
+		IsSettled:     &row.IsSettled,
+	}
+}
+
+// settlementToRecord exports the payer as the user and the payee as the
+// counterparty.
+func settlementToRecord(row *RepositoryPorts.ExportSettlementRow) *Dtos.ExportRecord {
+	settlementId := row.SettlementID.String()
+	payeeId := row.PayeeID.String()
+	status := string(row.Status)
+	return &Dtos.ExportRecord{
+		RecordType:        Dtos.ExportRecordSettlement,
+		Date:              &row.Date,
+		GroupID:           optionalId(row.GroupID),
+		GroupName:         row.GroupName,
+		SplitID:           optionalId(row.SplitID),
+		SettlementID:      &settlementId,
+		Description:       row.Description,
+		Currency:          string(row.Currency),
+		UserID:            row.PayerID.String(),
+		UserName:          row.PayerName,
+		UserEmail:         row.PayerEmail,
+		CounterpartyID:    &payeeId,
+		CounterpartyName:  &row.PayeeName,
+		CounterpartyEmail: &row.PayeeEmail,
+		Amount:            &row.Amount,
+		Status:            &status,
+		Confirmed:         &row.Confirmed,
+		ConfirmedAt:       row.ConfirmedAt,
+	}
+}
+
+func balanceToRecord(row *RepositoryPorts.ExportBalanceRow) *Dtos.ExportRecord {
+	return &Dtos.ExportRecord{
+		RecordType:        Dtos.ExportRecordBalance,
+		GroupID:           optionalId(row.GroupID),
+		GroupName:         row.GroupName,
+		Currency:          string(row.Currency),
+		UserID:            row.UserID.String(),
+		UserName:          row.UserName,
+		UserEmail:         row.UserEmail,
+		CounterpartyID:    optionalId(row.CounterpartyID),
+		CounterpartyName:  row.CounterpartyName,
+		CounterpartyEmail: row.CounterpartyEmail,
+		Amount:            &row.NetAmount,
+	}
+}
+
+func optionalId(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
@@ -0,0 +1,390 @@
+package SplitApplication
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+	Domain "autobill-service/internal/domain"
+	Errors "autobill-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+const importDateLayout = "2006-01-02"
+
+var (
+	splitwiseColumns      = []string{"date", "description", "category", "cost", "currency"}
+	genericColumns        = []string{"date", "description", "amount", "currency", "paid_by", "split_with"}
+	splitwiseTotalBalance = "total balance"
+)
+
+type importRecord struct {
+	line   int
+	fields []string
+}
+
+// importEntry is one email of a paid_by or split_with list, with its amount or
+// zero when none was given.
+type importEntry struct {
+	userId uuid.UUID
+	amount int64
+}
+
+type importRow struct {
+	line   int
+	fields []string
+	date   *time.Time
+	input  Dtos.CreateSplitInput
+	errors []string
+}
+
+func (r *importRow) fail(message string) {
+	r.errors = append(r.errors, message)
+}
+
+// importParser turns the records of a file into split inputs for one group.
+// Emails and category names are matched case-insensitively.
+type importParser struct {
+	groupId    uuid.UUID
+	members    map[string]uuid.UUID
+	categories map[string]string
+}
+
+func readImportRecords(content io.Reader) ([]importRecord, error) {
+	reader := csv.NewReader(content)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var records []importRecord
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidImportFile)
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, importRecord{line: line, fields: fields})
+	}
+	if len(records) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidImportFile)
+	}
+
+	// Spreadsheet tools like to start files with a byte order mark.
+	records[0].fields[0] = strings.TrimPrefix(records[0].fields[0], "\ufeff")
+	return records, nil
+}
+
+func detectImportFormat(header []string) string {
+	columns := make(map[string]bool, len(header))
+	for _, column := range header {
+		columns[normalizeColumn(column)] = true
+	}
+
+	if len(header) > len(splitwiseColumns) {
+		matches := true
+		for i, column := range splitwiseColumns {
+			if normalizeColumn(header[i]) != column {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return Dtos.ImportFormatSplitwise
+		}
+	}
+	for _, column := range genericColumns {
+		if !columns[column] {
+			return ""
+		}
+	}
+	return Dtos.ImportFormatGeneric
+}
+
+// parseSplitwise reads a Splitwise export: date, description, category, cost
+// and currency followed by one column per member holding what they paid minus
+// their share. Member columns are matched through mapping, or used as the
+// email when the header already is one.
+func (p *importParser) parseSplitwise(records []importRecord, mapping map[string]string) []*importRow {
+	header := records[0].fields
+	memberIds := make([]*uuid.UUID, len(header))
+	memberErrors := make([]string, len(header))
+	mapped := make(map[uuid.UUID]bool)
+	for i := len(splitwiseColumns); i < len(header); i++ {
+		name := strings.TrimSpace(header[i])
+		email, ok := mapping[name]
+		if !ok && strings.Contains(name, "@") {
+			email, ok = name, true
+		}
+		if !ok {
+			memberErrors[i] = name + ": " + Errors.ErrImportMemberNotMapped
+			continue
+		}
+		id, found := p.members[strings.ToLower(strings.TrimSpace(email))]
+		switch {
+		case !found:
+			memberErrors[i] = name + " (" + email + "): " + Errors.ErrImportMemberNotFound
+		case mapped[id]:
+			memberErrors[i] = name + " (" + email + "): " + Errors.ErrImportDuplicateMember
+		default:
+			mapped[id] = true
+			memberIds[i] = &id
+		}
+	}
+
+	rows := make([]*importRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		if isBlankRecord(record.fields) {
+			continue
+		}
+		if strings.TrimSpace(record.fields[0]) == "" && strings.EqualFold(strings.TrimSpace(field(record.fields, 1)), splitwiseTotalBalance) {
+			continue
+		}
+
+		row := &importRow{line: record.line, fields: record.fields}
+		rows = append(rows, row)
+		if len(record.fields) != len(header) {
+			row.fail(Errors.ErrImportColumnCount)
+			continue
+		}
+
+		currency := p.parseCommon(row, record.fields[0], record.fields[1], record.fields[2], record.fields[3], record.fields[4])
+		if len(row.errors) > 0 {
+			continue
+		}
+
+		var columns []int
+		var nets []int64
+		for i := len(splitwiseColumns); i < len(header); i++ {
+			net, ok := Domain.ParseAmount(defaultZero(record.fields[i]), currency)
+			if !ok {
+				row.fail(strings.TrimSpace(header[i]) + ": " + Errors.ErrInvalidImportAmount)
+				continue
+			}
+			if net == 0 {
+				continue
+			}
+			if memberIds[i] == nil {
+				row.fail(memberErrors[i])
+				continue
+			}
+			columns = append(columns, i)
+			nets = append(nets, net)
+		}
+		if len(row.errors) > 0 {
+			continue
+		}
+
+		paid, shares, ok := Domain.SplitwiseShares(row.input.TotalAmount, nets)
+		if !ok {
+			row.fail(Errors.ErrImportUnbalancedRow)
+			continue
+		}
+		row.input.DivisionType = string(Domain.SplitDivisionCustom)
+		for n, i := range columns {
+			userId := memberIds[i].String()
+			if paid[n] > 0 {
+				row.input.Payers = append(row.input.Payers, Dtos.PayerInput{UserID: userId, PaidAmount: paid[n]})
+			}
+			if shares[n] > 0 {
+				row.input.Participants = append(row.input.Participants, Dtos.ParticipantInput{UserID: userId, ShareAmount: shares[n]})
+			}
+		}
+	}
+	return rows
+}
+
+// parseGeneric reads the documented generic format. paid_by and split_with
+// hold emails separated by semicolons, each optionally followed by
+// "=amount". Without amounts, a single payer paid everything and the amount
+// is divided equally.
+func (p *importParser) parseGeneric(records []importRecord) []*importRow {
+	columns := make(map[string]int)
+	for i, column := range records[0].fields {
+		columns[normalizeColumn(column)] = i
+	}
+	value := func(fields []string, column string) string {
+		i, ok := columns[column]
+		if !ok {
+			return ""
+		}
+		return field(fields, i)
+	}
+
+	rows := make([]*importRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		if isBlankRecord(record.fields) {
+			continue
+		}
+
+		row := &importRow{line: record.line, fields: record.fields}
+		rows = append(rows, row)
+		if len(record.fields) != len(records[0].fields) {
+			row.fail(Errors.ErrImportColumnCount)
+			continue
+		}
+
+		fields := record.fields
+		currency := p.parseCommon(row, value(fields, "date"), value(fields, "description"), value(fields, "category"), value(fields, "amount"), value(fields, "currency"))
+		if len(row.errors) > 0 {
+			continue
+		}
+		total := row.input.TotalAmount
+
+		payers := p.parseEntries(row, value(fields, "paid_by"), currency)
+		participants := p.parseEntries(row, value(fields, "split_with"), currency)
+		if len(row.errors) > 0 {
+			continue
+		}
+		if len(participants) == 0 {
+			row.fail(Errors.ErrNoParticipants)
+			continue
+		}
+
+		if len(payers) == 1 && payers[0].amount == 0 {
+			payers[0].amount = total
+		}
+		if len(payers) == 0 || !entryAmountsMatch(payers, total) {
+			row.fail(Errors.ErrImportPayerAmounts)
+			continue
+		}
+		for _, payer := range payers {
+			row.input.Payers = append(row.input.Payers, Dtos.PayerInput{UserID: payer.userId.String(), PaidAmount: payer.amount})
+		}
+
+		custom := 0
+		for _, participant := range participants {
+			if participant.amount != 0 {
+				custom++
+			}
+		}
+		if custom > 0 && custom < len(participants) {
+			row.fail(Errors.ErrImportMixedShares)
+			continue
+		}
+		if custom > 0 && !entryAmountsMatch(participants, total) {
+			row.fail(Errors.ErrInvalidSplitAmount)
+			continue
+		}
+		row.input.DivisionType = string(Domain.SplitDivisionEqual)
+		if custom > 0 {
+			row.input.DivisionType = string(Domain.SplitDivisionCustom)
+		}
+		for _, participant := range participants {
+			row.input.Participants = append(row.input.Participants, Dtos.ParticipantInput{UserID: participant.userId.String(), ShareAmount: participant.amount})
+		}
+	}
+	return rows
+}
+
+// parseCommon fills in the columns both formats share and returns the row's
+// currency.
+func (p *importParser) parseCommon(row *importRow, date, description, category, amount, currency string) Domain.Currency {
+	row.input.Type = string(Domain.SplitTypeGroup)
+	row.input.GroupID = p.groupId.String()
+	row.input.Description = strings.TrimSpace(description)
+	row.input.CategoryID = p.categories[strings.ToLower(strings.TrimSpace(category))]
+
+	parsed, err := time.Parse(importDateLayout, strings.TrimSpace(date))
+	if err != nil {
+		row.fail(Errors.ErrInvalidImportDate)
+	} else {
+		row.date = &parsed
+		row.input.CreatedAt = &parsed
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(currency))
+	if !Domain.IsValidCurrency(code) {
+		row.fail(Errors.ErrInvalidCurrency)
+		return ""
+	}
+	row.input.Currency = code
+
+	total, ok := Domain.ParseAmount(amount, Domain.Currency(code))
+	if !ok || !Domain.IsValidAmount(total, Domain.Currency(code)) {
+		row.fail(Errors.ErrInvalidImportAmount)
+	}
+	row.input.TotalAmount = total
+	return Domain.Currency(code)
+}
+
+// parseEntries reads "email=amount;email" lists.
+func (p *importParser) parseEntries(row *importRow, value string, currency Domain.Currency) []importEntry {
+	var entries []importEntry
+	seen := make(map[uuid.UUID]bool)
+	for entry := range strings.SplitSeq(value, ";") {
+		email, amount, hasAmount := strings.Cut(strings.TrimSpace(entry), "=")
+		email = strings.ToLower(strings.TrimSpace(email))
+		if email == "" {
+			continue
+		}
+
+		userId, found := p.members[email]
+		if !found {
+			row.fail(email + ": " + Errors.ErrImportMemberNotFound)
+			continue
+		}
+		if seen[userId] {
+			row.fail(email + ": " + Errors.ErrImportDuplicateMember)
+			continue
+		}
+		seen[userId] = true
+
+		parsed := importEntry{userId: userId}
+		if hasAmount {
+			value, ok := Domain.ParseAmount(amount, currency)
+			if !ok || value <= 0 {
+				row.fail(email + ": " + Errors.ErrInvalidImportAmount)
+				continue
+			}
+			parsed.amount = value
+		}
+		entries = append(entries, parsed)
+	}
+	return entries
+}
+
+func entryAmountsMatch(entries []importEntry, total int64) bool {
+	var sum int64
+	for _, entry := range entries {
+		if entry.amount == 0 {
+			return false
+		}
+		sum += entry.amount
+	}
+	return sum == total
+}
+
+func normalizeColumn(column string) string {
+	return strings.ToLower(strings.TrimSpace(column))
+}
+
+func isBlankRecord(fields []string) bool {
+	for _, value := range fields {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func field(fields []string, i int) string {
+	if i >= len(fields) {
+		return ""
+	}
+	return fields[i]
+}
+
+func defaultZero(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "0"
+	}
+	return value
+}
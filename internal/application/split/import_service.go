@@ -0,0 +1,186 @@
+package SplitApplication
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type SplitImportService struct {
+	splitService HttpPorts.SplitUseCase
+	splitRepo    RepositoryPorts.SplitRepositoryPort
+	groupRepo    RepositoryPorts.GroupRepositoryPort
+	categoryRepo RepositoryPorts.CategoryRepositoryPort
+}
+
+func CreateSplitImportService(splitService HttpPorts.SplitUseCase, splitRepo RepositoryPorts.SplitRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort, categoryRepo RepositoryPorts.CategoryRepositoryPort) HttpPorts.SplitImportUseCase {
+	return &SplitImportService{
+		splitService: splitService,
+		splitRepo:    splitRepo,
+		groupRepo:    groupRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// ImportSplits validates every row of a CSV file and, unless it is a dry run,
+// creates a split for each valid one. Rows carry an idempotency key derived
+// from their content, so uploading the same file again after a partial import
+// only creates the rows that are still missing.
+func (s *SplitImportService) ImportSplits(ctx context.Context, userId, groupId uuid.UUID, input Dtos.ImportSplitsInput) (*Dtos.ImportResult, error) {
+	if _, err := s.groupRepo.GetMembership(ctx, groupId, userId); err != nil {
+		return nil, err
+	}
+
+	records, err := readImportRecords(input.Content)
+	if err != nil {
+		return nil, err
+	}
+	format := detectImportFormat(records[0].fields)
+	if format == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrUnrecognizedImportFormat)
+	}
+
+	parser, err := s.newImportParser(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*importRow
+	if format == Dtos.ImportFormatSplitwise {
+		rows = parser.parseSplitwise(records, input.Members)
+	} else {
+		rows = parser.parseGeneric(records)
+	}
+	if len(rows) > Domain.MaxImportRows {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrTooManyImportRows)
+	}
+
+	occurrences := make(map[string]int, len(rows))
+	result := &Dtos.ImportResult{
+		Format:    format,
+		DryRun:    input.DryRun,
+		TotalRows: len(rows),
+		Rows:      make([]Dtos.ImportRowResult, len(rows)),
+	}
+	for i, row := range rows {
+		content := strings.Join(row.fields, "\x00")
+		row.input.IdempotencyKey = Domain.SplitImportKey(groupId, row.fields, occurrences[content])
+		occurrences[content]++
+
+		rowResult := s.importRow(ctx, userId, row, input.DryRun)
+		switch rowResult.Status {
+		case Dtos.ImportRowValid:
+			result.ValidRows++
+		case Dtos.ImportRowCreated:
+			result.CreatedRows++
+		case Dtos.ImportRowAlreadyImported:
+			result.ExistingRows++
+		case Dtos.ImportRowInvalid:
+			result.InvalidRows++
+		case Dtos.ImportRowFailed:
+			result.FailedRows++
+		}
+		result.Rows[i] = rowResult
+	}
+
+	Logger.Debug().
+		Str("operation", "ImportSplits").
+		Str("userId", userId.String()).
+		Str("groupId", groupId.String()).
+		Str("format", format).
+		Bool("dryRun", input.DryRun).
+		Int("rows", result.TotalRows).
+		Int("created", result.CreatedRows).
+		Int("invalid", result.InvalidRows).
+		Int("failed", result.FailedRows).
+		Msg("Split import processed")
+
+	return result, nil
+}
+
+func (s *SplitImportService) importRow(ctx context.Context, userId uuid.UUID, row *importRow, dryRun bool) Dtos.ImportRowResult {
+	result := Dtos.ImportRowResult{
+		Line:        row.line,
+		Date:        row.date,
+		Description: row.input.Description,
+		Amount:      row.input.TotalAmount,
+		Currency:    row.input.Currency,
+		Errors:      row.errors,
+	}
+	if len(row.errors) > 0 {
+		result.Status = Dtos.ImportRowInvalid
+		return result
+	}
+
+	// A row whose split was deleted since counts as imported, so uploading
+	// the file again does not bring it back.
+	if existing, err := s.splitRepo.GetSplitByIdempotencyKeyUnscoped(ctx, row.input.IdempotencyKey); err == nil && existing != nil {
+		result.Status = Dtos.ImportRowAlreadyImported
+		if !existing.DeletedAt.Valid {
+			splitId := existing.Id.String()
+			result.SplitID = &splitId
+		}
+		return result
+	}
+	if dryRun {
+		result.Status = Dtos.ImportRowValid
+		return result
+	}
+
+	created, err := s.splitService.CreateSplit(ctx, userId, row.input)
+	if err != nil {
+		message := Errors.ErrInternal
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) {
+			message = fiberErr.Message
+		}
+		result.Status = Dtos.ImportRowFailed
+		result.Errors = []string{message}
+		return result
+	}
+
+	result.Status = Dtos.ImportRowCreated
+	result.SplitID = &created.ID
+	return result
+}
+
+// newImportParser looks up the group's members by email and the categories
+// available to it by name. Guests without an email cannot be matched.
+func (s *SplitImportService) newImportParser(ctx context.Context, groupId uuid.UUID) (*importParser, error) {
+	group, err := s.groupRepo.GetGroupWithMembers(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.categoryRepo.GetCategories(ctx, &groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &importParser{
+		groupId:    groupId,
+		members:    make(map[string]uuid.UUID, len(group.Memberships)),
+		categories: make(map[string]string, len(categories)),
+	}
+	for _, membership := range group.Memberships {
+		if email := strings.ToLower(strings.TrimSpace(membership.User.Email)); email != "" {
+			parser.members[email] = membership.UserID
+		}
+	}
+	// Group categories come last and take precedence over a system category
+	// of the same name.
+	for _, category := range categories {
+		parser.categories[strings.ToLower(category.Name)] = category.Id.String()
+	}
+	return parser, nil
+}
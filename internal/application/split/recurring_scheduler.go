@@ -0,0 +1,171 @@
+package SplitApplication
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Logger "autobill-service/pkg/logger"
+)
+
+const (
+	recurringSplitBatchSize         = 50
+	maxOccurrencesPerRecurringSplit = 100
+)
+
+type RecurringSplitScheduler struct {
+	repo         RepositoryPorts.RecurringSplitRepositoryPort
+	splitService HttpPorts.SplitUseCase
+	interval     time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func CreateRecurringSplitScheduler(repo RepositoryPorts.RecurringSplitRepositoryPort, splitService HttpPorts.SplitUseCase, interval time.Duration) *RecurringSplitScheduler {
+	return &RecurringSplitScheduler{
+		repo:         repo,
+		splitService: splitService,
+		interval:     interval,
+	}
+}
+
+func (s *RecurringSplitScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		Logger.Info().Dur("interval", s.interval).Msg("Recurring split scheduler started")
+
+		for {
+			s.runDueSplits(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *RecurringSplitScheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+	Logger.Info().Msg("Recurring split scheduler stopped")
+}
+
+func (s *RecurringSplitScheduler) runDueSplits(ctx context.Context) {
+	now := time.Now().UTC()
+
+	recurringSplits, err := s.repo.GetDueRecurringSplits(ctx, now, recurringSplitBatchSize)
+	if err != nil {
+		Logger.Error().Err(err).Msg("Failed to load due recurring splits")
+		return
+	}
+
+	for i := range recurringSplits {
+		if ctx.Err() != nil {
+			return
+		}
+		s.materialize(ctx, &recurringSplits[i], now)
+	}
+}
+
+func (s *RecurringSplitScheduler) materialize(ctx context.Context, recurringSplit *Domain.RecurringSplit, now time.Time) {
+	nextRunAt := recurringSplit.NextRunAt
+	lastRunAt := recurringSplit.LastRunAt
+	isActive := recurringSplit.IsActive
+
+	for occurrences := 0; occurrences < maxOccurrencesPerRecurringSplit && !nextRunAt.After(now); occurrences++ {
+		if recurringSplit.EndDate != nil && nextRunAt.After(*recurringSplit.EndDate) {
+			isActive = false
+			break
+		}
+
+		occurrence := nextRunAt
+		_, err := s.splitService.CreateSplit(ctx, recurringSplit.CreatedByID, recurringSplitOccurrenceInput(recurringSplit, occurrence))
+		if err != nil {
+			var fiberErr *fiber.Error
+			if !errors.As(err, &fiberErr) || fiberErr.Code >= fiber.StatusInternalServerError {
+				Logger.Error().
+					Err(err).
+					Str("recurringSplitId", recurringSplit.Id.String()).
+					Time("occurrence", occurrence).
+					Msg("Failed to materialize recurring split, will retry")
+				break
+			}
+			Logger.Warn().
+				Err(err).
+				Str("recurringSplitId", recurringSplit.Id.String()).
+				Time("occurrence", occurrence).
+				Msg("Skipping recurring split occurrence")
+		} else {
+			lastRunAt = &occurrence
+		}
+
+		next, err := nextOccurrence(recurringSplit, occurrence)
+		if err != nil {
+			Logger.Error().Err(err).Str("recurringSplitId", recurringSplit.Id.String()).Msg("Failed to compute next occurrence")
+			isActive = false
+			break
+		}
+		nextRunAt = next
+	}
+
+	if recurringSplit.EndDate != nil && nextRunAt.After(*recurringSplit.EndDate) {
+		isActive = false
+	}
+
+	if nextRunAt.Equal(recurringSplit.NextRunAt) && isActive == recurringSplit.IsActive {
+		return
+	}
+
+	if err := s.repo.UpdateRecurringSplitSchedule(ctx, recurringSplit.Id, nextRunAt, lastRunAt, isActive); err != nil {
+		Logger.Error().Err(err).Str("recurringSplitId", recurringSplit.Id.String()).Msg("Failed to advance recurring split schedule")
+	}
+}
+
+func recurringSplitOccurrenceInput(recurringSplit *Domain.RecurringSplit, occurrence time.Time) Dtos.CreateSplitInput {
+	participants := make([]Dtos.ParticipantInput, len(recurringSplit.Participants))
+	for i, p := range recurringSplit.Participants {
+		participants[i] = Dtos.ParticipantInput{
+			UserID:      p.UserID.String(),
+			ShareAmount: p.ShareAmount,
+			Percentage:  p.Percentage,
+			Shares:      p.Shares,
+		}
+	}
+
+	groupID := ""
+	if recurringSplit.GroupID != nil {
+		groupID = recurringSplit.GroupID.String()
+	}
+
+	return Dtos.CreateSplitInput{
+		Type:           string(recurringSplit.Type),
+		DivisionType:   string(recurringSplit.DivisionType),
+		TotalAmount:    recurringSplit.TotalAmount,
+		Currency:       string(recurringSplit.Currency),
+		Description:    recurringSplit.Description,
+		GroupID:        groupID,
+		SimplifyDebts:  recurringSplit.SimplifyDebts,
+		IdempotencyKey: occurrenceIdempotencyKey(recurringSplit.Id, occurrence),
+		Participants:   participants,
+	}
+}
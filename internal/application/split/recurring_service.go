@@ -0,0 +1,374 @@
+package SplitApplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Cron "autobill-service/pkg/cron"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type RecurringSplitService struct {
+	repo         RepositoryPorts.RecurringSplitRepositoryPort
+	splitService *SplitService
+}
+
+func CreateRecurringSplitService(repo RepositoryPorts.RecurringSplitRepositoryPort, splitService *SplitService) HttpPorts.RecurringSplitUseCase {
+	return &RecurringSplitService{
+		repo:         repo,
+		splitService: splitService,
+	}
+}
+
+func (s *RecurringSplitService) CreateRecurringSplit(ctx context.Context, userId uuid.UUID, input Dtos.CreateRecurringSplitInput) (*Dtos.RecurringSplitResult, error) {
+	if !Domain.IsValidSplitType(input.Type) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitType)
+	}
+	if !Domain.IsValidSplitDivisionType(input.DivisionType) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidDivisionType)
+	}
+	if Domain.SplitDivisionType(input.DivisionType) == Domain.SplitDivisionItemized {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrRecurringItemizedNotSupported)
+	}
+	if !Domain.IsValidCurrency(input.Currency) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidCurrency)
+	}
+	if !Domain.IsValidAmount(input.TotalAmount, Domain.Currency(input.Currency)) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitAmount)
+	}
+	if !Domain.IsValidRecurrenceCadence(input.Cadence) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRecurrenceCadence)
+	}
+
+	recurringSplit := &Domain.RecurringSplit{
+		Type:           Domain.SplitType(input.Type),
+		DivisionType:   Domain.SplitDivisionType(input.DivisionType),
+		TotalAmount:    input.TotalAmount,
+		Currency:       Domain.Currency(input.Currency),
+		Description:    input.Description,
+		SimplifyDebts:  input.SimplifyDebts,
+		Cadence:        Domain.RecurrenceCadence(input.Cadence),
+		CronExpression: input.CronExpression,
+		StartDate:      input.StartDate.UTC().Truncate(time.Minute),
+		EndDate:        input.EndDate,
+		IsActive:       true,
+		CreatedByID:    userId,
+	}
+	if recurringSplit.Cadence != Domain.RecurrenceCron {
+		recurringSplit.CronExpression = ""
+	}
+	if recurringSplit.EndDate != nil && !recurringSplit.EndDate.After(recurringSplit.StartDate) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRecurrenceDates)
+	}
+
+	firstRun, err := firstOccurrence(recurringSplit)
+	if err != nil {
+		return nil, err
+	}
+	recurringSplit.NextRunAt = firstRun
+
+	if input.GroupID != "" {
+		groupId, err := Helpers.ParseUUID(input.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if _, memberErr := s.splitService.groupRepo.GetMembership(ctx, groupId, userId); memberErr != nil {
+			return nil, memberErr
+		}
+		recurringSplit.GroupID = &groupId
+	} else if recurringSplit.Type == Domain.SplitTypeGroup {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrGroupIdRequired)
+	}
+
+	participants, err := s.buildParticipants(ctx, userId, recurringSplit, input.Participants)
+	if err != nil {
+		return nil, err
+	}
+
+	created, dbErr := s.repo.CreateRecurringSplit(ctx, recurringSplit, participants)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	Logger.Debug().
+		Str("operation", "CreateRecurringSplit").
+		Str("userId", userId.String()).
+		Str("recurringSplitId", created.Id.String()).
+		Str("cadence", input.Cadence).
+		Time("nextRunAt", created.NextRunAt).
+		Msg("Recurring split created successfully")
+
+	return recurringSplitToDto(created), nil
+}
+
+func (s *RecurringSplitService) GetRecurringSplit(ctx context.Context, userId, recurringSplitId uuid.UUID) (*Dtos.RecurringSplitResult, error) {
+	recurringSplit, dbErr := s.repo.GetRecurringSplitById(ctx, recurringSplitId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	if recurringSplit.CreatedByID != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrRecurringSplitNotFound)
+	}
+
+	return recurringSplitToDto(recurringSplit), nil
+}
+
+func (s *RecurringSplitService) GetMyRecurringSplits(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.RecurringSplitListResult, error) {
+	offset := pagination.Offset()
+	recurringSplits, total, dbErr := s.repo.GetRecurringSplitsByUserId(ctx, userId, pagination.PageSize, offset)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	results := make([]Dtos.RecurringSplitResult, len(recurringSplits))
+	for i, recurringSplit := range recurringSplits {
+		results[i] = *recurringSplitToDto(&recurringSplit)
+	}
+
+	return &Dtos.RecurringSplitListResult{
+		RecurringSplits: results,
+		Page:            pagination.Page,
+		PageSize:        pagination.PageSize,
+		TotalItems:      total,
+	}, nil
+}
+
+func (s *RecurringSplitService) UpdateRecurringSplit(ctx context.Context, userId, recurringSplitId uuid.UUID, input Dtos.UpdateRecurringSplitInput) (*Dtos.RecurringSplitResult, error) {
+	recurringSplit, dbErr := s.repo.GetRecurringSplitById(ctx, recurringSplitId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	if recurringSplit.CreatedByID != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrRecurringSplitNotFound)
+	}
+
+	updates := make(map[string]any)
+	if input.Description != nil {
+		updates["description"] = *input.Description
+		recurringSplit.Description = *input.Description
+	}
+	if input.TotalAmount != nil {
+		if !Domain.IsValidAmount(*input.TotalAmount, recurringSplit.Currency) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitAmount)
+		}
+		updates["total_amount"] = *input.TotalAmount
+		recurringSplit.TotalAmount = *input.TotalAmount
+	}
+	if input.EndDate != nil {
+		if !input.EndDate.After(recurringSplit.StartDate) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRecurrenceDates)
+		}
+		updates["end_date"] = *input.EndDate
+		recurringSplit.EndDate = input.EndDate
+	}
+	if input.IsActive != nil {
+		updates["is_active"] = *input.IsActive
+		if *input.IsActive && !recurringSplit.IsActive {
+			nextRunAt, err := skipMissedOccurrences(recurringSplit, time.Now().UTC())
+			if err != nil {
+				return nil, err
+			}
+			updates["next_run_at"] = nextRunAt
+		}
+	}
+
+	if len(updates) == 0 && input.Participants == nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNoFieldsToUpdate)
+	}
+
+	participantInputs := input.Participants
+	if participantInputs == nil && input.TotalAmount != nil {
+		participantInputs = make([]Dtos.ParticipantInput, len(recurringSplit.Participants))
+		for i, p := range recurringSplit.Participants {
+			participantInputs[i] = Dtos.ParticipantInput{
+				UserID:      p.UserID.String(),
+				ShareAmount: p.ShareAmount,
+				Percentage:  p.Percentage,
+				Shares:      p.Shares,
+			}
+		}
+	}
+
+	var participants []Domain.RecurringSplitParticipant
+	if participantInputs != nil {
+		var err error
+		participants, err = s.buildParticipants(ctx, userId, recurringSplit, participantInputs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updated, dbErr := s.repo.UpdateRecurringSplit(ctx, recurringSplitId, updates, participants)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return recurringSplitToDto(updated), nil
+}
+
+func (s *RecurringSplitService) DeleteRecurringSplit(ctx context.Context, userId, recurringSplitId uuid.UUID) error {
+	recurringSplit, dbErr := s.repo.GetRecurringSplitById(ctx, recurringSplitId)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	if recurringSplit.CreatedByID != userId {
+		return fiber.NewError(fiber.StatusNotFound, Errors.ErrRecurringSplitNotFound)
+	}
+
+	return s.repo.DeleteRecurringSplit(ctx, recurringSplitId)
+}
+
+func (s *RecurringSplitService) buildParticipants(ctx context.Context, userId uuid.UUID, recurringSplit *Domain.RecurringSplit, inputs []Dtos.ParticipantInput) ([]Domain.RecurringSplitParticipant, error) {
+	if len(inputs) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNoParticipants)
+	}
+
+	participantUUIDs := make([]uuid.UUID, len(inputs))
+	for i, p := range inputs {
+		parsed, err := Helpers.ParseUUID(p.UserID)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidParticipantId)
+		}
+		participantUUIDs[i] = parsed
+	}
+
+	if recurringSplit.GroupID != nil {
+		creator := []Domain.SplitPayer{{UserID: userId}}
+		if err := s.splitService.validateGroupMembers(ctx, *recurringSplit.GroupID, participantUUIDs, creator); err != nil {
+			return nil, err
+		}
+	}
+
+	template := &Domain.Split{
+		DivisionType: recurringSplit.DivisionType,
+		TotalAmount:  recurringSplit.TotalAmount,
+	}
+	shareAmounts, err := s.splitService.calculateShareAmounts(template, participantUUIDs, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	var shareTotal int64
+	for _, share := range shareAmounts {
+		shareTotal += share
+	}
+	if shareTotal != recurringSplit.TotalAmount {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitAmount)
+	}
+
+	participants := make([]Domain.RecurringSplitParticipant, len(inputs))
+	for i, p := range inputs {
+		participants[i] = Domain.RecurringSplitParticipant{
+			UserID:      participantUUIDs[i],
+			ShareAmount: p.ShareAmount,
+			Percentage:  p.Percentage,
+			Shares:      p.Shares,
+		}
+	}
+
+	return participants, nil
+}
+
+func recurringSplitToDto(recurringSplit *Domain.RecurringSplit) *Dtos.RecurringSplitResult {
+	participants := make([]Dtos.RecurringParticipantResult, len(recurringSplit.Participants))
+	for i, p := range recurringSplit.Participants {
+		participants[i] = Dtos.RecurringParticipantResult{
+			UserID:      p.UserID.String(),
+			UserName:    p.User.Name,
+			ShareAmount: p.ShareAmount,
+			Percentage:  p.Percentage,
+			Shares:      p.Shares,
+		}
+	}
+
+	groupID := ""
+	if recurringSplit.GroupID != nil {
+		groupID = recurringSplit.GroupID.String()
+	}
+
+	return &Dtos.RecurringSplitResult{
+		ID:             recurringSplit.Id.String(),
+		Type:           string(recurringSplit.Type),
+		DivisionType:   string(recurringSplit.DivisionType),
+		TotalAmount:    recurringSplit.TotalAmount,
+		Currency:       string(recurringSplit.Currency),
+		Description:    recurringSplit.Description,
+		GroupID:        groupID,
+		CreatedByID:    recurringSplit.CreatedByID.String(),
+		SimplifyDebts:  recurringSplit.SimplifyDebts,
+		Cadence:        string(recurringSplit.Cadence),
+		CronExpression: recurringSplit.CronExpression,
+		StartDate:      recurringSplit.StartDate,
+		EndDate:        recurringSplit.EndDate,
+		NextRunAt:      recurringSplit.NextRunAt,
+		LastRunAt:      recurringSplit.LastRunAt,
+		IsActive:       recurringSplit.IsActive,
+		CreatedAt:      recurringSplit.CreatedAt,
+		Participants:   participants,
+	}
+}
+
+func firstOccurrence(recurringSplit *Domain.RecurringSplit) (time.Time, error) {
+	if recurringSplit.Cadence != Domain.RecurrenceCron {
+		return recurringSplit.StartDate, nil
+	}
+	return nextOccurrence(recurringSplit, recurringSplit.StartDate.Add(-time.Second))
+}
+
+func nextOccurrence(recurringSplit *Domain.RecurringSplit, current time.Time) (time.Time, error) {
+	current = current.UTC()
+	start := recurringSplit.StartDate.UTC()
+
+	switch recurringSplit.Cadence {
+	case Domain.RecurrenceDaily:
+		return current.AddDate(0, 0, 1), nil
+	case Domain.RecurrenceWeekly:
+		return current.AddDate(0, 0, 7), nil
+	case Domain.RecurrenceMonthly:
+		firstOfNextMonth := time.Date(current.Year(), current.Month()+1, 1, start.Hour(), start.Minute(), 0, 0, time.UTC)
+		lastDay := firstOfNextMonth.AddDate(0, 1, -1).Day()
+		return firstOfNextMonth.AddDate(0, 0, min(start.Day(), lastDay)-1), nil
+	case Domain.RecurrenceCron:
+		schedule, err := Cron.Parse(recurringSplit.CronExpression)
+		if err != nil {
+			return time.Time{}, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidCronExpression)
+		}
+		next := schedule.Next(current)
+		if next.IsZero() {
+			return time.Time{}, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidCronExpression)
+		}
+		return next, nil
+	}
+
+	return time.Time{}, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidRecurrenceCadence)
+}
+
+func skipMissedOccurrences(recurringSplit *Domain.RecurringSplit, now time.Time) (time.Time, error) {
+	nextRunAt := recurringSplit.NextRunAt
+	for nextRunAt.Before(now) {
+		next, err := nextOccurrence(recurringSplit, nextRunAt)
+		if err != nil {
+			return time.Time{}, err
+		}
+		nextRunAt = next
+	}
+	return nextRunAt, nil
+}
+
+func occurrenceIdempotencyKey(recurringSplitId uuid.UUID, occurrence time.Time) string {
+	return fmt.Sprintf("recurring-%s-%s", recurringSplitId, occurrence.UTC().Format("20060102T1504"))
+}
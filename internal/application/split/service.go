@@ -2,13 +2,14 @@ package SplitApplication
 
 import (
 	"context"
+	"math"
 
 	"github.com/gofiber/fiber/v2"
 
 	Dtos "autobill-service/internal/application/split/dtos"
 	Domain "autobill-service/internal/domain"
-	HttpPorts "autobill-service/internal/ports/inbound/http"
 	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	EventPorts "autobill-service/internal/ports/outbound/events"
 	Errors "autobill-service/pkg/errors"
 	Helpers "autobill-service/pkg/helpers"
 	Logger "autobill-service/pkg/logger"
@@ -17,14 +18,18 @@ import (
 )
 
 type SplitService struct {
-	repo      RepositoryPorts.SplitRepositoryPort
-	groupRepo RepositoryPorts.GroupRepositoryPort
+	repo         RepositoryPorts.SplitRepositoryPort
+	groupRepo    RepositoryPorts.GroupRepositoryPort
+	categoryRepo RepositoryPorts.CategoryRepositoryPort
+	events       EventPorts.EventPublisher
 }
 
-func CreateSplitService(repo RepositoryPorts.SplitRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort) HttpPorts.SplitUseCase {
+func CreateSplitService(repo RepositoryPorts.SplitRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort, categoryRepo RepositoryPorts.CategoryRepositoryPort, events EventPorts.EventPublisher) *SplitService {
 	return &SplitService{
-		repo:      repo,
-		groupRepo: groupRepo,
+		repo:         repo,
+		groupRepo:    groupRepo,
+		categoryRepo: categoryRepo,
+		events:       events,
 	}
 }
 
@@ -48,6 +53,9 @@ func (s *SplitService) CreateSplit(ctx context.Context, userId uuid.UUID, input
 	if !Domain.IsValidCurrency(input.Currency) {
 		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidCurrency)
 	}
+	if !Domain.IsValidAmount(input.TotalAmount, Domain.Currency(input.Currency)) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitAmount)
+	}
 
 	if len(input.Participants) == 0 {
 		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNoParticipants)
@@ -62,6 +70,11 @@ func (s *SplitService) CreateSplit(ctx context.Context, userId uuid.UUID, input
 		participantUUIDs[i] = parsed
 	}
 
+	domainPayers, err := s.buildPayers(userId, input.TotalAmount, Domain.Currency(input.Currency), input.Payers)
+	if err != nil {
+		return nil, err
+	}
+
 	var groupId *uuid.UUID
 	if input.GroupID != "" {
 		parsed, err := Helpers.ParseUUID(input.GroupID)
@@ -72,61 +85,78 @@ func (s *SplitService) CreateSplit(ctx context.Context, userId uuid.UUID, input
 		if memberErr != nil {
 			return nil, memberErr
 		}
-		for _, participantID := range participantUUIDs {
-			if _, participantMemberErr := s.groupRepo.GetMembership(ctx, parsed, participantID); participantMemberErr != nil {
-				return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrParticipantNotGroupMember)
-			}
+		if err := s.validateGroupMembers(ctx, parsed, participantUUIDs, domainPayers); err != nil {
+			return nil, err
 		}
 		groupId = &parsed
 	} else if input.Type == string(Domain.SplitTypeGroup) {
 		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrGroupIdRequired)
 	}
 
-	participantCount := len(input.Participants)
-	equalShare := input.TotalAmount / int64(participantCount)
-	remainder := input.TotalAmount % int64(participantCount)
-
-	domainParticipants := make([]Domain.SplitParticipant, len(input.Participants))
-	for i, p := range input.Participants {
-		shareAmount := p.ShareAmount
-		if input.DivisionType == "EQUAL" {
-			shareAmount = equalShare
-			if int64(i) < remainder {
-				shareAmount++
-			}
+	split := &Domain.Split{
+		Type:                Domain.SplitType(input.Type),
+		DivisionType:        Domain.SplitDivisionType(input.DivisionType),
+		TotalAmount:         input.TotalAmount,
+		Currency:            Domain.Currency(input.Currency),
+		Description:         input.Description,
+		GroupID:             groupId,
+		SimplifyDebts:       input.SimplifyDebts,
+		CreatedByID:         userId,
+		TaxAmount:           input.TaxAmount,
+		TipAmount:           input.TipAmount,
+		ServiceChargeAmount: input.ServiceChargeAmount,
+	}
+	if input.IdempotencyKey != "" {
+		split.IdempotencyKey = &input.IdempotencyKey
+	}
+	if input.CreatedAt != nil {
+		split.CreatedAt = *input.CreatedAt
+	}
+	if input.CategoryID != "" {
+		category, err := s.resolveCategory(ctx, input.CategoryID, groupId)
+		if err != nil {
+			return nil, err
 		}
+		split.CategoryID = &category.Id
+		split.Category = category
+	}
+
+	split.Items, err = s.buildItems(input.Items, participantUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	shareAmounts, err := s.calculateShareAmounts(split, participantUUIDs, input.Participants)
+	if err != nil {
+		return nil, err
+	}
 
+	domainParticipants := make([]Domain.SplitParticipant, len(input.Participants))
+	for i := range input.Participants {
 		domainParticipants[i] = Domain.SplitParticipant{
 			UserID:      participantUUIDs[i],
-			ShareAmount: shareAmount,
+			ShareAmount: shareAmounts[i],
 			Currency:    Domain.Currency(input.Currency),
 			IsSettled:   false,
 		}
+		setParticipantWeights(&domainParticipants[i], split.DivisionType, input.Participants[i])
+		domainParticipants[i].SettledAmount = Domain.SelfPaidShare(domainParticipants[i], domainPayers)
+		domainParticipants[i].IsSettled = domainParticipants[i].SettledAmount >= domainParticipants[i].ShareAmount
 	}
 
 	if err := s.validateSplitAmountMatchesShares(input.TotalAmount, domainParticipants); err != nil {
 		return nil, err
 	}
 
-	split := &Domain.Split{
-		Type:          Domain.SplitType(input.Type),
-		DivisionType:  Domain.SplitDivisionType(input.DivisionType),
-		TotalAmount:   input.TotalAmount,
-		Currency:      Domain.Currency(input.Currency),
-		Description:   input.Description,
-		GroupID:       groupId,
-		SimplifyDebts: input.SimplifyDebts,
-		CreatedByID:   userId,
-	}
-	if input.IdempotencyKey != "" {
-		split.IdempotencyKey = &input.IdempotencyKey
-	}
+	activity := splitActivity(userId, Domain.ActivitySplitCreated, split, domainPayers, domainParticipants)
+	activity.After = splitSummary(split, domainPayers, domainParticipants)
 
-	createdSplit, createdParticipants, dbErr := s.repo.CreateSplitWithParticipants(ctx, split, domainParticipants)
+	createdSplit, createdParticipants, dbErr := s.repo.CreateSplitWithParticipants(ctx, split, domainPayers, domainParticipants, activity)
 	if dbErr != nil {
 		return nil, dbErr
 	}
 	createdSplit.Participants = createdParticipants
+	s.events.Publish(Domain.NewEventFromActivity(Domain.EventSplitCreated, activity))
 
 	Logger.Debug().
 		Str("operation", "CreateSplit").
@@ -136,6 +166,7 @@ func (s *SplitService) CreateSplit(ctx context.Context, userId uuid.UUID, input
 		Int64("amount", input.TotalAmount).
 		Str("currency", input.Currency).
 		Int("participants", len(input.Participants)).
+		Int("payers", len(domainPayers)).
 		Msg("Split created successfully")
 
 	return s.splitToDto(createdSplit), nil
@@ -199,6 +230,190 @@ func (s *SplitService) GetMySplits(ctx context.Context, userId uuid.UUID, pagina
 	}, nil
 }
 
+func (s *SplitService) UpdateSplit(ctx context.Context, userId, splitId uuid.UUID, input Dtos.UpdateSplitInput) (*Dtos.SplitResult, error) {
+	split, dbErr := s.repo.GetSplitWithParticipants(ctx, splitId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	if split.CreatedByID != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrSplitNotFound)
+	}
+
+	if input.Description == nil && input.CategoryID == nil && input.TotalAmount == nil && input.DivisionType == nil && input.Payers == nil && input.Participants == nil &&
+		input.Items == nil && input.TaxAmount == nil && input.TipAmount == nil && input.ServiceChargeAmount == nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNoFieldsToUpdate)
+	}
+
+	updated := *split
+	if input.Description != nil {
+		updated.Description = *input.Description
+	}
+	if input.CategoryID != nil {
+		updated.CategoryID, updated.Category = nil, nil
+		if *input.CategoryID != "" {
+			category, err := s.resolveCategory(ctx, *input.CategoryID, split.GroupID)
+			if err != nil {
+				return nil, err
+			}
+			updated.CategoryID = &category.Id
+		}
+	}
+	if input.TotalAmount != nil {
+		if !Domain.IsValidAmount(*input.TotalAmount, split.Currency) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitAmount)
+		}
+		updated.TotalAmount = *input.TotalAmount
+	}
+	if input.DivisionType != nil {
+		if !Domain.IsValidSplitDivisionType(*input.DivisionType) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidDivisionType)
+		}
+		updated.DivisionType = Domain.SplitDivisionType(*input.DivisionType)
+	}
+
+	participantInputs := input.Participants
+	if participantInputs == nil {
+		participantInputs = make([]Dtos.ParticipantInput, len(split.Participants))
+		for i, p := range split.Participants {
+			participantInputs[i] = Dtos.ParticipantInput{
+				UserID:      p.UserID.String(),
+				ShareAmount: p.ShareAmount,
+				Percentage:  p.Percentage,
+				Shares:      p.Shares,
+			}
+		}
+	}
+	if len(participantInputs) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNoParticipants)
+	}
+
+	participantUUIDs := make([]uuid.UUID, len(participantInputs))
+	for i, p := range participantInputs {
+		parsed, err := Helpers.ParseUUID(p.UserID)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidParticipantId)
+		}
+		participantUUIDs[i] = parsed
+	}
+
+	payerInputs := input.Payers
+	if payerInputs == nil {
+		payerInputs = make([]Dtos.PayerInput, len(split.Payers))
+		for i, p := range split.Payers {
+			payerInputs[i] = Dtos.PayerInput{
+				UserID:     p.UserID.String(),
+				PaidAmount: p.PaidAmount,
+			}
+		}
+		if len(payerInputs) == 1 {
+			payerInputs[0].PaidAmount = updated.TotalAmount
+		}
+	}
+
+	domainPayers, err := s.buildPayers(userId, updated.TotalAmount, updated.Currency, payerInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated.GroupID != nil {
+		if err := s.validateGroupMembers(ctx, *updated.GroupID, participantUUIDs, domainPayers); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.TaxAmount != nil {
+		updated.TaxAmount = *input.TaxAmount
+	}
+	if input.TipAmount != nil {
+		updated.TipAmount = *input.TipAmount
+	}
+	if input.ServiceChargeAmount != nil {
+		updated.ServiceChargeAmount = *input.ServiceChargeAmount
+	}
+
+	itemInputs := input.Items
+	if itemInputs == nil {
+		itemInputs = make([]Dtos.ItemInput, len(split.Items))
+		for i, item := range split.Items {
+			itemInputs[i] = Dtos.ItemInput{
+				Name:     item.Name,
+				Amount:   item.Amount,
+				Quantity: item.Quantity,
+			}
+			for _, assignee := range item.Assignees {
+				itemInputs[i].AssignedUserIDs = append(itemInputs[i].AssignedUserIDs, assignee.UserID.String())
+			}
+		}
+	}
+	if updated.DivisionType != Domain.SplitDivisionItemized && input.Items == nil {
+		itemInputs = nil
+		if input.TaxAmount == nil && input.TipAmount == nil && input.ServiceChargeAmount == nil {
+			updated.TaxAmount, updated.TipAmount, updated.ServiceChargeAmount = 0, 0, 0
+		}
+	}
+
+	updated.Items, err = s.buildItems(itemInputs, participantUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shares only need dividing again when something they depend on changed.
+	// Splits created before weights were stored cannot be redivided without
+	// the participants.
+	var shareAmounts []int64
+	if input.Participants == nil && updated.DivisionType == split.DivisionType && updated.TotalAmount == split.TotalAmount &&
+		updated.DivisionType != Domain.SplitDivisionItemized {
+		if len(updated.Items) > 0 || updated.TaxAmount != 0 || updated.TipAmount != 0 || updated.ServiceChargeAmount != 0 {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrItemsRequireItemizedDivision)
+		}
+		shareAmounts = make([]int64, len(participantInputs))
+		for i, p := range participantInputs {
+			shareAmounts[i] = p.ShareAmount
+		}
+	} else {
+		shareAmounts, err = s.calculateShareAmounts(&updated, participantUUIDs, participantInputs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	domainParticipants := make([]Domain.SplitParticipant, len(participantInputs))
+	for i := range participantInputs {
+		domainParticipants[i] = Domain.SplitParticipant{
+			UserID:      participantUUIDs[i],
+			ShareAmount: shareAmounts[i],
+			Currency:    updated.Currency,
+		}
+		setParticipantWeights(&domainParticipants[i], updated.DivisionType, participantInputs[i])
+	}
+
+	if err := s.validateSplitAmountMatchesShares(updated.TotalAmount, domainParticipants); err != nil {
+		return nil, err
+	}
+
+	activity := splitActivity(userId, Domain.ActivitySplitUpdated, &updated, domainPayers, domainParticipants)
+	activity.AddAudience(splitUserIds(split.Payers, split.Participants)...)
+	activity.Before = splitSummary(split, split.Payers, split.Participants)
+	activity.After = splitSummary(&updated, domainPayers, domainParticipants)
+
+	updatedSplit, dbErr := s.repo.UpdateSplitWithParticipants(ctx, &updated, domainPayers, domainParticipants, activity)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	Logger.Debug().
+		Str("operation", "UpdateSplit").
+		Str("userId", userId.String()).
+		Str("splitId", splitId.String()).
+		Int64("amount", updatedSplit.TotalAmount).
+		Int("participants", len(domainParticipants)).
+		Int("payers", len(domainPayers)).
+		Msg("Split updated successfully")
+
+	return s.splitToDto(updatedSplit), nil
+}
+
 func (s *SplitService) ReverseSplit(ctx context.Context, userId, splitId uuid.UUID) (*Dtos.SplitResult, error) {
 	originalSplit, dbErr := s.repo.GetSplitWithParticipants(ctx, splitId)
 	if dbErr != nil {
@@ -217,43 +432,57 @@ func (s *SplitService) ReverseSplit(ctx context.Context, userId, splitId uuid.UU
 		return nil, fiber.NewError(fiber.StatusConflict, Errors.ErrSplitHasPendingSettlements)
 	}
 
-	confirmedTotalsByPayer, err := s.repo.GetConfirmedSettlementTotalsByPayer(ctx, splitId)
+	confirmedTotals, err := s.repo.GetConfirmedSettlementTotals(ctx, splitId)
 	if err != nil {
 		return nil, err
 	}
 
-	for payerID, paidAmount := range confirmedTotalsByPayer {
-		if paidAmount <= 0 || payerID == originalSplit.CreatedByID {
+	for _, confirmedTotal := range confirmedTotals {
+		if confirmedTotal.Total <= 0 || confirmedTotal.PayerID == confirmedTotal.PayeeID {
 			continue
 		}
 
 		reverseSplit := &Domain.Split{
 			Type:          originalSplit.Type,
 			DivisionType:  Domain.SplitDivisionCustom,
-			TotalAmount:   paidAmount,
+			TotalAmount:   confirmedTotal.Total,
 			Currency:      originalSplit.Currency,
 			Description:   "Refund reverse split: " + originalSplit.Description,
 			GroupID:       originalSplit.GroupID,
-			CreatedByID:   payerID,
+			CreatedByID:   confirmedTotal.PayerID,
 			SimplifyDebts: originalSplit.SimplifyDebts,
 		}
 
+		reversePayers := []Domain.SplitPayer{
+			{
+				UserID:     confirmedTotal.PayerID,
+				PaidAmount: confirmedTotal.Total,
+				Currency:   originalSplit.Currency,
+			},
+		}
+
 		reverseParticipants := []Domain.SplitParticipant{
 			{
-				UserID:      originalSplit.CreatedByID,
-				ShareAmount: paidAmount,
+				UserID:      confirmedTotal.PayeeID,
+				ShareAmount: confirmedTotal.Total,
 				Currency:    originalSplit.Currency,
 				IsSettled:   false,
 			},
 		}
 
-		_, _, createErr := s.repo.CreateSplitWithParticipants(ctx, reverseSplit, reverseParticipants)
+		activity := splitActivity(userId, Domain.ActivitySplitCreated, reverseSplit, reversePayers, reverseParticipants)
+		activity.After = splitSummary(reverseSplit, reversePayers, reverseParticipants)
+
+		_, _, createErr := s.repo.CreateSplitWithParticipants(ctx, reverseSplit, reversePayers, reverseParticipants, activity)
 		if createErr != nil {
 			return nil, createErr
 		}
 	}
 
-	err = s.repo.DeleteSplitWithBalanceRollback(ctx, originalSplit, originalSplit.Participants)
+	activity := splitActivity(userId, Domain.ActivitySplitReversed, originalSplit, originalSplit.Payers, originalSplit.Participants)
+	activity.Before = splitSummary(originalSplit, originalSplit.Payers, originalSplit.Participants)
+
+	err = s.repo.DeleteSplitWithBalanceRollback(ctx, originalSplit, originalSplit.Participants, activity)
 	if err != nil {
 		return nil, err
 	}
@@ -266,11 +495,37 @@ func (s *SplitService) splitToDto(split *Domain.Split) *Dtos.SplitResult {
 	for i, p := range split.Participants {
 		userName := p.User.Name
 		participants[i] = Dtos.ParticipantResult{
-			UserID:      p.UserID.String(),
-			UserName:    userName,
-			ShareAmount: p.ShareAmount,
-			Currency:    string(p.Currency),
-			IsSettled:   p.IsSettled,
+			UserID:        p.UserID.String(),
+			UserName:      userName,
+			ShareAmount:   p.ShareAmount,
+			SettledAmount: p.SettledAmount,
+			Currency:      string(p.Currency),
+			IsSettled:     p.IsSettled,
+		}
+	}
+
+	items := make([]Dtos.ItemResult, len(split.Items))
+	for i, item := range split.Items {
+		assignedUserIds := make([]string, len(item.Assignees))
+		for j, assignee := range item.Assignees {
+			assignedUserIds[j] = assignee.UserID.String()
+		}
+		items[i] = Dtos.ItemResult{
+			ID:              item.Id.String(),
+			Name:            item.Name,
+			Amount:          item.Amount,
+			Quantity:        item.Quantity,
+			AssignedUserIDs: assignedUserIds,
+		}
+	}
+
+	payers := make([]Dtos.PayerResult, len(split.Payers))
+	for i, p := range split.Payers {
+		payers[i] = Dtos.PayerResult{
+			UserID:     p.UserID.String(),
+			UserName:   p.User.Name,
+			PaidAmount: p.PaidAmount,
+			Currency:   string(p.Currency),
 		}
 	}
 
@@ -279,6 +534,14 @@ func (s *SplitService) splitToDto(split *Domain.Split) *Dtos.SplitResult {
 		groupIdStr = split.GroupID.String()
 	}
 
+	categoryIdStr, categoryName := "", ""
+	if split.CategoryID != nil {
+		categoryIdStr = split.CategoryID.String()
+	}
+	if split.Category != nil {
+		categoryName = split.Category.Name
+	}
+
 	return &Dtos.SplitResult{
 		ID:            split.Id.String(),
 		Type:          string(split.Type),
@@ -287,10 +550,46 @@ func (s *SplitService) splitToDto(split *Domain.Split) *Dtos.SplitResult {
 		Currency:      string(split.Currency),
 		Description:   split.Description,
 		GroupID:       groupIdStr,
+		CategoryID:    categoryIdStr,
+		CategoryName:  categoryName,
 		CreatedByID:   split.CreatedByID.String(),
 		CreatedAt:     split.CreatedAt,
 		SimplifyDebts: split.SimplifyDebts,
+		Payers:        payers,
 		Participants:  participants,
+
+		Items:               items,
+		TaxAmount:           split.TaxAmount,
+		TipAmount:           split.TipAmount,
+		ServiceChargeAmount: split.ServiceChargeAmount,
+	}
+}
+
+func splitActivity(actorId uuid.UUID, action Domain.ActivityAction, split *Domain.Split, payers []Domain.SplitPayer, participants []Domain.SplitParticipant) *Domain.ActivityEvent {
+	activity := Domain.NewActivityEvent(actorId, action, Domain.ActivityTargetSplit, split.Id, split.GroupID)
+	activity.AddAudience(splitUserIds(payers, participants)...)
+	return activity
+}
+
+func splitUserIds(payers []Domain.SplitPayer, participants []Domain.SplitParticipant) []uuid.UUID {
+	userIds := make([]uuid.UUID, 0, len(payers)+len(participants))
+	for _, p := range payers {
+		userIds = append(userIds, p.UserID)
+	}
+	for _, p := range participants {
+		userIds = append(userIds, p.UserID)
+	}
+	return userIds
+}
+
+func splitSummary(split *Domain.Split, payers []Domain.SplitPayer, participants []Domain.SplitParticipant) Domain.ActivitySummary {
+	return Domain.ActivitySummary{
+		"description":   split.Description,
+		"division_type": string(split.DivisionType),
+		"total_amount":  split.TotalAmount,
+		"currency":      string(split.Currency),
+		"payers":        len(payers),
+		"participants":  len(participants),
 	}
 }
 
@@ -310,6 +609,190 @@ func (s *SplitService) isUserAuthorizedForSplit(ctx context.Context, split *Doma
 	return false
 }
 
+// resolveCategory looks up a category that a split in groupId may use: a
+// system default or one of the group's own.
+func (s *SplitService) resolveCategory(ctx context.Context, categoryId string, groupId *uuid.UUID) (*Domain.Category, error) {
+	parsed, err := Helpers.ParseUUID(categoryId)
+	if err != nil {
+		return nil, err
+	}
+	category, dbErr := s.categoryRepo.GetCategoryById(ctx, parsed)
+	if dbErr != nil || !category.AvailableIn(groupId) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidCategory)
+	}
+	return category, nil
+}
+
+func (s *SplitService) validateGroupMembers(ctx context.Context, groupId uuid.UUID, participantIds []uuid.UUID, payers []Domain.SplitPayer) error {
+	for _, participantID := range participantIds {
+		if _, participantMemberErr := s.groupRepo.GetMembership(ctx, groupId, participantID); participantMemberErr != nil {
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrParticipantNotGroupMember)
+		}
+	}
+	for _, payer := range payers {
+		if _, payerMemberErr := s.groupRepo.GetMembership(ctx, groupId, payer.UserID); payerMemberErr != nil {
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrPayerNotGroupMember)
+		}
+	}
+	return nil
+}
+
+func (s *SplitService) buildPayers(userId uuid.UUID, totalAmount int64, currency Domain.Currency, inputs []Dtos.PayerInput) ([]Domain.SplitPayer, error) {
+	if len(inputs) == 0 {
+		return []Domain.SplitPayer{
+			{
+				UserID:     userId,
+				PaidAmount: totalAmount,
+				Currency:   currency,
+			},
+		}, nil
+	}
+
+	payers := make([]Domain.SplitPayer, len(inputs))
+	seen := make(map[uuid.UUID]bool, len(inputs))
+	var paidTotal int64
+	for i, p := range inputs {
+		parsed, err := Helpers.ParseUUID(p.UserID)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidPayerId)
+		}
+		if seen[parsed] || p.PaidAmount <= 0 {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidPaidAmount)
+		}
+		seen[parsed] = true
+		paidTotal += p.PaidAmount
+
+		payers[i] = Domain.SplitPayer{
+			UserID:     parsed,
+			PaidAmount: p.PaidAmount,
+			Currency:   currency,
+		}
+	}
+
+	if paidTotal != totalAmount {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidPaidAmount)
+	}
+
+	return payers, nil
+}
+
+func (s *SplitService) buildItems(inputs []Dtos.ItemInput, participantIds []uuid.UUID) ([]Domain.SplitItem, error) {
+	isParticipant := make(map[uuid.UUID]bool, len(participantIds))
+	for _, id := range participantIds {
+		isParticipant[id] = true
+	}
+
+	items := make([]Domain.SplitItem, len(inputs))
+	for i, input := range inputs {
+		quantity := input.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+		if input.Name == "" || input.Amount <= 0 || quantity < 0 || len(input.AssignedUserIDs) == 0 {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitItem)
+		}
+
+		items[i] = Domain.SplitItem{
+			Name:      input.Name,
+			Amount:    input.Amount,
+			Quantity:  quantity,
+			Assignees: make([]Domain.SplitItemAssignee, len(input.AssignedUserIDs)),
+		}
+
+		seen := make(map[uuid.UUID]bool, len(input.AssignedUserIDs))
+		for j, assignedUserId := range input.AssignedUserIDs {
+			parsed, err := Helpers.ParseUUID(assignedUserId)
+			if err != nil {
+				return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidParticipantId)
+			}
+			if !isParticipant[parsed] || seen[parsed] {
+				return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrItemAssigneeNotParticipant)
+			}
+			seen[parsed] = true
+			items[i].Assignees[j] = Domain.SplitItemAssignee{UserID: parsed}
+		}
+	}
+
+	return items, nil
+}
+
+func (s *SplitService) calculateShareAmounts(split *Domain.Split, participantIds []uuid.UUID, participants []Dtos.ParticipantInput) ([]int64, error) {
+	if split.DivisionType == Domain.SplitDivisionItemized {
+		return s.calculateItemizedShares(split, participantIds)
+	}
+	if len(split.Items) > 0 || split.TaxAmount != 0 || split.TipAmount != 0 || split.ServiceChargeAmount != 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrItemsRequireItemizedDivision)
+	}
+
+	totalAmount := split.TotalAmount
+	weights := make([]int64, len(participants))
+
+	switch split.DivisionType {
+	case Domain.SplitDivisionEqual:
+		for i := range participants {
+			weights[i] = 1
+		}
+	case Domain.SplitDivisionPercentage:
+		var totalBasisPoints int64
+		for i, p := range participants {
+			basisPoints := int64(math.Round(p.Percentage * 100))
+			if basisPoints <= 0 {
+				return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitPercentages)
+			}
+			weights[i] = basisPoints
+			totalBasisPoints += basisPoints
+		}
+		if totalBasisPoints != 10000 {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitPercentages)
+		}
+	case Domain.SplitDivisionShares:
+		for i, p := range participants {
+			if p.Shares <= 0 {
+				return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitShares)
+			}
+			weights[i] = p.Shares
+		}
+	default:
+		shareAmounts := make([]int64, len(participants))
+		for i, p := range participants {
+			shareAmounts[i] = p.ShareAmount
+		}
+		return shareAmounts, nil
+	}
+
+	return Domain.AllocateByWeight(totalAmount, weights), nil
+}
+
+// setParticipantWeights keeps the percentage or shares a participant's share
+// was divided by, so the split can be divided again when it is edited.
+func setParticipantWeights(participant *Domain.SplitParticipant, divisionType Domain.SplitDivisionType, input Dtos.ParticipantInput) {
+	switch divisionType {
+	case Domain.SplitDivisionPercentage:
+		participant.Percentage = input.Percentage
+	case Domain.SplitDivisionShares:
+		participant.Shares = input.Shares
+	}
+}
+
+func (s *SplitService) calculateItemizedShares(split *Domain.Split, participantIds []uuid.UUID) ([]int64, error) {
+	if len(split.Items) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidSplitItem)
+	}
+	if split.TaxAmount < 0 || split.TipAmount < 0 || split.ServiceChargeAmount < 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidItemizedTotal)
+	}
+
+	itemsTotal := int64(0)
+	for _, item := range split.Items {
+		itemsTotal += item.LineTotal()
+	}
+	if itemsTotal+split.ChargesAmount() != split.TotalAmount {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidItemizedTotal)
+	}
+
+	return Domain.CalculateItemizedShares(split.Items, participantIds, split.ChargesAmount()), nil
+}
+
 func (s *SplitService) validateSplitAmountMatchesShares(totalAmount int64, participants []Domain.SplitParticipant) error {
 	var participantTotal int64
 	for _, p := range participants {
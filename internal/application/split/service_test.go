@@ -0,0 +1,87 @@
+package SplitApplication
+
+import (
+	"context"
+	"testing"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+	Domain "autobill-service/internal/domain"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+
+	"github.com/google/uuid"
+)
+
+// fakeSplitRepository serves a single split. Methods UpdateSplit does not use
+// are left to the embedded nil interface.
+type fakeSplitRepository struct {
+	RepositoryPorts.SplitRepositoryPort
+	split        *Domain.Split
+	participants []Domain.SplitParticipant
+}
+
+func (r *fakeSplitRepository) GetSplitWithParticipants(ctx context.Context, splitId uuid.UUID) (*Domain.Split, error) {
+	split := *r.split
+	return &split, nil
+}
+
+func (r *fakeSplitRepository) UpdateSplitWithParticipants(ctx context.Context, split *Domain.Split, payers []Domain.SplitPayer, participants []Domain.SplitParticipant, activity *Domain.ActivityEvent) (*Domain.Split, error) {
+	r.participants = participants
+	split.Payers, split.Participants = payers, participants
+	return split, nil
+}
+
+func percentageSplit(creator uuid.UUID, participants ...Domain.SplitParticipant) *Domain.Split {
+	return &Domain.Split{
+		BaseModel:    Domain.BaseModel{Id: uuid.New()},
+		Type:         Domain.SplitTypeDirect,
+		DivisionType: Domain.SplitDivisionPercentage,
+		TotalAmount:  1000,
+		Currency:     "USD",
+		Description:  "Dinner",
+		CreatedByID:  creator,
+		Payers:       []Domain.SplitPayer{{UserID: creator, PaidAmount: 1000, Currency: "USD"}},
+		Participants: participants,
+	}
+}
+
+func TestUpdateSplitDescriptionKeepsPercentageShares(t *testing.T) {
+	creator, friend := uuid.New(), uuid.New()
+	// Created before percentages were stored, so only the amounts are known.
+	repo := &fakeSplitRepository{split: percentageSplit(creator,
+		Domain.SplitParticipant{UserID: creator, ShareAmount: 700, Currency: "USD"},
+		Domain.SplitParticipant{UserID: friend, ShareAmount: 300, Currency: "USD"},
+	)}
+	service := &SplitService{repo: repo}
+
+	description := "Team dinner"
+	result, err := service.UpdateSplit(context.Background(), creator, repo.split.Id, Dtos.UpdateSplitInput{Description: &description})
+	if err != nil {
+		t.Fatalf("UpdateSplit: %v", err)
+	}
+	if result.Description != description {
+		t.Errorf("description = %q", result.Description)
+	}
+	if repo.participants[0].ShareAmount != 700 || repo.participants[1].ShareAmount != 300 {
+		t.Errorf("shares = %d/%d, want 700/300", repo.participants[0].ShareAmount, repo.participants[1].ShareAmount)
+	}
+}
+
+func TestUpdateSplitTotalRedividesByStoredPercentages(t *testing.T) {
+	creator, friend := uuid.New(), uuid.New()
+	repo := &fakeSplitRepository{split: percentageSplit(creator,
+		Domain.SplitParticipant{UserID: creator, ShareAmount: 700, Percentage: 70, Currency: "USD"},
+		Domain.SplitParticipant{UserID: friend, ShareAmount: 300, Percentage: 30, Currency: "USD"},
+	)}
+	service := &SplitService{repo: repo}
+
+	total := int64(2000)
+	if _, err := service.UpdateSplit(context.Background(), creator, repo.split.Id, Dtos.UpdateSplitInput{TotalAmount: &total}); err != nil {
+		t.Fatalf("UpdateSplit: %v", err)
+	}
+	if repo.participants[0].ShareAmount != 1400 || repo.participants[1].ShareAmount != 600 {
+		t.Errorf("shares = %d/%d, want 1400/600", repo.participants[0].ShareAmount, repo.participants[1].ShareAmount)
+	}
+	if repo.participants[0].Percentage != 70 || repo.participants[1].Percentage != 30 {
+		t.Errorf("percentages = %v/%v, want 70/30", repo.participants[0].Percentage, repo.participants[1].Percentage)
+	}
+}
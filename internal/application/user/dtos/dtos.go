@@ -14,3 +14,19 @@ type UpdateUserInput struct {
 	Email string
 	Name  string
 }
+
+type NotificationPreferencesResult struct {
+	Frequency   string
+	Splits      bool
+	Settlements bool
+	Reminders   bool
+	Mentions    bool
+}
+
+type UpdateNotificationPreferencesInput struct {
+	Frequency   *string
+	Splits      *bool
+	Settlements *bool
+	Reminders   *bool
+	Mentions    *bool
+}
@@ -58,3 +58,52 @@ func (service *UserService) UpdateUser(ctx context.Context, id uuid.UUID, input
 	}
 	return service.userToDto(user), nil
 }
+
+func preferencesToDto(preferences Domain.NotificationPreferences) *Dtos.NotificationPreferencesResult {
+	return &Dtos.NotificationPreferencesResult{
+		Frequency:   string(preferences.Frequency),
+		Splits:      preferences.Splits,
+		Settlements: preferences.Settlements,
+		Reminders:   preferences.Reminders,
+		Mentions:    preferences.Mentions,
+	}
+}
+
+func (service *UserService) GetNotificationPreferences(ctx context.Context, id uuid.UUID) (*Dtos.NotificationPreferencesResult, error) {
+	user, err := service.db.FindUserById(ctx, id)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrUserNotFound)
+	}
+	return preferencesToDto(user.Notifications), nil
+}
+
+func (service *UserService) UpdateNotificationPreferences(ctx context.Context, id uuid.UUID, input Dtos.UpdateNotificationPreferencesInput) (*Dtos.NotificationPreferencesResult, error) {
+	updates := map[string]any{}
+	if input.Frequency != nil {
+		if !Domain.IsValidNotificationFrequency(*input.Frequency) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidNotificationFrequency)
+		}
+		updates["notify_frequency"] = *input.Frequency
+	}
+	if input.Splits != nil {
+		updates["notify_splits"] = *input.Splits
+	}
+	if input.Settlements != nil {
+		updates["notify_settlements"] = *input.Settlements
+	}
+	if input.Reminders != nil {
+		updates["notify_reminders"] = *input.Reminders
+	}
+	if input.Mentions != nil {
+		updates["notify_mentions"] = *input.Mentions
+	}
+	if len(updates) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNoFieldsToUpdate)
+	}
+
+	user, err := service.db.UpdateNotificationPreferences(ctx, id, updates)
+	if err != nil {
+		return nil, err
+	}
+	return preferencesToDto(user.Notifications), nil
+}
@@ -0,0 +1,117 @@
+package WebhookApplication
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	WebhookPorts "autobill-service/internal/ports/outbound/webhook"
+	Logger "autobill-service/pkg/logger"
+)
+
+const (
+	webhookBatchSize = 50
+	// webhookDeliveryLease must outlast a send so a claimed delivery is not
+	// picked up again while it is still in flight.
+	webhookDeliveryLease = 5 * time.Minute
+)
+
+type WebhookDispatcher struct {
+	repo        RepositoryPorts.WebhookRepositoryPort
+	sender      WebhookPorts.WebhookSender
+	interval    time.Duration
+	maxAttempts int
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func CreateWebhookDispatcher(repo RepositoryPorts.WebhookRepositoryPort, sender WebhookPorts.WebhookSender, interval time.Duration, maxAttempts int) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		repo:        repo,
+		sender:      sender,
+		interval:    interval,
+		maxAttempts: maxAttempts,
+	}
+}
+
+func (d *WebhookDispatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.done = make(chan struct{})
+
+	go func() {
+		defer close(d.done)
+
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+
+		Logger.Info().Dur("interval", d.interval).Msg("Webhook dispatcher started")
+
+		for {
+			d.dispatchDue(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (d *WebhookDispatcher) Stop() {
+	if d.cancel == nil {
+		return
+	}
+	d.cancel()
+	<-d.done
+	Logger.Info().Msg("Webhook dispatcher stopped")
+}
+
+func (d *WebhookDispatcher) dispatchDue(ctx context.Context) {
+	deliveries, err := d.repo.ClaimDueDeliveries(ctx, time.Now().UTC(), webhookDeliveryLease, webhookBatchSize)
+	if err != nil {
+		Logger.Error().Err(err).Msg("Failed to claim due webhook deliveries")
+		return
+	}
+
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		d.deliver(ctx, &deliveries[i])
+	}
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, delivery *Domain.WebhookDelivery) {
+	statusCode, err := d.sender.Send(ctx, WebhookPorts.WebhookRequest{
+		URL:        delivery.Subscription.URL,
+		Secret:     delivery.Subscription.Secret,
+		DeliveryID: delivery.Id,
+		EventType:  string(delivery.EventType),
+		Payload:    []byte(delivery.Payload),
+	})
+	if ctx.Err() != nil {
+		// Shutting down; the lease expires and the delivery is retried.
+		return
+	}
+
+	now := time.Now().UTC()
+	if err != nil {
+		delivery.MarkFailed(now, statusCode, err.Error(), d.maxAttempts)
+		Logger.Warn().
+			Err(err).
+			Str("deliveryId", delivery.Id.String()).
+			Int("attempts", delivery.Attempts).
+			Str("status", string(delivery.Status)).
+			Msg("Webhook delivery failed")
+	} else {
+		delivery.MarkDelivered(now, statusCode)
+	}
+
+	if saveErr := d.repo.SaveDeliveryAttempt(ctx, delivery); saveErr != nil {
+		Logger.Error().Err(saveErr).Str("deliveryId", delivery.Id.String()).Msg("Failed to record webhook delivery attempt")
+	}
+}
@@ -0,0 +1,58 @@
+package WebhookApplicationDtos
+
+import "time"
+
+type CreateWebhookInput struct {
+	URL         string
+	GroupID     string
+	Events      []string
+	Description string
+}
+
+type UpdateWebhookInput struct {
+	URL         *string
+	Events      *[]string
+	Description *string
+	Active      *bool
+}
+
+type WebhookResult struct {
+	ID          string
+	URL         string
+	GroupID     *string
+	Events      []string
+	Description string
+	Active      bool
+	Secret      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type WebhookListResult struct {
+	Webhooks   []WebhookResult
+	Page       int
+	PageSize   int
+	TotalItems int64
+}
+
+type WebhookDeliveryResult struct {
+	ID             string
+	WebhookID      string
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastAttemptAt  *time.Time
+	LastStatusCode *int
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+type WebhookDeliveryListResult struct {
+	Deliveries []WebhookDeliveryResult
+	Page       int
+	PageSize   int
+	TotalItems int64
+}
@@ -0,0 +1,331 @@
+package WebhookApplication
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	Dtos "autobill-service/internal/application/webhook/dtos"
+	Domain "autobill-service/internal/domain"
+	HttpPorts "autobill-service/internal/ports/inbound/http"
+	RepositoryPorts "autobill-service/internal/ports/outbound/db"
+	Errors "autobill-service/pkg/errors"
+	Helpers "autobill-service/pkg/helpers"
+	Logger "autobill-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+type WebhookService struct {
+	repo      RepositoryPorts.WebhookRepositoryPort
+	groupRepo RepositoryPorts.GroupRepositoryPort
+}
+
+func CreateWebhookService(repo RepositoryPorts.WebhookRepositoryPort, groupRepo RepositoryPorts.GroupRepositoryPort) HttpPorts.WebhookUseCase {
+	return &WebhookService{
+		repo:      repo,
+		groupRepo: groupRepo,
+	}
+}
+
+func (s *WebhookService) CreateWebhook(ctx context.Context, userId uuid.UUID, input Dtos.CreateWebhookInput) (*Dtos.WebhookResult, error) {
+	if err := validateWebhookURL(ctx, input.URL); err != nil {
+		return nil, err
+	}
+
+	events, err := parseWebhookEvents(input.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &Domain.WebhookSubscription{
+		OwnerID:     userId,
+		URL:         input.URL,
+		Events:      Domain.JoinWebhookEvents(events),
+		Description: input.Description,
+		Active:      true,
+	}
+
+	if input.GroupID != "" {
+		groupId, err := Helpers.ParseUUID(input.GroupID)
+		if err != nil {
+			return nil, err
+		}
+
+		isAdmin, adminErr := s.groupRepo.IsGroupAdmin(ctx, groupId, userId)
+		if adminErr != nil {
+			return nil, adminErr
+		}
+		if !isAdmin {
+			return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrGroupNotFound)
+		}
+		subscription.GroupID = &groupId
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, Errors.ErrInternal)
+	}
+	subscription.Secret = secret
+
+	created, dbErr := s.repo.CreateSubscription(ctx, subscription)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	Logger.Debug().
+		Str("operation", "CreateWebhook").
+		Str("userId", userId.String()).
+		Str("webhookId", created.Id.String()).
+		Msg("Webhook created successfully")
+
+	// The secret is only returned when the webhook is created.
+	result := webhookToDto(created)
+	result.Secret = created.Secret
+	return result, nil
+}
+
+func (s *WebhookService) GetWebhooks(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.WebhookListResult, error) {
+	subscriptions, total, dbErr := s.repo.GetSubscriptionsByOwnerId(ctx, userId, pagination.PageSize, pagination.Offset())
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	webhooks := make([]Dtos.WebhookResult, len(subscriptions))
+	for i := range subscriptions {
+		webhooks[i] = *webhookToDto(&subscriptions[i])
+	}
+
+	return &Dtos.WebhookListResult{
+		Webhooks:   webhooks,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalItems: total,
+	}, nil
+}
+
+func (s *WebhookService) GetWebhook(ctx context.Context, userId, webhookId uuid.UUID) (*Dtos.WebhookResult, error) {
+	subscription, err := s.getOwnedSubscription(ctx, userId, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	return webhookToDto(subscription), nil
+}
+
+func (s *WebhookService) UpdateWebhook(ctx context.Context, userId, webhookId uuid.UUID, input Dtos.UpdateWebhookInput) (*Dtos.WebhookResult, error) {
+	if _, err := s.getOwnedSubscription(ctx, userId, webhookId); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]any)
+	if input.URL != nil {
+		if err := validateWebhookURL(ctx, *input.URL); err != nil {
+			return nil, err
+		}
+		updates["url"] = *input.URL
+	}
+	if input.Events != nil {
+		events, err := parseWebhookEvents(*input.Events)
+		if err != nil {
+			return nil, err
+		}
+		updates["events"] = Domain.JoinWebhookEvents(events)
+	}
+	if input.Description != nil {
+		updates["description"] = *input.Description
+	}
+	if input.Active != nil {
+		updates["active"] = *input.Active
+	}
+
+	if len(updates) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrNoFieldsToUpdate)
+	}
+
+	updated, dbErr := s.repo.UpdateSubscription(ctx, webhookId, updates)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	return webhookToDto(updated), nil
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, userId, webhookId uuid.UUID) error {
+	if _, err := s.getOwnedSubscription(ctx, userId, webhookId); err != nil {
+		return err
+	}
+	return s.repo.DeleteSubscription(ctx, webhookId)
+}
+
+func (s *WebhookService) GetDeliveries(ctx context.Context, userId, webhookId uuid.UUID, status string, pagination Helpers.PaginationParams) (*Dtos.WebhookDeliveryListResult, error) {
+	if _, err := s.getOwnedSubscription(ctx, userId, webhookId); err != nil {
+		return nil, err
+	}
+
+	var statusFilter *Domain.WebhookDeliveryStatus
+	if status != "" {
+		if !Domain.IsValidWebhookDeliveryStatus(status) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidWebhookDeliveryStatus)
+		}
+		deliveryStatus := Domain.WebhookDeliveryStatus(status)
+		statusFilter = &deliveryStatus
+	}
+
+	deliveries, total, dbErr := s.repo.GetDeliveries(ctx, webhookId, statusFilter, pagination.PageSize, pagination.Offset())
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	return toDeliveryListResult(deliveries, total, pagination), nil
+}
+
+func (s *WebhookService) GetDeadLetters(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.WebhookDeliveryListResult, error) {
+	deliveries, total, dbErr := s.repo.GetDeadDeliveriesByOwnerId(ctx, userId, pagination.PageSize, pagination.Offset())
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	return toDeliveryListResult(deliveries, total, pagination), nil
+}
+
+func (s *WebhookService) RetryDelivery(ctx context.Context, userId, deliveryId uuid.UUID) (*Dtos.WebhookDeliveryResult, error) {
+	delivery, dbErr := s.repo.GetDeliveryById(ctx, deliveryId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	if delivery.Subscription.OwnerID != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrWebhookDeliveryNotFound)
+	}
+	if delivery.Status != Domain.WebhookDeliveryDead {
+		return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrWebhookDeliveryNotDead)
+	}
+
+	requeued, dbErr := s.repo.RequeueDelivery(ctx, deliveryId, time.Now().UTC())
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	Logger.Debug().
+		Str("operation", "RetryDelivery").
+		Str("userId", userId.String()).
+		Str("deliveryId", deliveryId.String()).
+		Msg("Webhook delivery requeued")
+
+	return deliveryToDto(requeued), nil
+}
+
+func (s *WebhookService) getOwnedSubscription(ctx context.Context, userId, webhookId uuid.UUID) (*Domain.WebhookSubscription, error) {
+	subscription, dbErr := s.repo.GetSubscriptionById(ctx, webhookId)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	if subscription.OwnerID != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, Errors.ErrWebhookNotFound)
+	}
+	return subscription, nil
+}
+
+// lookupWebhookHost resolves webhook hosts; IP literals are returned as they
+// are.
+var lookupWebhookHost = net.DefaultResolver.LookupNetIP
+
+// validateWebhookURL only accepts https URLs whose host resolves to public
+// addresses. The sender checks the address again when it connects, since DNS
+// can change after registration.
+func validateWebhookURL(ctx context.Context, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Hostname() == "" || parsed.User != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidWebhookURL)
+	}
+
+	addrs, err := lookupWebhookHost(ctx, "ip", parsed.Hostname())
+	if err != nil || len(addrs) == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, Errors.ErrWebhookHostUnresolvable)
+	}
+	for _, addr := range addrs {
+		if !Domain.IsPublicWebhookAddress(addr) {
+			return fiber.NewError(fiber.StatusBadRequest, Errors.ErrWebhookAddressNotAllowed)
+		}
+	}
+	return nil
+}
+
+func parseWebhookEvents(values []string) ([]Domain.ActivityAction, error) {
+	events := make([]Domain.ActivityAction, 0, len(values))
+	seen := make(map[Domain.ActivityAction]bool, len(values))
+	for _, value := range values {
+		event := Domain.ActivityAction(value)
+		if !Domain.IsWebhookEvent(event) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Errors.ErrInvalidWebhookEvent)
+		}
+		if !seen[event] {
+			seen[event] = true
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(bytes), nil
+}
+
+func webhookToDto(subscription *Domain.WebhookSubscription) *Dtos.WebhookResult {
+	events := subscription.EventList()
+	eventValues := make([]string, len(events))
+	for i, event := range events {
+		eventValues[i] = string(event)
+	}
+
+	result := &Dtos.WebhookResult{
+		ID:          subscription.Id.String(),
+		URL:         subscription.URL,
+		Events:      eventValues,
+		Description: subscription.Description,
+		Active:      subscription.Active,
+		CreatedAt:   subscription.CreatedAt,
+		UpdatedAt:   subscription.UpdatedAt,
+	}
+	if subscription.GroupID != nil {
+		groupId := subscription.GroupID.String()
+		result.GroupID = &groupId
+	}
+	return result
+}
+
+func deliveryToDto(delivery *Domain.WebhookDelivery) *Dtos.WebhookDeliveryResult {
+	return &Dtos.WebhookDeliveryResult{
+		ID:             delivery.Id.String(),
+		WebhookID:      delivery.SubscriptionID.String(),
+		EventType:      string(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func toDeliveryListResult(deliveries []Domain.WebhookDelivery, total int64, pagination Helpers.PaginationParams) *Dtos.WebhookDeliveryListResult {
+	results := make([]Dtos.WebhookDeliveryResult, len(deliveries))
+	for i := range deliveries {
+		results[i] = *deliveryToDto(&deliveries[i])
+	}
+
+	return &Dtos.WebhookDeliveryListResult{
+		Deliveries: results,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalItems: total,
+	}
+}
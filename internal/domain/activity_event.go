@@ -0,0 +1,89 @@
+package Domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type ActivitySummary map[string]interface{}
+
+func (s ActivitySummary) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
+func (s *ActivitySummary) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("unsupported activity summary type")
+	}
+	return json.Unmarshal(data, s)
+}
+
+type ActivityEvent struct {
+	BaseModel
+
+	ActorID    uuid.UUID          `gorm:"type:uuid;index;not null" json:"actor_id"`
+	Action     ActivityAction     `gorm:"type:varchar(40);not null" json:"action"`
+	TargetType ActivityTargetType `gorm:"type:varchar(20);not null" json:"target_type"`
+	TargetID   uuid.UUID          `gorm:"type:uuid;index;not null" json:"target_id"`
+	GroupID    *uuid.UUID         `gorm:"type:uuid;index" json:"group_id,omitempty"`
+	Before     ActivitySummary    `gorm:"type:jsonb" json:"before,omitempty"`
+	After      ActivitySummary    `gorm:"type:jsonb" json:"after,omitempty"`
+
+	Actor    User                `gorm:"foreignKey:ActorID;references:Id;constraint:OnDelete:CASCADE"`
+	Audience []ActivityEventUser `gorm:"foreignKey:ActivityEventID;references:Id"`
+}
+
+// ActivityEventUser lists the users whose personal feed shows an event.
+type ActivityEventUser struct {
+	BaseModel
+
+	ActivityEventID uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_activity_event_user" json:"activity_event_id"`
+	UserID          uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_activity_event_user" json:"user_id"`
+
+	ActivityEvent ActivityEvent `gorm:"foreignKey:ActivityEventID;references:Id;constraint:OnDelete:CASCADE"`
+	User          User          `gorm:"foreignKey:UserID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+func NewActivityEvent(actorId uuid.UUID, action ActivityAction, targetType ActivityTargetType, targetId uuid.UUID, groupId *uuid.UUID) *ActivityEvent {
+	event := &ActivityEvent{
+		ActorID:    actorId,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetId,
+		GroupID:    groupId,
+	}
+	event.AddAudience(actorId)
+	return event
+}
+
+func (e *ActivityEvent) AddAudience(userIds ...uuid.UUID) {
+	for _, userId := range userIds {
+		seen := false
+		for _, member := range e.Audience {
+			if member.UserID == userId {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			e.Audience = append(e.Audience, ActivityEventUser{UserID: userId})
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package Domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// Category labels splits for spending analytics. Categories without a group
+// are system defaults that every split can use; custom categories are only
+// available to splits in their own group.
+type Category struct {
+	BaseModel
+
+	Name        string     `gorm:"type:varchar(50);not null" json:"name"`
+	GroupID     *uuid.UUID `gorm:"type:uuid;index" json:"group_id,omitempty"`
+	CreatedByID *uuid.UUID `gorm:"type:uuid" json:"created_by_id,omitempty"`
+
+	Group     *Group `gorm:"foreignKey:GroupID;references:Id;constraint:OnDelete:CASCADE"`
+	CreatedBy *User  `gorm:"foreignKey:CreatedByID;references:Id;constraint:OnDelete:SET NULL"`
+}
+
+func (c Category) IsSystem() bool {
+	return c.GroupID == nil
+}
+
+func (c Category) AvailableIn(groupId *uuid.UUID) bool {
+	if c.GroupID == nil {
+		return true
+	}
+	return groupId != nil && *c.GroupID == *groupId
+}
@@ -0,0 +1,134 @@
+package Domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxCommentLength   = 2000
+	MaxCommentMentions = 20
+	commentExcerptSize = 200
+)
+
+// mentionPattern matches "@" followed by an email address, e.g.
+// "@alice@example.com". The leading "@" must not follow a word character so
+// that plain email addresses in the text are not treated as mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)+)`)
+
+// Comment belongs to either a split or a settlement. Threads are one level
+// deep: a reply always points at the top-level comment it answers.
+type Comment struct {
+	BaseModel
+
+	SplitID      *uuid.UUID `gorm:"type:uuid;index" json:"split_id,omitempty"`
+	SettlementID *uuid.UUID `gorm:"type:uuid;index" json:"settlement_id,omitempty"`
+	ParentID     *uuid.UUID `gorm:"type:uuid;index" json:"parent_id,omitempty"`
+	AuthorID     uuid.UUID  `gorm:"type:uuid;index;not null" json:"author_id"`
+	Body         string     `gorm:"type:varchar(2000);not null" json:"body"`
+	EditedAt     *time.Time `gorm:"default:null" json:"edited_at,omitempty"`
+
+	Split      *Split           `gorm:"foreignKey:SplitID;references:Id;constraint:OnDelete:CASCADE"`
+	Settlement *Settlement      `gorm:"foreignKey:SettlementID;references:Id;constraint:OnDelete:CASCADE"`
+	Parent     *Comment         `gorm:"foreignKey:ParentID;references:Id;constraint:OnDelete:CASCADE"`
+	Author     User             `gorm:"foreignKey:AuthorID;references:Id;constraint:OnDelete:CASCADE"`
+	Replies    []Comment        `gorm:"foreignKey:ParentID;references:Id"`
+	Mentions   []CommentMention `gorm:"foreignKey:CommentID;references:Id"`
+}
+
+type CommentMention struct {
+	BaseModel
+
+	CommentID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_comment_mention" json:"comment_id"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_comment_mention" json:"user_id"`
+
+	Comment Comment `gorm:"foreignKey:CommentID;references:Id;constraint:OnDelete:CASCADE"`
+	User    User    `gorm:"foreignKey:UserID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+// CommentThread is the split or settlement a comment is attached to, as seen
+// by a user allowed to read it. Mentionable lists the users who can read the
+// thread too, and so may be mentioned in it.
+type CommentThread struct {
+	TargetType  ActivityTargetType
+	TargetID    uuid.UUID
+	GroupID     *uuid.UUID
+	Description string
+	Mentionable []User
+}
+
+func (t *CommentThread) NewComment(authorId uuid.UUID, body string) *Comment {
+	comment := &Comment{AuthorID: authorId, Body: body}
+	targetId := t.TargetID
+	if t.TargetType == ActivityTargetSettlement {
+		comment.SettlementID = &targetId
+	} else {
+		comment.SplitID = &targetId
+	}
+	return comment
+}
+
+func (t *CommentThread) Contains(comment *Comment) bool {
+	if t.TargetType == ActivityTargetSettlement {
+		return comment.SettlementID != nil && *comment.SettlementID == t.TargetID
+	}
+	return comment.SplitID != nil && *comment.SplitID == t.TargetID
+}
+
+// ResolveMentions returns the IDs of the mentionable users whose email is
+// mentioned in body, in order of first mention. Unknown addresses are left as
+// plain text.
+func (t *CommentThread) ResolveMentions(body string) []uuid.UUID {
+	byEmail := make(map[string]uuid.UUID, len(t.Mentionable))
+	for _, user := range t.Mentionable {
+		byEmail[strings.ToLower(user.Email)] = user.Id
+	}
+
+	var userIds []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, email := range ParseMentions(body) {
+		userId, ok := byEmail[email]
+		if !ok || seen[userId] {
+			continue
+		}
+		seen[userId] = true
+		userIds = append(userIds, userId)
+		if len(userIds) == MaxCommentMentions {
+			break
+		}
+	}
+	return userIds
+}
+
+// MentionActivity records authorId mentioning userIds in comment.
+func (t *CommentThread) MentionActivity(authorId uuid.UUID, comment *Comment, userIds []uuid.UUID) *ActivityEvent {
+	activity := NewActivityEvent(authorId, ActivityCommentMentioned, t.TargetType, t.TargetID, t.GroupID)
+	activity.AddAudience(userIds...)
+	activity.After = ActivitySummary{
+		"comment_id":  comment.Id.String(),
+		"description": t.Description,
+		"comment":     CommentExcerpt(comment.Body),
+	}
+	return activity
+}
+
+// ParseMentions returns the lowercased email addresses mentioned in body.
+func ParseMentions(body string) []string {
+	matches := mentionPattern.FindAllStringSubmatch(body, -1)
+	emails := make([]string, len(matches))
+	for i, match := range matches {
+		emails[i] = strings.ToLower(match[1])
+	}
+	return emails
+}
+
+func CommentExcerpt(body string) string {
+	runes := []rune(strings.TrimSpace(body))
+	if len(runes) <= commentExcerptSize {
+		return string(runes)
+	}
+	return strings.TrimSpace(string(runes[:commentExcerptSize])) + "…"
+}
@@ -0,0 +1,287 @@
+package Domain
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Currency string
+
+const (
+	CurrencyINR Currency = "INR"
+	CurrencyUSD Currency = "USD"
+	CurrencyEUR Currency = "EUR"
+)
+
+const MaxMajorUnitAmount int64 = 1_000_000_000_000
+
+type CurrencyInfo struct {
+	Code     Currency
+	Name     string
+	Exponent int
+}
+
+var currencyRegistry = map[Currency]CurrencyInfo{
+	"AED": {Code: "AED", Name: "UAE Dirham", Exponent: 2},
+	"AFN": {Code: "AFN", Name: "Afghani", Exponent: 2},
+	"ALL": {Code: "ALL", Name: "Lek", Exponent: 2},
+	"AMD": {Code: "AMD", Name: "Armenian Dram", Exponent: 2},
+	"ANG": {Code: "ANG", Name: "Netherlands Antillean Guilder", Exponent: 2},
+	"AOA": {Code: "AOA", Name: "Kwanza", Exponent: 2},
+	"ARS": {Code: "ARS", Name: "Argentine Peso", Exponent: 2},
+	"AUD": {Code: "AUD", Name: "Australian Dollar", Exponent: 2},
+	"AWG": {Code: "AWG", Name: "Aruban Florin", Exponent: 2},
+	"AZN": {Code: "AZN", Name: "Azerbaijan Manat", Exponent: 2},
+	"BAM": {Code: "BAM", Name: "Convertible Mark", Exponent: 2},
+	"BBD": {Code: "BBD", Name: "Barbados Dollar", Exponent: 2},
+	"BDT": {Code: "BDT", Name: "Taka", Exponent: 2},
+	"BGN": {Code: "BGN", Name: "Bulgarian Lev", Exponent: 2},
+	"BHD": {Code: "BHD", Name: "Bahraini Dinar", Exponent: 3},
+	"BIF": {Code: "BIF", Name: "Burundi Franc", Exponent: 0},
+	"BMD": {Code: "BMD", Name: "Bermudian Dollar", Exponent: 2},
+	"BND": {Code: "BND", Name: "Brunei Dollar", Exponent: 2},
+	"BOB": {Code: "BOB", Name: "Boliviano", Exponent: 2},
+	"BOV": {Code: "BOV", Name: "Mvdol", Exponent: 2},
+	"BRL": {Code: "BRL", Name: "Brazilian Real", Exponent: 2},
+	"BSD": {Code: "BSD", Name: "Bahamian Dollar", Exponent: 2},
+	"BTN": {Code: "BTN", Name: "Ngultrum", Exponent: 2},
+	"BWP": {Code: "BWP", Name: "Pula", Exponent: 2},
+	"BYN": {Code: "BYN", Name: "Belarusian Ruble", Exponent: 2},
+	"BZD": {Code: "BZD", Name: "Belize Dollar", Exponent: 2},
+	"CAD": {Code: "CAD", Name: "Canadian Dollar", Exponent: 2},
+	"CDF": {Code: "CDF", Name: "Congolese Franc", Exponent: 2},
+	"CHE": {Code: "CHE", Name: "WIR Euro", Exponent: 2},
+	"CHF": {Code: "CHF", Name: "Swiss Franc", Exponent: 2},
+	"CHW": {Code: "CHW", Name: "WIR Franc", Exponent: 2},
+	"CLF": {Code: "CLF", Name: "Unidad de Fomento", Exponent: 4},
+	"CLP": {Code: "CLP", Name: "Chilean Peso", Exponent: 0},
+	"CNY": {Code: "CNY", Name: "Yuan Renminbi", Exponent: 2},
+	"COP": {Code: "COP", Name: "Colombian Peso", Exponent: 2},
+	"COU": {Code: "COU", Name: "Unidad de Valor Real", Exponent: 2},
+	"CRC": {Code: "CRC", Name: "Costa Rican Colon", Exponent: 2},
+	"CUP": {Code: "CUP", Name: "Cuban Peso", Exponent: 2},
+	"CVE": {Code: "CVE", Name: "Cabo Verde Escudo", Exponent: 2},
+	"CZK": {Code: "CZK", Name: "Czech Koruna", Exponent: 2},
+	"DJF": {Code: "DJF", Name: "Djibouti Franc", Exponent: 0},
+	"DKK": {Code: "DKK", Name: "Danish Krone", Exponent: 2},
+	"DOP": {Code: "DOP", Name: "Dominican Peso", Exponent: 2},
+	"DZD": {Code: "DZD", Name: "Algerian Dinar", Exponent: 2},
+	"EGP": {Code: "EGP", Name: "Egyptian Pound", Exponent: 2},
+	"ERN": {Code: "ERN", Name: "Nakfa", Exponent: 2},
+	"ETB": {Code: "ETB", Name: "Ethiopian Birr", Exponent: 2},
+	"EUR": {Code: "EUR", Name: "Euro", Exponent: 2},
+	"FJD": {Code: "FJD", Name: "Fiji Dollar", Exponent: 2},
+	"FKP": {Code: "FKP", Name: "Falkland Islands Pound", Exponent: 2},
+	"GBP": {Code: "GBP", Name: "Pound Sterling", Exponent: 2},
+	"GEL": {Code: "GEL", Name: "Lari", Exponent: 2},
+	"GHS": {Code: "GHS", Name: "Ghana Cedi", Exponent: 2},
+	"GIP": {Code: "GIP", Name: "Gibraltar Pound", Exponent: 2},
+	"GMD": {Code: "GMD", Name: "Dalasi", Exponent: 2},
+	"GNF": {Code: "GNF", Name: "Guinean Franc", Exponent: 0},
+	"GTQ": {Code: "GTQ", Name: "Quetzal", Exponent: 2},
+	"GYD": {Code: "GYD", Name: "Guyana Dollar", Exponent: 2},
+	"HKD": {Code: "HKD", Name: "Hong Kong Dollar", Exponent: 2},
+	"HNL": {Code: "HNL", Name: "Lempira", Exponent: 2},
+	"HTG": {Code: "HTG", Name: "Gourde", Exponent: 2},
+	"HUF": {Code: "HUF", Name: "Forint", Exponent: 2},
+	"IDR": {Code: "IDR", Name: "Rupiah", Exponent: 2},
+	"ILS": {Code: "ILS", Name: "New Israeli Sheqel", Exponent: 2},
+	"INR": {Code: "INR", Name: "Indian Rupee", Exponent: 2},
+	"IQD": {Code: "IQD", Name: "Iraqi Dinar", Exponent: 3},
+	"IRR": {Code: "IRR", Name: "Iranian Rial", Exponent: 2},
+	"ISK": {Code: "ISK", Name: "Iceland Krona", Exponent: 0},
+	"JMD": {Code: "JMD", Name: "Jamaican Dollar", Exponent: 2},
+	"JOD": {Code: "JOD", Name: "Jordanian Dinar", Exponent: 3},
+	"JPY": {Code: "JPY", Name: "Yen", Exponent: 0},
+	"KES": {Code: "KES", Name: "Kenyan Shilling", Exponent: 2},
+	"KGS": {Code: "KGS", Name: "Som", Exponent: 2},
+	"KHR": {Code: "KHR", Name: "Riel", Exponent: 2},
+	"KMF": {Code: "KMF", Name: "Comorian Franc", Exponent: 0},
+	"KPW": {Code: "KPW", Name: "North Korean Won", Exponent: 2},
+	"KRW": {Code: "KRW", Name: "Won", Exponent: 0},
+	"KWD": {Code: "KWD", Name: "Kuwaiti Dinar", Exponent: 3},
+	"KYD": {Code: "KYD", Name: "Cayman Islands Dollar", Exponent: 2},
+	"KZT": {Code: "KZT", Name: "Tenge", Exponent: 2},
+	"LAK": {Code: "LAK", Name: "Lao Kip", Exponent: 2},
+	"LBP": {Code: "LBP", Name: "Lebanese Pound", Exponent: 2},
+	"LKR": {Code: "LKR", Name: "Sri Lanka Rupee", Exponent: 2},
+	"LRD": {Code: "LRD", Name: "Liberian Dollar", Exponent: 2},
+	"LSL": {Code: "LSL", Name: "Loti", Exponent: 2},
+	"LYD": {Code: "LYD", Name: "Libyan Dinar", Exponent: 3},
+	"MAD": {Code: "MAD", Name: "Moroccan Dirham", Exponent: 2},
+	"MDL": {Code: "MDL", Name: "Moldovan Leu", Exponent: 2},
+	"MGA": {Code: "MGA", Name: "Malagasy Ariary", Exponent: 2},
+	"MKD": {Code: "MKD", Name: "Denar", Exponent: 2},
+	"MMK": {Code: "MMK", Name: "Kyat", Exponent: 2},
+	"MNT": {Code: "MNT", Name: "Tugrik", Exponent: 2},
+	"MOP": {Code: "MOP", Name: "Pataca", Exponent: 2},
+	"MRU": {Code: "MRU", Name: "Ouguiya", Exponent: 2},
+	"MUR": {Code: "MUR", Name: "Mauritius Rupee", Exponent: 2},
+	"MVR": {Code: "MVR", Name: "Rufiyaa", Exponent: 2},
+	"MWK": {Code: "MWK", Name: "Malawi Kwacha", Exponent: 2},
+	"MXN": {Code: "MXN", Name: "Mexican Peso", Exponent: 2},
+	"MXV": {Code: "MXV", Name: "Mexican Unidad de Inversion (UDI)", Exponent: 2},
+	"MYR": {Code: "MYR", Name: "Malaysian Ringgit", Exponent: 2},
+	"MZN": {Code: "MZN", Name: "Mozambique Metical", Exponent: 2},
+	"NAD": {Code: "NAD", Name: "Namibia Dollar", Exponent: 2},
+	"NGN": {Code: "NGN", Name: "Naira", Exponent: 2},
+	"NIO": {Code: "NIO", Name: "Cordoba Oro", Exponent: 2},
+	"NOK": {Code: "NOK", Name: "Norwegian Krone", Exponent: 2},
+	"NPR": {Code: "NPR", Name: "Nepalese Rupee", Exponent: 2},
+	"NZD": {Code: "NZD", Name: "New Zealand Dollar", Exponent: 2},
+	"OMR": {Code: "OMR", Name: "Rial Omani", Exponent: 3},
+	"PAB": {Code: "PAB", Name: "Balboa", Exponent: 2},
+	"PEN": {Code: "PEN", Name: "Sol", Exponent: 2},
+	"PGK": {Code: "PGK", Name: "Kina", Exponent: 2},
+	"PHP": {Code: "PHP", Name: "Philippine Peso", Exponent: 2},
+	"PKR": {Code: "PKR", Name: "Pakistan Rupee", Exponent: 2},
+	"PLN": {Code: "PLN", Name: "Zloty", Exponent: 2},
+	"PYG": {Code: "PYG", Name: "Guarani", Exponent: 0},
+	"QAR": {Code: "QAR", Name: "Qatari Rial", Exponent: 2},
+	"RON": {Code: "RON", Name: "Romanian Leu", Exponent: 2},
+	"RSD": {Code: "RSD", Name: "Serbian Dinar", Exponent: 2},
+	"RUB": {Code: "RUB", Name: "Russian Ruble", Exponent: 2},
+	"RWF": {Code: "RWF", Name: "Rwanda Franc", Exponent: 0},
+	"SAR": {Code: "SAR", Name: "Saudi Riyal", Exponent: 2},
+	"SBD": {Code: "SBD", Name: "Solomon Islands Dollar", Exponent: 2},
+	"SCR": {Code: "SCR", Name: "Seychelles Rupee", Exponent: 2},
+	"SDG": {Code: "SDG", Name: "Sudanese Pound", Exponent: 2},
+	"SEK": {Code: "SEK", Name: "Swedish Krona", Exponent: 2},
+	"SGD": {Code: "SGD", Name: "Singapore Dollar", Exponent: 2},
+	"SHP": {Code: "SHP", Name: "Saint Helena Pound", Exponent: 2},
+	"SLE": {Code: "SLE", Name: "Leone", Exponent: 2},
+	"SOS": {Code: "SOS", Name: "Somali Shilling", Exponent: 2},
+	"SRD": {Code: "SRD", Name: "Surinam Dollar", Exponent: 2},
+	"SSP": {Code: "SSP", Name: "South Sudanese Pound", Exponent: 2},
+	"STN": {Code: "STN", Name: "Dobra", Exponent: 2},
+	"SVC": {Code: "SVC", Name: "El Salvador Colon", Exponent: 2},
+	"SYP": {Code: "SYP", Name: "Syrian Pound", Exponent: 2},
+	"SZL": {Code: "SZL", Name: "Lilangeni", Exponent: 2},
+	"THB": {Code: "THB", Name: "Baht", Exponent: 2},
+	"TJS": {Code: "TJS", Name: "Somoni", Exponent: 2},
+	"TMT": {Code: "TMT", Name: "Turkmenistan New Manat", Exponent: 2},
+	"TND": {Code: "TND", Name: "Tunisian Dinar", Exponent: 3},
+	"TOP": {Code: "TOP", Name: "Pa'anga", Exponent: 2},
+	"TRY": {Code: "TRY", Name: "Turkish Lira", Exponent: 2},
+	"TTD": {Code: "TTD", Name: "Trinidad and Tobago Dollar", Exponent: 2},
+	"TWD": {Code: "TWD", Name: "New Taiwan Dollar", Exponent: 2},
+	"TZS": {Code: "TZS", Name: "Tanzanian Shilling", Exponent: 2},
+	"UAH": {Code: "UAH", Name: "Hryvnia", Exponent: 2},
+	"UGX": {Code: "UGX", Name: "Uganda Shilling", Exponent: 0},
+	"USD": {Code: "USD", Name: "US Dollar", Exponent: 2},
+	"USN": {Code: "USN", Name: "US Dollar (Next day)", Exponent: 2},
+	"UYI": {Code: "UYI", Name: "Uruguay Peso en Unidades Indexadas (UI)", Exponent: 0},
+	"UYU": {Code: "UYU", Name: "Peso Uruguayo", Exponent: 2},
+	"UYW": {Code: "UYW", Name: "Unidad Previsional", Exponent: 4},
+	"UZS": {Code: "UZS", Name: "Uzbekistan Sum", Exponent: 2},
+	"VED": {Code: "VED", Name: "Bolivar Soberano", Exponent: 2},
+	"VES": {Code: "VES", Name: "Bolivar Soberano", Exponent: 2},
+	"VND": {Code: "VND", Name: "Dong", Exponent: 0},
+	"VUV": {Code: "VUV", Name: "Vatu", Exponent: 0},
+	"WST": {Code: "WST", Name: "Tala", Exponent: 2},
+	"XAF": {Code: "XAF", Name: "CFA Franc BEAC", Exponent: 0},
+	"XCD": {Code: "XCD", Name: "East Caribbean Dollar", Exponent: 2},
+	"XCG": {Code: "XCG", Name: "Caribbean Guilder", Exponent: 2},
+	"XOF": {Code: "XOF", Name: "CFA Franc BCEAO", Exponent: 0},
+	"XPF": {Code: "XPF", Name: "CFP Franc", Exponent: 0},
+	"YER": {Code: "YER", Name: "Yemeni Rial", Exponent: 2},
+	"ZAR": {Code: "ZAR", Name: "Rand", Exponent: 2},
+	"ZMW": {Code: "ZMW", Name: "Zambian Kwacha", Exponent: 2},
+	"ZWG": {Code: "ZWG", Name: "Zimbabwe Gold", Exponent: 2},
+}
+
+func LookupCurrency(code string) (CurrencyInfo, bool) {
+	info, ok := currencyRegistry[Currency(code)]
+	return info, ok
+}
+
+func IsValidCurrency(c string) bool {
+	_, ok := currencyRegistry[Currency(c)]
+	return ok
+}
+
+func Currencies() []CurrencyInfo {
+	currencies := make([]CurrencyInfo, 0, len(currencyRegistry))
+	for _, info := range currencyRegistry {
+		currencies = append(currencies, info)
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i].Code < currencies[j].Code
+	})
+	return currencies
+}
+
+func (c Currency) Exponent() int {
+	if info, ok := currencyRegistry[c]; ok {
+		return info.Exponent
+	}
+	return 2
+}
+
+func (c Currency) MaxAmount() int64 {
+	return MaxMajorUnitAmount * pow10(c.Exponent())
+}
+
+func IsValidAmount(amount int64, currency Currency) bool {
+	return amount > 0 && amount <= currency.MaxAmount()
+}
+
+func FormatAmount(amount int64, currency Currency) string {
+	exponent := currency.Exponent()
+
+	sign := ""
+	magnitude := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		magnitude = uint64(-(amount + 1)) + 1
+	}
+
+	digits := strconv.FormatUint(magnitude, 10)
+	if exponent == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+// ParseAmount reads a decimal string such as "-12.50" into minor units. It
+// rejects more fraction digits than the currency has and amounts beyond
+// MaxAmount.
+func ParseAmount(value string, currency Currency) (int64, bool) {
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "-"), "+")
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	exponent := currency.Exponent()
+	if whole == "" || len(fraction) > exponent {
+		return 0, false
+	}
+
+	digits := whole + fraction + strings.Repeat("0", exponent-len(fraction))
+	amount, err := strconv.ParseUint(digits, 10, 63)
+	if err != nil || int64(amount) > currency.MaxAmount() {
+		return 0, false
+	}
+	if negative {
+		return -int64(amount), true
+	}
+	return int64(amount), true
+}
+
+func ConvertMinorUnits(amount int64, from, to Currency, rate float64) int64 {
+	scale := math.Pow10(to.Exponent() - from.Exponent())
+	return int64(math.Round(float64(amount) * rate * scale))
+}
+
+func pow10(exponent int) int64 {
+	result := int64(1)
+	for range exponent {
+		result *= 10
+	}
+	return result
+}
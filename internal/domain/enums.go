@@ -1,21 +1,5 @@
 package Domain
 
-type Currency string
-
-const (
-	CurrencyINR Currency = "INR"
-	CurrencyUSD Currency = "USD"
-	CurrencyEUR Currency = "EUR"
-)
-
-func IsValidCurrency(c string) bool {
-	switch Currency(c) {
-	case CurrencyINR, CurrencyUSD, CurrencyEUR:
-		return true
-	}
-	return false
-}
-
 type SplitType string
 
 const (
@@ -34,13 +18,33 @@ func IsValidSplitType(t string) bool {
 type SplitDivisionType string
 
 const (
-	SplitDivisionEqual  SplitDivisionType = "EQUAL"
-	SplitDivisionCustom SplitDivisionType = "CUSTOM"
+	SplitDivisionEqual      SplitDivisionType = "EQUAL"
+	SplitDivisionCustom     SplitDivisionType = "CUSTOM"
+	SplitDivisionPercentage SplitDivisionType = "PERCENTAGE"
+	SplitDivisionShares     SplitDivisionType = "SHARES"
+	SplitDivisionItemized   SplitDivisionType = "ITEMIZED"
 )
 
 func IsValidSplitDivisionType(d string) bool {
 	switch SplitDivisionType(d) {
-	case SplitDivisionEqual, SplitDivisionCustom:
+	case SplitDivisionEqual, SplitDivisionCustom, SplitDivisionPercentage, SplitDivisionShares, SplitDivisionItemized:
+		return true
+	}
+	return false
+}
+
+type RecurrenceCadence string
+
+const (
+	RecurrenceDaily   RecurrenceCadence = "DAILY"
+	RecurrenceWeekly  RecurrenceCadence = "WEEKLY"
+	RecurrenceMonthly RecurrenceCadence = "MONTHLY"
+	RecurrenceCron    RecurrenceCadence = "CRON"
+)
+
+func IsValidRecurrenceCadence(c string) bool {
+	switch RecurrenceCadence(c) {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, RecurrenceCron:
 		return true
 	}
 	return false
@@ -69,3 +73,155 @@ func IsValidAssignableRole(r string) bool {
 	}
 	return false
 }
+
+type SettlementStatus string
+
+const (
+	SettlementPending   SettlementStatus = "PENDING"
+	SettlementConfirmed SettlementStatus = "CONFIRMED"
+	SettlementRejected  SettlementStatus = "REJECTED"
+	SettlementDisputed  SettlementStatus = "DISPUTED"
+)
+
+func IsValidSettlementStatus(s string) bool {
+	switch SettlementStatus(s) {
+	case SettlementPending, SettlementConfirmed, SettlementRejected, SettlementDisputed:
+		return true
+	}
+	return false
+}
+
+type LedgerAccount string
+
+const (
+	LedgerAccountUser  LedgerAccount = "USER"
+	LedgerAccountGroup LedgerAccount = "GROUP"
+)
+
+type LedgerSourceType string
+
+const (
+	LedgerSourceSplit              LedgerSourceType = "SPLIT"
+	LedgerSourceSplitReversal      LedgerSourceType = "SPLIT_REVERSAL"
+	LedgerSourceSettlement         LedgerSourceType = "SETTLEMENT"
+	LedgerSourceSettlementReversal LedgerSourceType = "SETTLEMENT_REVERSAL"
+	LedgerSourceOpeningBalance     LedgerSourceType = "OPENING_BALANCE"
+	LedgerSourceUserMerge          LedgerSourceType = "USER_MERGE"
+)
+
+type ActivityAction string
+
+const (
+	ActivitySplitCreated           ActivityAction = "SPLIT_CREATED"
+	ActivitySplitUpdated           ActivityAction = "SPLIT_UPDATED"
+	ActivitySplitReversed          ActivityAction = "SPLIT_REVERSED"
+	ActivitySettlementCreated      ActivityAction = "SETTLEMENT_CREATED"
+	ActivitySettlementConfirmed    ActivityAction = "SETTLEMENT_CONFIRMED"
+	ActivitySettlementRejected     ActivityAction = "SETTLEMENT_REJECTED"
+	ActivitySettlementDisputed     ActivityAction = "SETTLEMENT_DISPUTED"
+	ActivitySettlementDeleted      ActivityAction = "SETTLEMENT_DELETED"
+	ActivityGroupCreated           ActivityAction = "GROUP_CREATED"
+	ActivityGroupUpdated           ActivityAction = "GROUP_UPDATED"
+	ActivityGroupDeleted           ActivityAction = "GROUP_DELETED"
+	ActivityMemberAdded            ActivityAction = "MEMBER_ADDED"
+	ActivityMemberRoleChanged      ActivityAction = "MEMBER_ROLE_CHANGED"
+	ActivityMemberRemoved          ActivityAction = "MEMBER_REMOVED"
+	ActivityMemberLeft             ActivityAction = "MEMBER_LEFT"
+	ActivityMemberJoined           ActivityAction = "MEMBER_JOINED"
+	ActivityGuestClaimed           ActivityAction = "GUEST_CLAIMED"
+	ActivityOwnershipTransferred   ActivityAction = "OWNERSHIP_TRANSFERRED"
+	ActivityFriendRequestSent      ActivityAction = "FRIEND_REQUEST_SENT"
+	ActivityFriendRequestAccepted  ActivityAction = "FRIEND_REQUEST_ACCEPTED"
+	ActivityFriendRequestRejected  ActivityAction = "FRIEND_REQUEST_REJECTED"
+	ActivityFriendRequestCancelled ActivityAction = "FRIEND_REQUEST_CANCELLED"
+	ActivityFriendRemoved          ActivityAction = "FRIEND_REMOVED"
+	ActivityPaymentReminded        ActivityAction = "PAYMENT_REMINDED"
+	ActivityPaymentReminderDeleted ActivityAction = "PAYMENT_REMINDER_DELETED"
+	ActivityCommentMentioned       ActivityAction = "COMMENT_MENTIONED"
+)
+
+type ActivityTargetType string
+
+const (
+	ActivityTargetSplit         ActivityTargetType = "SPLIT"
+	ActivityTargetSettlement    ActivityTargetType = "SETTLEMENT"
+	ActivityTargetSettleUp      ActivityTargetType = "SETTLE_UP"
+	ActivityTargetGroup         ActivityTargetType = "GROUP"
+	ActivityTargetGroupMember   ActivityTargetType = "GROUP_MEMBER"
+	ActivityTargetFriendRequest ActivityTargetType = "FRIEND_REQUEST"
+	ActivityTargetFriendship    ActivityTargetType = "FRIENDSHIP"
+)
+
+type EventType string
+
+const (
+	EventSplitCreated        EventType = "SPLIT_CREATED"
+	EventSettlementPending   EventType = "SETTLEMENT_PENDING"
+	EventSettlementConfirmed EventType = "SETTLEMENT_CONFIRMED"
+	EventFriendRequest       EventType = "FRIEND_REQUEST"
+	EventGroupMembership     EventType = "GROUP_MEMBERSHIP"
+	EventCommentMentioned    EventType = "COMMENT_MENTIONED"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "DEAD"
+)
+
+func IsValidWebhookDeliveryStatus(status string) bool {
+	switch WebhookDeliveryStatus(status) {
+	case WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryDead:
+		return true
+	}
+	return false
+}
+
+type NotificationFrequency string
+
+const (
+	NotificationOff         NotificationFrequency = "OFF"
+	NotificationInstant     NotificationFrequency = "INSTANT"
+	NotificationDailyDigest NotificationFrequency = "DAILY_DIGEST"
+)
+
+func IsValidNotificationFrequency(frequency string) bool {
+	switch NotificationFrequency(frequency) {
+	case NotificationOff, NotificationInstant, NotificationDailyDigest:
+		return true
+	}
+	return false
+}
+
+type NotificationStatus string
+
+const (
+	NotificationPending NotificationStatus = "PENDING"
+	NotificationSent    NotificationStatus = "SENT"
+	NotificationFailed  NotificationStatus = "FAILED"
+)
+
+type AgingBucket string
+
+const (
+	AgingCurrent AgingBucket = "0-30"
+	Aging31To60  AgingBucket = "31-60"
+	Aging61To90  AgingBucket = "61-90"
+	AgingOver90  AgingBucket = "90+"
+)
+
+var AgingBuckets = []AgingBucket{AgingCurrent, Aging31To60, Aging61To90, AgingOver90}
+
+func AgingBucketFor(ageDays int) AgingBucket {
+	switch {
+	case ageDays <= 30:
+		return AgingCurrent
+	case ageDays <= 60:
+		return Aging31To60
+	case ageDays <= 90:
+		return Aging61To90
+	}
+	return AgingOver90
+}
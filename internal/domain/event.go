@@ -0,0 +1,52 @@
+package Domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event is a real-time notification pushed to connected clients. Events are
+// not persisted; the bus assigns the ID when the event is published.
+type Event struct {
+	ID         uint64
+	Type       EventType
+	Action     ActivityAction
+	ActorID    uuid.UUID
+	TargetType ActivityTargetType
+	TargetID   uuid.UUID
+	GroupID    *uuid.UUID
+	Data       ActivitySummary
+	Recipients []uuid.UUID
+	OccurredAt time.Time
+}
+
+// NewEventFromActivity builds an event for a recorded activity, delivered to
+// the activity's audience.
+func NewEventFromActivity(eventType EventType, activity *ActivityEvent) Event {
+	recipients := make([]uuid.UUID, len(activity.Audience))
+	for i, member := range activity.Audience {
+		recipients[i] = member.UserID
+	}
+
+	return Event{
+		Type:       eventType,
+		Action:     activity.Action,
+		ActorID:    activity.ActorID,
+		TargetType: activity.TargetType,
+		TargetID:   activity.TargetID,
+		GroupID:    activity.GroupID,
+		Data:       activity.After,
+		Recipients: recipients,
+		OccurredAt: time.Now(),
+	}
+}
+
+func (e Event) IsFor(userId uuid.UUID) bool {
+	for _, recipient := range e.Recipients {
+		if recipient == userId {
+			return true
+		}
+	}
+	return false
+}
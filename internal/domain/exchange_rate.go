@@ -0,0 +1,65 @@
+package Domain
+
+import "time"
+
+type ExchangeRate struct {
+	BaseModel
+
+	BaseCurrency  Currency  `gorm:"type:varchar(10);not null;uniqueIndex:idx_exchange_rate_pair_date" json:"base_currency"`
+	QuoteCurrency Currency  `gorm:"type:varchar(10);not null;uniqueIndex:idx_exchange_rate_pair_date" json:"quote_currency"`
+	Rate          float64   `gorm:"type:numeric(24,12);not null" json:"rate"`
+	EffectiveDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_exchange_rate_pair_date" json:"effective_date"`
+	Source        string    `gorm:"type:varchar(100)" json:"source"`
+}
+
+func (r ExchangeRate) Convert(amount int64) int64 {
+	return ConvertMinorUnits(amount, r.BaseCurrency, r.QuoteCurrency, r.Rate)
+}
+
+func (r ExchangeRate) Inverse() ExchangeRate {
+	inverse := r
+	inverse.BaseCurrency, inverse.QuoteCurrency = r.QuoteCurrency, r.BaseCurrency
+	inverse.Rate = 1 / r.Rate
+	return inverse
+}
+
+func IdentityExchangeRate(currency Currency, asOf time.Time) ExchangeRate {
+	return ExchangeRate{
+		BaseCurrency:  currency,
+		QuoteCurrency: currency,
+		Rate:          1,
+		EffectiveDate: asOf,
+	}
+}
+
+func FindExchangeRate(rates []ExchangeRate, base, quote Currency, asOf time.Time) (ExchangeRate, bool) {
+	if base == quote {
+		return IdentityExchangeRate(base, asOf), true
+	}
+
+	var best ExchangeRate
+	found := false
+	for _, r := range rates {
+		if r.EffectiveDate.After(asOf) || r.Rate <= 0 {
+			continue
+		}
+
+		var candidate ExchangeRate
+		switch {
+		case r.BaseCurrency == base && r.QuoteCurrency == quote:
+			candidate = r
+		case r.BaseCurrency == quote && r.QuoteCurrency == base:
+			candidate = r.Inverse()
+		default:
+			continue
+		}
+
+		if !found || candidate.EffectiveDate.After(best.EffectiveDate) ||
+			(candidate.EffectiveDate.Equal(best.EffectiveDate) && candidate.BaseCurrency == base) {
+			best = candidate
+			found = true
+		}
+	}
+
+	return best, found
+}
@@ -9,6 +9,8 @@ type Group struct {
 	OwnerID       uuid.UUID `gorm:"type:uuid;not null" json:"owner_id"`
 	SimplifyDebts bool      `gorm:"default:false" json:"simplify_debts"`
 
+	SettlementCurrency *Currency `gorm:"type:varchar(10);default:null" json:"settlement_currency,omitempty"`
+
 	Memberships []GroupMembership `gorm:"foreignKey:GroupID;references:Id"`
 	Splits      []Split           `gorm:"foreignKey:GroupID;references:Id"`
 	Balances    []GroupBalance    `gorm:"foreignKey:GroupID;references:Id"`
@@ -0,0 +1,25 @@
+package Domain
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// NewGuest builds a placeholder user without credentials. The optional email
+// is only used to match the guest to an account registered later.
+func NewGuest(name, email string) *User {
+	return &User{
+		Name:   name,
+		Email:  strings.ToLower(strings.TrimSpace(email)),
+		Status: AccountGuest,
+	}
+}
+
+// GuestClaimActivity records userId taking over a guest's place in a group.
+func GuestClaimActivity(userId, guestId, groupId uuid.UUID) *ActivityEvent {
+	activity := NewActivityEvent(userId, ActivityGuestClaimed, ActivityTargetGroupMember, userId, &groupId)
+	activity.Before = ActivitySummary{"guest_id": guestId.String()}
+	activity.After = ActivitySummary{"user_id": userId.String()}
+	return activity
+}
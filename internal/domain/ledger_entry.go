@@ -0,0 +1,136 @@
+package Domain
+
+import (
+	"github.com/google/uuid"
+)
+
+type LedgerEntry struct {
+	BaseModel
+
+	TransactionID  uuid.UUID        `gorm:"type:uuid;index;not null" json:"transaction_id"`
+	SourceType     LedgerSourceType `gorm:"type:varchar(30);not null" json:"source_type"`
+	SourceID       uuid.UUID        `gorm:"type:uuid;index;not null" json:"source_id"`
+	Account        LedgerAccount    `gorm:"type:varchar(10);not null" json:"account"`
+	UserID         uuid.UUID        `gorm:"type:uuid;index;not null" json:"user_id"`
+	CounterpartyID *uuid.UUID       `gorm:"type:uuid;index" json:"counterparty_id,omitempty"`
+	GroupID        *uuid.UUID       `gorm:"type:uuid;index" json:"group_id,omitempty"`
+	Amount         int64            `gorm:"not null" json:"amount"`
+	Currency       Currency         `gorm:"type:varchar(10);not null" json:"currency"`
+
+	User User `gorm:"foreignKey:UserID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+// LedgerBalance is the sum of one user's entries on a USER account with a
+// counterparty or on a GROUP account.
+type LedgerBalance struct {
+	Account        LedgerAccount
+	CounterpartyID *uuid.UUID
+	GroupID        *uuid.UUID
+	Currency       Currency
+	Amount         int64
+}
+
+// Every transaction posts two balanced legs: USER entries mirror
+// user_balances (one row per direction of each pair) and GROUP entries mirror
+// group_balances. Each leg sums to zero on its own.
+type ledgerTransaction struct {
+	id         uuid.UUID
+	sourceType LedgerSourceType
+	sourceId   uuid.UUID
+	groupId    *uuid.UUID
+	currency   Currency
+	entries    []LedgerEntry
+}
+
+func newLedgerTransaction(sourceType LedgerSourceType, sourceId uuid.UUID, groupId *uuid.UUID, currency Currency) *ledgerTransaction {
+	return &ledgerTransaction{
+		id:         uuid.New(),
+		sourceType: sourceType,
+		sourceId:   sourceId,
+		groupId:    groupId,
+		currency:   currency,
+	}
+}
+
+func (t *ledgerTransaction) entry(account LedgerAccount, userId uuid.UUID, counterpartyId *uuid.UUID, amount int64) {
+	if amount == 0 {
+		return
+	}
+	t.entries = append(t.entries, LedgerEntry{
+		TransactionID:  t.id,
+		SourceType:     t.sourceType,
+		SourceID:       t.sourceId,
+		Account:        account,
+		UserID:         userId,
+		CounterpartyID: counterpartyId,
+		GroupID:        t.groupId,
+		Amount:         amount,
+		Currency:       t.currency,
+	})
+}
+
+func (t *ledgerTransaction) pair(creditorId, debtorId uuid.UUID, amount int64) {
+	t.entry(LedgerAccountUser, creditorId, &debtorId, amount)
+	t.entry(LedgerAccountUser, debtorId, &creditorId, -amount)
+}
+
+func SplitLedgerEntries(split *Split, payers []SplitPayer, participants []SplitParticipant, sign int64) []LedgerEntry {
+	sourceType := LedgerSourceSplit
+	if sign < 0 {
+		sourceType = LedgerSourceSplitReversal
+	}
+
+	t := newLedgerTransaction(sourceType, split.Id, split.GroupID, split.Currency)
+	for _, debt := range CalculateSplitDebts(payers, participants) {
+		t.pair(debt.CreditorID, debt.DebtorID, sign*debt.Amount)
+	}
+
+	if split.GroupID != nil {
+		for _, change := range CalculateSplitNetChanges(payers, participants) {
+			t.entry(LedgerAccountGroup, change.UserID, nil, sign*change.NetAmount)
+		}
+	}
+
+	return t.entries
+}
+
+func SettlementLedgerEntries(settlement *Settlement, groupId *uuid.UUID, sign int64) []LedgerEntry {
+	sourceType := LedgerSourceSettlement
+	if sign < 0 {
+		sourceType = LedgerSourceSettlementReversal
+	}
+
+	amount := sign * settlement.Amount
+	t := newLedgerTransaction(sourceType, settlement.Id, groupId, settlement.Currency)
+	t.pair(settlement.PayerID, settlement.PayeeID, amount)
+
+	if groupId != nil {
+		t.entry(LedgerAccountGroup, settlement.PayerID, nil, amount)
+		t.entry(LedgerAccountGroup, settlement.PayeeID, nil, -amount)
+	}
+
+	return t.entries
+}
+
+// UserMergeLedgerEntries moves the balances of sourceId over to targetId
+// without touching past entries. What the two users owed each other cancels
+// out, since they are now the same person.
+func UserMergeLedgerEntries(sourceId, targetId uuid.UUID, balances []LedgerBalance) []LedgerEntry {
+	var entries []LedgerEntry
+	for _, balance := range balances {
+		t := newLedgerTransaction(LedgerSourceUserMerge, sourceId, balance.GroupID, balance.Currency)
+		switch balance.Account {
+		case LedgerAccountUser:
+			counterpartyId := *balance.CounterpartyID
+			t.pair(counterpartyId, sourceId, balance.Amount)
+			if counterpartyId != targetId {
+				t.pair(targetId, counterpartyId, balance.Amount)
+			}
+		case LedgerAccountGroup:
+			t.entry(LedgerAccountGroup, sourceId, nil, -balance.Amount)
+			t.entry(LedgerAccountGroup, targetId, nil, balance.Amount)
+		}
+		entries = append(entries, t.entries...)
+	}
+	return entries
+}
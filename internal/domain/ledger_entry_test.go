@@ -0,0 +1,139 @@
+package Domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type userBalanceKey struct {
+	userId, counterpartyId uuid.UUID
+	currency               Currency
+}
+
+type groupBalanceKey struct {
+	groupId, userId uuid.UUID
+	currency        Currency
+}
+
+// rebuildBalances sums entries the way the balance projections are rebuilt
+// from the ledger, dropping balances that come to zero.
+func rebuildBalances(entries []LedgerEntry) (map[userBalanceKey]int64, map[groupBalanceKey]int64) {
+	users := make(map[userBalanceKey]int64)
+	groups := make(map[groupBalanceKey]int64)
+	for _, entry := range entries {
+		switch entry.Account {
+		case LedgerAccountUser:
+			users[userBalanceKey{entry.UserID, *entry.CounterpartyID, entry.Currency}] += entry.Amount
+		case LedgerAccountGroup:
+			groups[groupBalanceKey{*entry.GroupID, entry.UserID, entry.Currency}] += entry.Amount
+		}
+	}
+	for key, amount := range users {
+		if amount == 0 {
+			delete(users, key)
+		}
+	}
+	for key, amount := range groups {
+		if amount == 0 {
+			delete(groups, key)
+		}
+	}
+	return users, groups
+}
+
+func TestUserMergeLedgerEntriesKeepBalances(t *testing.T) {
+	source, target, friend, groupId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	var history []LedgerEntry
+	// The source paid a group dinner shared with the friend.
+	history = append(history, SplitLedgerEntries(
+		&Split{BaseModel: BaseModel{Id: uuid.New()}, GroupID: &groupId, Currency: "USD"},
+		[]SplitPayer{{UserID: source, PaidAmount: 1000}},
+		[]SplitParticipant{{UserID: source, ShareAmount: 500}, {UserID: friend, ShareAmount: 500}}, 1)...)
+	// The target paid a group taxi shared with the source.
+	history = append(history, SplitLedgerEntries(
+		&Split{BaseModel: BaseModel{Id: uuid.New()}, GroupID: &groupId, Currency: "USD"},
+		[]SplitPayer{{UserID: target, PaidAmount: 600}},
+		[]SplitParticipant{{UserID: source, ShareAmount: 300}, {UserID: target, ShareAmount: 300}}, 1)...)
+	// The friend paid for the source and the target outside the group.
+	history = append(history, SplitLedgerEntries(
+		&Split{BaseModel: BaseModel{Id: uuid.New()}, Currency: "EUR"},
+		[]SplitPayer{{UserID: friend, PaidAmount: 300}},
+		[]SplitParticipant{{UserID: source, ShareAmount: 100}, {UserID: target, ShareAmount: 100}, {UserID: friend, ShareAmount: 100}}, 1)...)
+	history = append(history, SettlementLedgerEntries(
+		&Settlement{BaseModel: BaseModel{Id: uuid.New()}, PayerID: friend, PayeeID: source, Amount: 200, Currency: "USD"}, &groupId, 1)...)
+
+	// Before the merge, with the source counted as the target.
+	merged := make([]LedgerEntry, 0, len(history))
+	for _, entry := range history {
+		if entry.UserID == source {
+			entry.UserID = target
+		}
+		if entry.CounterpartyID != nil && *entry.CounterpartyID == source {
+			entry.CounterpartyID = &target
+		}
+		if entry.Account == LedgerAccountUser && entry.UserID == *entry.CounterpartyID {
+			continue
+		}
+		merged = append(merged, entry)
+	}
+	wantUsers, wantGroups := rebuildBalances(merged)
+
+	users, groups := rebuildBalances(history)
+	var balances []LedgerBalance
+	for key, amount := range users {
+		if key.userId == source {
+			balances = append(balances, LedgerBalance{Account: LedgerAccountUser, CounterpartyID: &key.counterpartyId, Currency: key.currency, Amount: amount})
+		}
+	}
+	for key, amount := range groups {
+		if key.userId == source {
+			balances = append(balances, LedgerBalance{Account: LedgerAccountGroup, GroupID: &key.groupId, Currency: key.currency, Amount: amount})
+		}
+	}
+
+	compensating := UserMergeLedgerEntries(source, target, balances)
+	transactions := make(map[uuid.UUID]int64)
+	for _, entry := range compensating {
+		if entry.SourceType != LedgerSourceUserMerge || entry.SourceID != source {
+			t.Errorf("entry %+v is not a merge entry", entry)
+		}
+		if entry.Account == LedgerAccountUser && entry.UserID == *entry.CounterpartyID {
+			t.Errorf("entry %+v is against the user themselves", entry)
+		}
+		transactions[entry.TransactionID] += entry.Amount
+	}
+	for id, sum := range transactions {
+		if sum != 0 {
+			t.Errorf("transaction %s nets to %d", id, sum)
+		}
+	}
+
+	gotUsers, gotGroups := rebuildBalances(append(history, compensating...))
+	if len(gotUsers) != len(wantUsers) {
+		t.Errorf("user balances = %v, want %v", gotUsers, wantUsers)
+	}
+	for key, amount := range wantUsers {
+		if gotUsers[key] != amount {
+			t.Errorf("user balance %+v = %d, want %d", key, gotUsers[key], amount)
+		}
+	}
+	if len(gotGroups) != len(wantGroups) {
+		t.Errorf("group balances = %v, want %v", gotGroups, wantGroups)
+	}
+	for key, amount := range wantGroups {
+		if gotGroups[key] != amount {
+			t.Errorf("group balance %+v = %d, want %d", key, gotGroups[key], amount)
+		}
+	}
+
+	// The friend owed the source 500 and paid 200 back; the target owes the
+	// friend 200 EUR, 100 each for the source and the target.
+	if got := gotUsers[userBalanceKey{target, friend, "USD"}]; got != 300 {
+		t.Errorf("target's USD balance with the friend = %d, want 300", got)
+	}
+	if got := gotUsers[userBalanceKey{target, friend, "EUR"}]; got != -200 {
+		t.Errorf("target's EUR balance with the friend = %d, want -200", got)
+	}
+}
@@ -1,6 +1,45 @@
 package Domain
 
+import "math/big"
+
 type MoneyAmount struct {
 	Value    int64    `gorm:"not null" json:"value"`
 	Currency Currency `gorm:"type:varchar(10);not null" json:"currency"`
 }
+
+func AllocateByWeight(totalAmount int64, weights []int64) []int64 {
+	shareAmounts := make([]int64, len(weights))
+	if totalAmount < 0 {
+		for i, share := range AllocateByWeight(-totalAmount, weights) {
+			shareAmounts[i] = -share
+		}
+		return shareAmounts
+	}
+
+	var totalWeight int64
+	for _, w := range weights {
+		totalWeight += w
+	}
+	if totalWeight <= 0 {
+		return shareAmounts
+	}
+
+	var allocated int64
+	for i, w := range weights {
+		share := new(big.Int).Mul(big.NewInt(totalAmount), big.NewInt(w))
+		share.Quo(share, big.NewInt(totalWeight))
+		shareAmounts[i] = share.Int64()
+		allocated += shareAmounts[i]
+	}
+
+	remainder := totalAmount - allocated
+	for i := 0; remainder > 0; i++ {
+		if weights[i] <= 0 {
+			continue
+		}
+		shareAmounts[i]++
+		remainder--
+	}
+
+	return shareAmounts
+}
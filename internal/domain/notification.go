@@ -0,0 +1,135 @@
+package Domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	notificationBaseRetryDelay = time.Minute
+	notificationMaxRetryDelay  = time.Hour
+)
+
+// NotificationEvents are the activity actions that can be sent by email.
+var NotificationEvents = []ActivityAction{
+	ActivitySplitCreated,
+	ActivitySettlementCreated,
+	ActivitySettlementConfirmed,
+	ActivitySettlementRejected,
+	ActivitySettlementDisputed,
+	ActivityPaymentReminded,
+	ActivityCommentMentioned,
+}
+
+func IsNotificationEvent(action ActivityAction) bool {
+	for _, event := range NotificationEvents {
+		if event == action {
+			return true
+		}
+	}
+	return false
+}
+
+type NotificationPreferences struct {
+	Frequency   NotificationFrequency `gorm:"type:varchar(20);not null;default:'INSTANT'" json:"frequency"`
+	Splits      bool                  `gorm:"not null;default:true" json:"splits"`
+	Settlements bool                  `gorm:"not null;default:true" json:"settlements"`
+	Reminders   bool                  `gorm:"not null;default:true" json:"reminders"`
+	Mentions    bool                  `gorm:"not null;default:true" json:"mentions"`
+}
+
+func DefaultNotificationPreferences() NotificationPreferences {
+	return NotificationPreferences{
+		Frequency:   NotificationInstant,
+		Splits:      true,
+		Settlements: true,
+		Reminders:   true,
+		Mentions:    true,
+	}
+}
+
+// Wants reports whether an email should be sent for the given activity.
+func (p NotificationPreferences) Wants(action ActivityAction) bool {
+	if p.Frequency == NotificationOff || !IsNotificationEvent(action) {
+		return false
+	}
+	switch action {
+	case ActivitySplitCreated:
+		return p.Splits
+	case ActivityPaymentReminded:
+		return p.Reminders
+	case ActivityCommentMentioned:
+		return p.Mentions
+	}
+	return p.Settlements
+}
+
+// Notification is an email outbox row, written with the activity it reports
+// and drained by the notification dispatcher. Digest rows wait for the next
+// daily digest instead of being sent on their own.
+type Notification struct {
+	BaseModel
+
+	UserID          uuid.UUID          `gorm:"type:uuid;index;not null;uniqueIndex:idx_notification_user_event" json:"user_id"`
+	ActivityEventID uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_notification_user_event" json:"activity_event_id"`
+	Digest          bool               `gorm:"not null;default:false" json:"digest"`
+	Status          NotificationStatus `gorm:"type:varchar(20);not null;default:'PENDING'" json:"status"`
+	Attempts        int                `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt   time.Time          `gorm:"index;not null" json:"next_attempt_at"`
+	LastError       string             `gorm:"type:varchar(1000)" json:"last_error,omitempty"`
+	SentAt          *time.Time         `gorm:"default:null" json:"sent_at,omitempty"`
+
+	User          User          `gorm:"foreignKey:UserID;references:Id;constraint:OnDelete:CASCADE"`
+	ActivityEvent ActivityEvent `gorm:"foreignKey:ActivityEventID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+func NewNotification(userId uuid.UUID, activity *ActivityEvent, preferences NotificationPreferences, now time.Time) *Notification {
+	return &Notification{
+		UserID:          userId,
+		ActivityEventID: activity.Id,
+		Digest:          preferences.Frequency == NotificationDailyDigest,
+		Status:          NotificationPending,
+		NextAttemptAt:   now,
+	}
+}
+
+func (n *Notification) MarkSent(now time.Time) {
+	n.Attempts++
+	n.Status = NotificationSent
+	n.LastError = ""
+	n.SentAt = &now
+}
+
+func (n *Notification) MarkFailed(now time.Time, reason string, maxAttempts int) {
+	n.Attempts++
+	if len(reason) > 1000 {
+		reason = reason[:1000]
+	}
+	n.LastError = reason
+
+	if n.Attempts >= maxAttempts {
+		n.Status = NotificationFailed
+		return
+	}
+
+	delay := notificationBaseRetryDelay
+	for i := 1; i < n.Attempts && delay < notificationMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > notificationMaxRetryDelay {
+		delay = notificationMaxRetryDelay
+	}
+	n.NextAttemptAt = now.Add(delay)
+}
+
+// NextDigestAt returns the first time at or after now that falls on the given
+// hour (UTC).
+func NextDigestAt(now time.Time, hour int) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	if next.Before(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
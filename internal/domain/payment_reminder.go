@@ -0,0 +1,45 @@
+package Domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentReminder records a nudge sent to a debtor about their outstanding
+// share of a split. Automatic reminders have no sender.
+type PaymentReminder struct {
+	BaseModel
+
+	SplitID   uuid.UUID  `gorm:"type:uuid;index;not null" json:"split_id"`
+	SenderID  *uuid.UUID `gorm:"type:uuid;index" json:"sender_id,omitempty"`
+	DebtorID  uuid.UUID  `gorm:"type:uuid;index;not null" json:"debtor_id"`
+	Amount    int64      `gorm:"not null" json:"amount"`
+	Currency  Currency   `gorm:"type:varchar(10);not null" json:"currency"`
+	Automatic bool       `gorm:"not null;default:false" json:"automatic"`
+
+	Split  Split `gorm:"foreignKey:SplitID;references:Id;constraint:OnDelete:CASCADE"`
+	Sender *User `gorm:"foreignKey:SenderID;references:Id;constraint:OnDelete:SET NULL"`
+	Debtor User  `gorm:"foreignKey:DebtorID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+// DroppedByUserMerge reports whether merging sourceId into targetId drops the
+// reminder, which it does when one of the two reminded the other.
+func (r PaymentReminder) DroppedByUserMerge(sourceId, targetId uuid.UUID) bool {
+	if r.SenderID == nil {
+		return false
+	}
+	return (*r.SenderID == sourceId && r.DebtorID == targetId) || (*r.SenderID == targetId && r.DebtorID == sourceId)
+}
+
+func (p SplitParticipant) Outstanding() int64 {
+	return p.ShareAmount - p.SettledAmount
+}
+
+// AgeInDays counts whole days elapsed since the given time.
+func AgeInDays(since, now time.Time) int {
+	if now.Before(since) {
+		return 0
+	}
+	return int(now.Sub(since) / (24 * time.Hour))
+}
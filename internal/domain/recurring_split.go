@@ -0,0 +1,47 @@
+package Domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecurringSplit struct {
+	BaseModel
+
+	Type          SplitType         `gorm:"type:varchar(20);not null" json:"type"`
+	DivisionType  SplitDivisionType `gorm:"type:varchar(20);not null" json:"division_type"`
+	TotalAmount   int64             `gorm:"not null" json:"total_amount"`
+	Currency      Currency          `gorm:"type:varchar(10);not null" json:"currency"`
+	Description   string            `gorm:"type:varchar(500)" json:"description"`
+	SimplifyDebts *bool             `gorm:"default:null" json:"simplify_debts"`
+
+	Cadence        RecurrenceCadence `gorm:"type:varchar(20);not null" json:"cadence"`
+	CronExpression string            `gorm:"type:varchar(100)" json:"cron_expression,omitempty"`
+	StartDate      time.Time         `gorm:"not null" json:"start_date"`
+	EndDate        *time.Time        `gorm:"default:null" json:"end_date,omitempty"`
+	NextRunAt      time.Time         `gorm:"index;not null" json:"next_run_at"`
+	LastRunAt      *time.Time        `gorm:"default:null" json:"last_run_at,omitempty"`
+	IsActive       bool              `gorm:"not null;default:true" json:"is_active"`
+
+	GroupID *uuid.UUID `gorm:"type:uuid;index" json:"group_id,omitempty"`
+	Group   *Group     `gorm:"foreignKey:GroupID;references:Id;constraint:OnDelete:CASCADE"`
+
+	CreatedByID uuid.UUID `gorm:"type:uuid;index;not null" json:"created_by_id"`
+	CreatedBy   User      `gorm:"foreignKey:CreatedByID;references:Id;constraint:OnDelete:CASCADE"`
+
+	Participants []RecurringSplitParticipant `gorm:"foreignKey:RecurringSplitID;references:Id"`
+}
+
+type RecurringSplitParticipant struct {
+	BaseModel
+
+	RecurringSplitID uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_recurring_split_user" json:"recurring_split_id"`
+	UserID           uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_recurring_split_user" json:"user_id"`
+	ShareAmount      int64     `gorm:"not null;default:0" json:"share_amount"`
+	Percentage       float64   `gorm:"not null;default:0" json:"percentage"`
+	Shares           int64     `gorm:"not null;default:0" json:"shares"`
+
+	RecurringSplit RecurringSplit `gorm:"foreignKey:RecurringSplitID;references:Id;constraint:OnDelete:CASCADE"`
+	User           User           `gorm:"foreignKey:UserID;references:Id;constraint:OnDelete:CASCADE"`
+}
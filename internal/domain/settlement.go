@@ -9,16 +9,33 @@ import (
 type Settlement struct {
 	BaseModel
 
-	SplitID        uuid.UUID `gorm:"type:uuid;index;not null" json:"split_id"`
-	PayerID        uuid.UUID `gorm:"type:uuid;index;not null" json:"payer_id"`
-	PayeeID        uuid.UUID `gorm:"type:uuid;index;not null" json:"payee_id"`
-	Amount         int64     `gorm:"not null" json:"amount"`
-	Currency       Currency  `gorm:"type:varchar(10);not null" json:"currency"`
-	Date           time.Time `gorm:"not null" json:"date"`
-	Confirmed      bool      `gorm:"not null;default:false" json:"confirmed"`
-	IdempotencyKey *string   `gorm:"type:varchar(64);uniqueIndex" json:"idempotency_key,omitempty"`
+	SplitID        *uuid.UUID       `gorm:"type:uuid;index" json:"split_id,omitempty"`
+	GroupID        *uuid.UUID       `gorm:"type:uuid;index" json:"group_id,omitempty"`
+	PayerID        uuid.UUID        `gorm:"type:uuid;index;not null" json:"payer_id"`
+	PayeeID        uuid.UUID        `gorm:"type:uuid;index;not null" json:"payee_id"`
+	Amount         int64            `gorm:"not null" json:"amount"`
+	Currency       Currency         `gorm:"type:varchar(10);not null" json:"currency"`
+	Date           time.Time        `gorm:"not null" json:"date"`
+	Confirmed      bool             `gorm:"not null;default:false" json:"confirmed"`
+	Status         SettlementStatus `gorm:"type:varchar(20);not null;default:PENDING;index" json:"status"`
+	StatusReason   *string          `gorm:"type:varchar(500)" json:"status_reason,omitempty"`
+	IdempotencyKey *string          `gorm:"type:varchar(64);uniqueIndex" json:"idempotency_key,omitempty"`
+	SettleUpID     *uuid.UUID       `gorm:"type:uuid;index" json:"settle_up_id,omitempty"`
 
 	Split Split `gorm:"foreignKey:SplitID;references:Id;constraint:OnDelete:CASCADE"`
 	Payer User  `gorm:"foreignKey:PayerID;references:Id;constraint:OnDelete:CASCADE"`
 	Payee User  `gorm:"foreignKey:PayeeID;references:Id;constraint:OnDelete:CASCADE"`
 }
+
+func (s Settlement) IsDirect() bool {
+	return s.SplitID == nil
+}
+
+// DroppedByUserMerge reports whether merging sourceId into targetId drops the
+// settlement. Open settlements between the two would turn into settlements
+// with oneself that nobody can resolve. Confirmed ones are kept; the merge's
+// ledger entries already cancel what they moved.
+func (s Settlement) DroppedByUserMerge(sourceId, targetId uuid.UUID) bool {
+	between := (s.PayerID == sourceId && s.PayeeID == targetId) || (s.PayerID == targetId && s.PayeeID == sourceId)
+	return between && s.Status != SettlementConfirmed
+}
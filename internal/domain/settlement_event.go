@@ -0,0 +1,18 @@
+package Domain
+
+import (
+	"github.com/google/uuid"
+)
+
+type SettlementEvent struct {
+	BaseModel
+
+	SettlementID uuid.UUID        `gorm:"type:uuid;index;not null" json:"settlement_id"`
+	ActorID      uuid.UUID        `gorm:"type:uuid;index;not null" json:"actor_id"`
+	FromStatus   SettlementStatus `gorm:"type:varchar(20);not null" json:"from_status"`
+	ToStatus     SettlementStatus `gorm:"type:varchar(20);not null" json:"to_status"`
+	Reason       *string          `gorm:"type:varchar(500)" json:"reason,omitempty"`
+
+	Settlement Settlement `gorm:"foreignKey:SettlementID;references:Id;constraint:OnDelete:CASCADE"`
+	Actor      User       `gorm:"foreignKey:ActorID;references:Id;constraint:OnDelete:CASCADE"`
+}
@@ -0,0 +1,211 @@
+package Simplification
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// ExactLimit is the largest number of non-zero balances solved exactly.
+// The exact search is O(2^n * n), so larger groups fall back to a
+// near-minimal heuristic.
+const ExactLimit = 18
+
+type Balance struct {
+	UserID uuid.UUID
+	Amount int64
+}
+
+type Transfer struct {
+	FromUserID uuid.UUID
+	ToUserID   uuid.UUID
+	Amount     int64
+}
+
+// Simplify returns a set of transfers that settles the given net balances
+// (positive = owed money, negative = owes money). The result is the same
+// for any ordering of the input.
+//
+// A set of n balances split into k disjoint zero-sum subsets needs exactly
+// n-k transfers, so the minimum is reached by maximising k. Up to
+// ExactLimit balances this is solved exactly; above it, equal and opposite
+// balances are paired first and the rest is settled largest-first.
+func Simplify(balances []Balance) []Transfer {
+	entries := normalize(balances)
+	if len(entries) == 0 {
+		return []Transfer{}
+	}
+
+	var subsets [][]Balance
+	if len(entries) <= ExactLimit {
+		subsets = zeroSumPartition(entries)
+	} else {
+		subsets = pairOpposites(entries)
+	}
+
+	transfers := []Transfer{}
+	for _, subset := range subsets {
+		transfers = append(transfers, settle(subset)...)
+	}
+	return transfers
+}
+
+func normalize(balances []Balance) []Balance {
+	totals := make(map[uuid.UUID]int64, len(balances))
+	for _, b := range balances {
+		totals[b.UserID] += b.Amount
+	}
+
+	entries := make([]Balance, 0, len(totals))
+	for userId, amount := range totals {
+		if amount != 0 {
+			entries = append(entries, Balance{UserID: userId, Amount: amount})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return less(entries[i], entries[j])
+	})
+	return entries
+}
+
+func less(a, b Balance) bool {
+	if abs(a.Amount) != abs(b.Amount) {
+		return abs(a.Amount) > abs(b.Amount)
+	}
+	return bytes.Compare(a.UserID[:], b.UserID[:]) < 0
+}
+
+func zeroSumPartition(entries []Balance) [][]Balance {
+	n := len(entries)
+	full := 1<<n - 1
+
+	sums := make([]int64, full+1)
+	groups := make([]int8, full+1)
+	for mask := 1; mask <= full; mask++ {
+		low := lowestBit(mask)
+		sums[mask] = sums[mask&^(1<<low)] + entries[low].Amount
+
+		best := int8(-1)
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 && groups[mask&^(1<<i)] > best {
+				best = groups[mask&^(1<<i)]
+			}
+		}
+		if sums[mask] == 0 {
+			best++
+		}
+		groups[mask] = best
+	}
+
+	// Walk back from the full set, removing one member at a time while
+	// keeping the optimum; every time the remaining prefix sums to zero a
+	// zero-sum subset has been closed off.
+	var subsets [][]Balance
+	var current []Balance
+	for mask := full; mask != 0; {
+		if sums[mask] == 0 && len(current) > 0 {
+			subsets = append(subsets, current)
+			current = nil
+		}
+
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			rest := mask &^ (1 << i)
+			gain := int8(0)
+			if sums[mask] == 0 {
+				gain = 1
+			}
+			if groups[rest]+gain == groups[mask] {
+				current = append(current, entries[i])
+				mask = rest
+				break
+			}
+		}
+	}
+	if len(current) > 0 {
+		subsets = append(subsets, current)
+	}
+	return subsets
+}
+
+func pairOpposites(entries []Balance) [][]Balance {
+	used := make([]bool, len(entries))
+	var subsets [][]Balance
+
+	for i := range entries {
+		if used[i] {
+			continue
+		}
+		for j := i + 1; j < len(entries); j++ {
+			if !used[j] && entries[i].Amount+entries[j].Amount == 0 {
+				used[i], used[j] = true, true
+				subsets = append(subsets, []Balance{entries[i], entries[j]})
+				break
+			}
+		}
+	}
+
+	var rest []Balance
+	for i, e := range entries {
+		if !used[i] {
+			rest = append(rest, e)
+		}
+	}
+	if len(rest) > 0 {
+		subsets = append(subsets, rest)
+	}
+	return subsets
+}
+
+func settle(subset []Balance) []Transfer {
+	var creditors, debtors []Balance
+	for _, b := range subset {
+		if b.Amount > 0 {
+			creditors = append(creditors, b)
+		} else {
+			debtors = append(debtors, Balance{UserID: b.UserID, Amount: -b.Amount})
+		}
+	}
+
+	var transfers []Transfer
+	for len(creditors) > 0 && len(debtors) > 0 {
+		sort.Slice(creditors, func(i, j int) bool { return less(creditors[i], creditors[j]) })
+		sort.Slice(debtors, func(i, j int) bool { return less(debtors[i], debtors[j]) })
+
+		amount := min(debtors[0].Amount, creditors[0].Amount)
+		transfers = append(transfers, Transfer{
+			FromUserID: debtors[0].UserID,
+			ToUserID:   creditors[0].UserID,
+			Amount:     amount,
+		})
+
+		debtors[0].Amount -= amount
+		creditors[0].Amount -= amount
+		if debtors[0].Amount == 0 {
+			debtors = debtors[1:]
+		}
+		if creditors[0].Amount == 0 {
+			creditors = creditors[1:]
+		}
+	}
+	return transfers
+}
+
+func lowestBit(mask int) int {
+	i := 0
+	for mask&1 == 0 {
+		mask >>= 1
+		i++
+	}
+	return i
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
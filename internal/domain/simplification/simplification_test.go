@@ -0,0 +1,148 @@
+package Simplification
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func user(n int) uuid.UUID {
+	return uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-%012d", n))
+}
+
+func balances(amounts ...int64) []Balance {
+	result := make([]Balance, len(amounts))
+	for i, amount := range amounts {
+		result[i] = Balance{UserID: user(i + 1), Amount: amount}
+	}
+	return result
+}
+
+func assertSettles(t *testing.T, input []Balance, transfers []Transfer) {
+	t.Helper()
+
+	remaining := make(map[uuid.UUID]int64)
+	for _, b := range input {
+		remaining[b.UserID] += b.Amount
+	}
+	for _, tr := range transfers {
+		if tr.Amount <= 0 {
+			t.Fatalf("non-positive transfer %+v", tr)
+		}
+		if tr.FromUserID == tr.ToUserID {
+			t.Fatalf("self transfer %+v", tr)
+		}
+		remaining[tr.FromUserID] += tr.Amount
+		remaining[tr.ToUserID] -= tr.Amount
+	}
+	for userId, amount := range remaining {
+		if amount != 0 {
+			t.Fatalf("user %s left with %d", userId, amount)
+		}
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		name      string
+		balances  []Balance
+		transfers int
+	}{
+		{"empty", nil, 0},
+		{"all settled", balances(0, 0, 0), 0},
+		{"single pair", balances(500, -500), 1},
+		{"one creditor many debtors", balances(900, -300, -300, -300), 3},
+		{"one debtor many creditors", balances(-600, 100, 200, 300), 3},
+		{"two independent pairs", balances(100, -100, 250, -250), 2},
+		// Settling largest-first without partitioning needs 5 transfers here.
+		{"greedy is not minimal", balances(19, 9, -9, -8, -7, -4), 4},
+		{"hidden zero-sum subsets", balances(5, 4, 3, -5, -4, -3), 3},
+		{"mixed subset sizes", balances(10, -4, -6, 7, -7, 2, 3, -5), 5},
+		{"three-way subsets", balances(6, -2, -4, 9, -5, -4), 4},
+		{"duplicate users are merged", []Balance{
+			{UserID: user(1), Amount: 200},
+			{UserID: user(1), Amount: 300},
+			{UserID: user(2), Amount: -500},
+		}, 1},
+		{"ten-way chain", balances(45, -1, -2, -3, -4, -5, -6, -7, -8, -9), 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfers := Simplify(tt.balances)
+			if len(transfers) != tt.transfers {
+				t.Fatalf("expected %d transfers, got %d: %+v", tt.transfers, len(transfers), transfers)
+			}
+			assertSettles(t, tt.balances, transfers)
+		})
+	}
+}
+
+func TestSimplifyIsDeterministic(t *testing.T) {
+	input := balances(120, -75, 40, -30, -55, 15, -15)
+	expected := Simplify(input)
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		shuffled := append([]Balance(nil), input...)
+		rng.Shuffle(len(shuffled), func(a, b int) { shuffled[a], shuffled[b] = shuffled[b], shuffled[a] })
+
+		if got := Simplify(shuffled); !reflect.DeepEqual(got, expected) {
+			t.Fatalf("order %d produced %+v, expected %+v", i, got, expected)
+		}
+	}
+}
+
+func TestSimplifyLargeGroups(t *testing.T) {
+	tests := []struct {
+		name      string
+		pairs     int
+		transfers int
+	}{
+		{"pairs above exact limit", ExactLimit, ExactLimit},
+		{"fifty pairs", 50, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var input []Balance
+			for i := 0; i < tt.pairs; i++ {
+				amount := int64(100 + i)
+				input = append(input,
+					Balance{UserID: user(2*i + 1), Amount: amount},
+					Balance{UserID: user(2*i + 2), Amount: -amount},
+				)
+			}
+
+			transfers := Simplify(input)
+			if len(transfers) != tt.transfers {
+				t.Fatalf("expected %d transfers, got %d", tt.transfers, len(transfers))
+			}
+			assertSettles(t, input, transfers)
+		})
+	}
+}
+
+func TestSimplifyRandomGroupsNeverExceedNMinusOne(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for round := 0; round < 200; round++ {
+		n := 2 + rng.Intn(ExactLimit+10)
+		input := make([]Balance, n)
+		var total int64
+		for i := 0; i < n-1; i++ {
+			amount := rng.Int63n(2000) - 1000
+			input[i] = Balance{UserID: user(i + 1), Amount: amount}
+			total += amount
+		}
+		input[n-1] = Balance{UserID: user(n), Amount: -total}
+
+		transfers := Simplify(input)
+		if len(transfers) > n-1 {
+			t.Fatalf("round %d: %d transfers for %d users", round, len(transfers), n)
+		}
+		assertSettles(t, input, transfers)
+	}
+}
@@ -13,14 +13,27 @@ type Split struct {
 	Currency       Currency          `gorm:"type:varchar(10);not null" json:"currency"`
 	Description    string            `gorm:"type:varchar(500)" json:"description"`
 	SimplifyDebts  *bool             `gorm:"default:null" json:"simplify_debts"`
-	IdempotencyKey *string           `gorm:"type:varchar(64);uniqueIndex" json:"idempotency_key,omitempty"`
+	IdempotencyKey *string           `gorm:"type:varchar(64);uniqueIndex:idx_splits_active_idempotency_key,where:deleted_at IS NULL" json:"idempotency_key,omitempty"`
+
+	TaxAmount           int64 `gorm:"not null;default:0" json:"tax_amount"`
+	TipAmount           int64 `gorm:"not null;default:0" json:"tip_amount"`
+	ServiceChargeAmount int64 `gorm:"not null;default:0" json:"service_charge_amount"`
 
 	GroupID *uuid.UUID `gorm:"type:uuid;index" json:"group_id,omitempty"`
 	Group   *Group     `gorm:"foreignKey:GroupID;references:Id;constraint:OnDelete:SET NULL"`
 
+	CategoryID *uuid.UUID `gorm:"type:uuid;index" json:"category_id,omitempty"`
+	Category   *Category  `gorm:"foreignKey:CategoryID;references:Id;constraint:OnDelete:SET NULL"`
+
 	CreatedByID uuid.UUID `gorm:"type:uuid;index;not null" json:"created_by_id"`
 	CreatedBy   User      `gorm:"foreignKey:CreatedByID;references:Id;constraint:OnDelete:CASCADE"`
 
+	Items        []SplitItem        `gorm:"foreignKey:SplitID;references:Id"`
+	Payers       []SplitPayer       `gorm:"foreignKey:SplitID;references:Id"`
 	Participants []SplitParticipant `gorm:"foreignKey:SplitID;references:Id"`
 	Settlements  []Settlement       `gorm:"foreignKey:SplitID;references:Id"`
 }
+
+func (split Split) ChargesAmount() int64 {
+	return split.TaxAmount + split.TipAmount + split.ServiceChargeAmount
+}
@@ -0,0 +1,45 @@
+package Domain
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultMaxAttachmentSize = 10 << 20
+	MaxAttachmentsPerSplit   = 20
+)
+
+// attachmentContentTypes are the types accepted for receipts, keyed by the
+// type sniffed from the upload rather than the one the client declared.
+var attachmentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+func IsAllowedAttachmentType(contentType string) bool {
+	return attachmentContentTypes[contentType]
+}
+
+// SplitAttachment is a file, usually a photo of the bill, kept with a split.
+// The content lives in the blob store under StorageKey.
+type SplitAttachment struct {
+	BaseModel
+
+	SplitID      uuid.UUID `gorm:"type:uuid;index;not null" json:"split_id"`
+	UploadedByID uuid.UUID `gorm:"type:uuid;index;not null" json:"uploaded_by_id"`
+	FileName     string    `gorm:"type:varchar(255);not null" json:"file_name"`
+	ContentType  string    `gorm:"type:varchar(100);not null" json:"content_type"`
+	Size         int64     `gorm:"not null" json:"size"`
+	Checksum     string    `gorm:"type:varchar(64);not null" json:"checksum"`
+	StorageKey   string    `gorm:"type:varchar(255);uniqueIndex;not null" json:"-"`
+
+	Split      Split `gorm:"foreignKey:SplitID;references:Id;constraint:OnDelete:CASCADE"`
+	UploadedBy User  `gorm:"foreignKey:UploadedByID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+func AttachmentStorageKey(splitId, attachmentId uuid.UUID) string {
+	return "splits/" + splitId.String() + "/" + attachmentId.String()
+}
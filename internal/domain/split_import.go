@@ -0,0 +1,62 @@
+package Domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const MaxImportRows = 1000
+
+// SplitImportKey is the idempotency key of an imported row. It depends on the
+// group and the row's fields rather than its position, so a file can be
+// re-uploaded after rows before it were fixed or removed. occurrence tells
+// identical rows in the same file apart.
+func SplitImportKey(groupId uuid.UUID, fields []string, occurrence int) string {
+	hash := sha256.New()
+	hash.Write([]byte("split-import\x00" + groupId.String() + "\x00" + strconv.Itoa(occurrence)))
+	for _, field := range fields {
+		hash.Write([]byte("\x00" + strings.TrimSpace(field)))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// SplitwiseShares rebuilds what each member paid and owed from a Splitwise
+// export, which only records every member's net: paid minus share. Members
+// with a positive net paid. The part of the cost that the positive nets do not
+// account for is the payers' own share, divided equally between them, which
+// is exact for the usual case of a single payer. Every member's net is kept.
+func SplitwiseShares(cost int64, nets []int64) (paid, shares []int64, ok bool) {
+	var owed, total int64
+	var payers []int
+	for i, net := range nets {
+		total += net
+		if net > 0 {
+			owed += net
+			payers = append(payers, i)
+		}
+	}
+	if total != 0 || len(payers) == 0 || owed > cost {
+		return nil, nil, false
+	}
+
+	paid = make([]int64, len(nets))
+	shares = make([]int64, len(nets))
+	own := cost - owed
+	for n, i := range payers {
+		shares[i] = own / int64(len(payers))
+		if int64(n) < own%int64(len(payers)) {
+			shares[i]++
+		}
+		paid[i] = nets[i] + shares[i]
+	}
+	for i, net := range nets {
+		if net < 0 {
+			shares[i] = -net
+		}
+	}
+	return paid, shares, true
+}
@@ -0,0 +1,59 @@
+package Domain
+
+import (
+	"github.com/google/uuid"
+)
+
+type SplitItem struct {
+	BaseModel
+
+	SplitID  uuid.UUID `gorm:"type:uuid;index;not null" json:"split_id"`
+	Name     string    `gorm:"type:varchar(200);not null" json:"name"`
+	Amount   int64     `gorm:"not null" json:"amount"`
+	Quantity int64     `gorm:"not null;default:1" json:"quantity"`
+
+	Split     Split               `gorm:"foreignKey:SplitID;references:Id;constraint:OnDelete:CASCADE"`
+	Assignees []SplitItemAssignee `gorm:"foreignKey:SplitItemID;references:Id"`
+}
+
+type SplitItemAssignee struct {
+	BaseModel
+
+	SplitItemID uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_split_item_user" json:"split_item_id"`
+	UserID      uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_split_item_user" json:"user_id"`
+
+	SplitItem SplitItem `gorm:"foreignKey:SplitItemID;references:Id;constraint:OnDelete:CASCADE"`
+	User      User      `gorm:"foreignKey:UserID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+func (item SplitItem) LineTotal() int64 {
+	return item.Amount * item.Quantity
+}
+
+func CalculateItemizedShares(items []SplitItem, participantIds []uuid.UUID, charges int64) []int64 {
+	indexByUser := make(map[uuid.UUID]int, len(participantIds))
+	for i, id := range participantIds {
+		indexByUser[id] = i
+	}
+
+	subtotals := make([]int64, len(participantIds))
+	for _, item := range items {
+		weights := make([]int64, len(item.Assignees))
+		for i := range weights {
+			weights[i] = 1
+		}
+
+		portions := AllocateByWeight(item.LineTotal(), weights)
+		for i, assignee := range item.Assignees {
+			subtotals[indexByUser[assignee.UserID]] += portions[i]
+		}
+	}
+
+	chargePortions := AllocateByWeight(charges, subtotals)
+
+	shares := make([]int64, len(participantIds))
+	for i := range shares {
+		shares[i] = subtotals[i] + chargePortions[i]
+	}
+	return shares
+}
@@ -10,6 +10,8 @@ type SplitParticipant struct {
 	SplitID       uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_split_user" json:"split_id"`
 	UserID        uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_split_user" json:"user_id"`
 	ShareAmount   int64     `gorm:"not null" json:"share_amount"`
+	Percentage    float64   `gorm:"not null;default:0" json:"percentage"`
+	Shares        int64     `gorm:"not null;default:0" json:"shares"`
 	SettledAmount int64     `gorm:"not null;default:0" json:"settled_amount"`
 	Currency      Currency  `gorm:"type:varchar(10);not null" json:"currency"`
 	IsSettled     bool      `gorm:"not null;default:false" json:"is_settled"`
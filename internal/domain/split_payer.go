@@ -0,0 +1,97 @@
+package Domain
+
+import (
+	"github.com/google/uuid"
+)
+
+type SplitPayer struct {
+	BaseModel
+
+	SplitID    uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_split_payer" json:"split_id"`
+	UserID     uuid.UUID `gorm:"type:uuid;index;not null;uniqueIndex:idx_split_payer" json:"user_id"`
+	PaidAmount int64     `gorm:"not null" json:"paid_amount"`
+	Currency   Currency  `gorm:"type:varchar(10);not null" json:"currency"`
+
+	Split Split `gorm:"foreignKey:SplitID;references:Id;constraint:OnDelete:CASCADE"`
+	User  User  `gorm:"foreignKey:UserID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+type SplitDebt struct {
+	DebtorID   uuid.UUID
+	CreditorID uuid.UUID
+	Amount     int64
+}
+
+type SplitNetChange struct {
+	UserID    uuid.UUID
+	NetAmount int64
+}
+
+func AllocateShareToPayers(shareAmount int64, payers []SplitPayer) []int64 {
+	weights := make([]int64, len(payers))
+	for i, payer := range payers {
+		weights[i] = payer.PaidAmount
+	}
+	return AllocateByWeight(shareAmount, weights)
+}
+
+func SelfPaidShare(participant SplitParticipant, payers []SplitPayer) int64 {
+	portions := AllocateShareToPayers(participant.ShareAmount, payers)
+	for i, payer := range payers {
+		if payer.UserID == participant.UserID {
+			return portions[i]
+		}
+	}
+	return 0
+}
+
+func OwedToPayer(participant SplitParticipant, payers []SplitPayer, payerId uuid.UUID) int64 {
+	portions := AllocateShareToPayers(participant.ShareAmount, payers)
+	for i, payer := range payers {
+		if payer.UserID == payerId && payer.UserID != participant.UserID {
+			return portions[i]
+		}
+	}
+	return 0
+}
+
+func CalculateSplitDebts(payers []SplitPayer, participants []SplitParticipant) []SplitDebt {
+	debts := make([]SplitDebt, 0)
+	for _, participant := range participants {
+		portions := AllocateShareToPayers(participant.ShareAmount, payers)
+		for i, payer := range payers {
+			if payer.UserID == participant.UserID || portions[i] == 0 {
+				continue
+			}
+			debts = append(debts, SplitDebt{
+				DebtorID:   participant.UserID,
+				CreditorID: payer.UserID,
+				Amount:     portions[i],
+			})
+		}
+	}
+	return debts
+}
+
+func CalculateSplitNetChanges(payers []SplitPayer, participants []SplitParticipant) []SplitNetChange {
+	changes := make([]SplitNetChange, 0, len(participants)+len(payers))
+	indexByUser := make(map[uuid.UUID]int)
+
+	add := func(userId uuid.UUID, amount int64) {
+		if i, exists := indexByUser[userId]; exists {
+			changes[i].NetAmount += amount
+			return
+		}
+		indexByUser[userId] = len(changes)
+		changes = append(changes, SplitNetChange{UserID: userId, NetAmount: amount})
+	}
+
+	for _, participant := range participants {
+		add(participant.UserID, -participant.ShareAmount)
+	}
+	for _, payer := range payers {
+		add(payer.UserID, payer.PaidAmount)
+	}
+
+	return changes
+}
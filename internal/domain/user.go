@@ -1,11 +1,16 @@
 package Domain
 
+import "github.com/google/uuid"
+
 type User struct {
 	BaseModel
 
-	Email  string        `gorm:"uniqueIndex;not null" json:"email"`
-	Name   string        `json:"name"`
-	Status AccountStatus `gorm:"type:varchar(20);not null" json:"status"`
+	Email        string        `gorm:"uniqueIndex:idx_users_registered_email,where:status <> 'GUEST' AND merged_into_id IS NULL;not null" json:"email"`
+	Name         string        `json:"name"`
+	Status       AccountStatus `gorm:"type:varchar(20);not null" json:"status"`
+	MergedIntoID *uuid.UUID    `gorm:"type:uuid;index" json:"merged_into_id,omitempty"`
+
+	Notifications NotificationPreferences `gorm:"embedded;embeddedPrefix:notify_" json:"notifications"`
 
 	Credential Credential `gorm:"foreignKey:UserID;references:Id"`
 
@@ -24,4 +29,11 @@ type AccountStatus string
 const (
 	AccountActive      AccountStatus = "ACTIVE"
 	AccountDeactivated AccountStatus = "DEACTIVATED"
+	AccountGuest       AccountStatus = "GUEST"
 )
+
+// IsGuest reports whether the user is a placeholder created by a group admin
+// for someone without an account.
+func (u User) IsGuest() bool {
+	return u.Status == AccountGuest
+}
@@ -0,0 +1,46 @@
+package Domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestUserMergeDropsWhatIsOpenBetweenTheUsers(t *testing.T) {
+	source, target, friend := uuid.New(), uuid.New(), uuid.New()
+
+	settlements := []struct {
+		name       string
+		settlement Settlement
+		dropped    bool
+	}{
+		{"pending from source to target", Settlement{PayerID: source, PayeeID: target, Status: SettlementPending}, true},
+		{"pending from target to source", Settlement{PayerID: target, PayeeID: source, Status: SettlementPending}, true},
+		{"rejected between them", Settlement{PayerID: source, PayeeID: target, Status: SettlementRejected}, true},
+		{"disputed between them", Settlement{PayerID: target, PayeeID: source, Status: SettlementDisputed}, true},
+		{"confirmed between them", Settlement{PayerID: source, PayeeID: target, Status: SettlementConfirmed}, false},
+		{"pending with a friend", Settlement{PayerID: source, PayeeID: friend, Status: SettlementPending}, false},
+		{"pending between the target and a friend", Settlement{PayerID: friend, PayeeID: target, Status: SettlementPending}, false},
+	}
+	for _, tt := range settlements {
+		if got := tt.settlement.DroppedByUserMerge(source, target); got != tt.dropped {
+			t.Errorf("%s: dropped = %v, want %v", tt.name, got, tt.dropped)
+		}
+	}
+
+	reminders := []struct {
+		name     string
+		reminder PaymentReminder
+		dropped  bool
+	}{
+		{"source reminded target", PaymentReminder{SenderID: &source, DebtorID: target}, true},
+		{"target reminded source", PaymentReminder{SenderID: &target, DebtorID: source}, true},
+		{"source reminded a friend", PaymentReminder{SenderID: &source, DebtorID: friend}, false},
+		{"automatic reminder to source", PaymentReminder{DebtorID: source}, false},
+	}
+	for _, tt := range reminders {
+		if got := tt.reminder.DroppedByUserMerge(source, target); got != tt.dropped {
+			t.Errorf("%s: dropped = %v, want %v", tt.name, got, tt.dropped)
+		}
+	}
+}
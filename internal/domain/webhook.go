@@ -0,0 +1,218 @@
+package Domain
+
+import (
+	"encoding/json"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	webhookBaseRetryDelay = 30 * time.Second
+	webhookMaxRetryDelay  = 6 * time.Hour
+)
+
+// WebhookEvents are the activity actions that can be delivered to webhooks.
+var WebhookEvents = []ActivityAction{
+	ActivitySplitCreated,
+	ActivitySplitUpdated,
+	ActivitySplitReversed,
+	ActivitySettlementCreated,
+	ActivitySettlementConfirmed,
+	ActivitySettlementRejected,
+	ActivitySettlementDisputed,
+	ActivitySettlementDeleted,
+}
+
+// internalWebhookPrefixes are non-public ranges the net/netip predicates do
+// not cover: "this network" and carrier-grade NAT, which some clouds use for
+// metadata services.
+var internalWebhookPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// IsPublicWebhookAddress reports whether webhooks may be delivered to addr.
+// Loopback, private, link-local and other internal addresses are refused so a
+// subscription cannot reach services inside the deployment.
+func IsPublicWebhookAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() || addr.IsMulticast() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, prefix := range internalWebhookPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+func IsWebhookEvent(action ActivityAction) bool {
+	for _, event := range WebhookEvents {
+		if event == action {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSubscription is scoped to a group when GroupID is set, otherwise to
+// activity involving its owner. An empty Events list subscribes to every
+// webhook event.
+type WebhookSubscription struct {
+	BaseModel
+
+	OwnerID     uuid.UUID  `gorm:"type:uuid;index;not null" json:"owner_id"`
+	GroupID     *uuid.UUID `gorm:"type:uuid;index" json:"group_id,omitempty"`
+	URL         string     `gorm:"type:varchar(2048);not null" json:"url"`
+	Secret      string     `gorm:"type:varchar(100);not null" json:"-"`
+	Events      string     `gorm:"type:varchar(1000);not null;default:''" json:"events"`
+	Description string     `gorm:"type:varchar(500)" json:"description"`
+	Active      bool       `gorm:"not null;default:true" json:"active"`
+
+	Owner User   `gorm:"foreignKey:OwnerID;references:Id;constraint:OnDelete:CASCADE"`
+	Group *Group `gorm:"foreignKey:GroupID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+func JoinWebhookEvents(events []ActivityAction) string {
+	values := make([]string, len(events))
+	for i, event := range events {
+		values[i] = string(event)
+	}
+	return strings.Join(values, ",")
+}
+
+func (s *WebhookSubscription) EventList() []ActivityAction {
+	if s.Events == "" {
+		return []ActivityAction{}
+	}
+	values := strings.Split(s.Events, ",")
+	events := make([]ActivityAction, len(values))
+	for i, value := range values {
+		events[i] = ActivityAction(value)
+	}
+	return events
+}
+
+func (s *WebhookSubscription) Matches(action ActivityAction) bool {
+	if !IsWebhookEvent(action) {
+		return false
+	}
+	if s.Events == "" {
+		return true
+	}
+	for _, event := range s.EventList() {
+		if event == action {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is an outbox row, written in the same transaction as the
+// change it describes and drained by the webhook dispatcher.
+type WebhookDelivery struct {
+	BaseModel
+
+	SubscriptionID  uuid.UUID             `gorm:"type:uuid;index;not null;uniqueIndex:idx_webhook_delivery_event" json:"subscription_id"`
+	ActivityEventID uuid.UUID             `gorm:"type:uuid;not null;uniqueIndex:idx_webhook_delivery_event" json:"activity_event_id"`
+	EventType       ActivityAction        `gorm:"type:varchar(40);not null" json:"event_type"`
+	Payload         string                `gorm:"type:jsonb;not null" json:"payload"`
+	Status          WebhookDeliveryStatus `gorm:"type:varchar(20);not null;default:'PENDING'" json:"status"`
+	Attempts        int                   `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt   time.Time             `gorm:"index;not null" json:"next_attempt_at"`
+	LastAttemptAt   *time.Time            `gorm:"default:null" json:"last_attempt_at,omitempty"`
+	LastStatusCode  *int                  `gorm:"default:null" json:"last_status_code,omitempty"`
+	LastError       string                `gorm:"type:varchar(1000)" json:"last_error,omitempty"`
+	DeliveredAt     *time.Time            `gorm:"default:null" json:"delivered_at,omitempty"`
+
+	Subscription WebhookSubscription `gorm:"foreignKey:SubscriptionID;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+type webhookPayload struct {
+	ID         string          `json:"id"`
+	Event      ActivityAction  `json:"event"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	ActorID    string          `json:"actor_id"`
+	TargetType string          `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	GroupID    *string         `json:"group_id,omitempty"`
+	Before     ActivitySummary `json:"before,omitempty"`
+	After      ActivitySummary `json:"after,omitempty"`
+}
+
+func NewWebhookDelivery(subscription *WebhookSubscription, activity *ActivityEvent, now time.Time) (*WebhookDelivery, error) {
+	payload := webhookPayload{
+		ID:         activity.Id.String(),
+		Event:      activity.Action,
+		OccurredAt: now,
+		ActorID:    activity.ActorID.String(),
+		TargetType: string(activity.TargetType),
+		TargetID:   activity.TargetID.String(),
+		Before:     activity.Before,
+		After:      activity.After,
+	}
+	if activity.GroupID != nil {
+		groupId := activity.GroupID.String()
+		payload.GroupID = &groupId
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookDelivery{
+		SubscriptionID:  subscription.Id,
+		ActivityEventID: activity.Id,
+		EventType:       activity.Action,
+		Payload:         string(body),
+		Status:          WebhookDeliveryPending,
+		NextAttemptAt:   now,
+	}, nil
+}
+
+func (d *WebhookDelivery) MarkDelivered(now time.Time, statusCode int) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.LastAttemptAt = &now
+	d.LastStatusCode = &statusCode
+	d.LastError = ""
+	d.DeliveredAt = &now
+}
+
+// MarkFailed schedules the next attempt with exponential backoff, or moves
+// the delivery to the dead-letter state once maxAttempts is reached.
+func (d *WebhookDelivery) MarkFailed(now time.Time, statusCode int, reason string, maxAttempts int) {
+	d.Attempts++
+	d.LastAttemptAt = &now
+	d.LastStatusCode = nil
+	if statusCode > 0 {
+		d.LastStatusCode = &statusCode
+	}
+	if len(reason) > 1000 {
+		reason = reason[:1000]
+	}
+	d.LastError = reason
+
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryDead
+		return
+	}
+	d.NextAttemptAt = now.Add(WebhookRetryDelay(d.Attempts))
+}
+
+func WebhookRetryDelay(attempts int) time.Duration {
+	delay := webhookBaseRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= webhookMaxRetryDelay {
+			return webhookMaxRetryDelay
+		}
+	}
+	return delay
+}
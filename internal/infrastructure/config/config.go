@@ -41,6 +41,37 @@ func Load() Config {
 	refreshTokenExpiration := optionalDurationEnvVar("REFRESH_TOKEN_EXPIRATION", 7*24*time.Hour)
 	rateLimitMax := optionalIntEnvVar("RATE_LIMIT_MAX", 100)
 	rateLimitWindow := optionalDurationEnvVar("RATE_LIMIT_WINDOW", 1*time.Minute)
+	recurringSplitInterval := optionalDurationEnvVar("RECURRING_SPLIT_INTERVAL", 1*time.Minute)
+	exchangeRateProvider := optionalEnvVar("EXCHANGE_RATE_PROVIDER", "db")
+	exchangeRateFile := optionalEnvVar("EXCHANGE_RATE_FILE", "exchange_rates.json")
+	eventHistorySize := optionalIntEnvVar("EVENT_HISTORY_SIZE", 1000)
+	eventHeartbeatInterval := optionalDurationEnvVar("EVENT_HEARTBEAT_INTERVAL", 25*time.Second)
+	webhookDispatchInterval := optionalDurationEnvVar("WEBHOOK_DISPATCH_INTERVAL", 10*time.Second)
+	webhookTimeout := optionalDurationEnvVar("WEBHOOK_TIMEOUT", 10*time.Second)
+	webhookMaxAttempts := optionalIntEnvVar("WEBHOOK_MAX_ATTEMPTS", 8)
+	notificationSender := optionalEnvVar("NOTIFICATION_SENDER", "log")
+	notificationFile := optionalEnvVar("NOTIFICATION_FILE", "")
+	notificationDispatchInterval := optionalDurationEnvVar("NOTIFICATION_DISPATCH_INTERVAL", 30*time.Second)
+	notificationDigestHour := optionalIntEnvVar("NOTIFICATION_DIGEST_HOUR", 8)
+	smtpHost := optionalEnvVar("SMTP_HOST", "localhost")
+	smtpPort := optionalEnvVar("SMTP_PORT", "587")
+	smtpUsername := optionalEnvVar("SMTP_USERNAME", "")
+	smtpPassword := optionalEnvVar("SMTP_PASSWORD", "")
+	smtpFrom := optionalEnvVar("SMTP_FROM", "notifications@autobill.local")
+	smtpTimeout := optionalDurationEnvVar("SMTP_TIMEOUT", 10*time.Second)
+	reminderAutoAfterDays := optionalIntEnvVar("REMINDER_AUTO_AFTER_DAYS", 7)
+	reminderCooldown := optionalDurationEnvVar("REMINDER_COOLDOWN", 24*time.Hour)
+	reminderInterval := optionalDurationEnvVar("REMINDER_INTERVAL", 1*time.Hour)
+	storageProvider := optionalEnvVar("STORAGE_PROVIDER", "local")
+	storageLocalPath := optionalEnvVar("STORAGE_LOCAL_PATH", "data/attachments")
+	attachmentMaxSize := optionalIntEnvVar("ATTACHMENT_MAX_SIZE", 10<<20)
+	s3Endpoint := optionalEnvVar("S3_ENDPOINT", "http://localhost:9000")
+	s3Region := optionalEnvVar("S3_REGION", "us-east-1")
+	s3Bucket := optionalEnvVar("S3_BUCKET", "autobill-attachments")
+	s3AccessKey := optionalEnvVar("S3_ACCESS_KEY", "")
+	s3SecretKey := optionalEnvVar("S3_SECRET_KEY", "")
+	s3Timeout := optionalDurationEnvVar("S3_TIMEOUT", 30*time.Second)
+	adminUserIds := optionalListEnvVar("ADMIN_USER_IDS")
 
 	return Config{
 		Environment: env,
@@ -68,6 +99,57 @@ func Load() Config {
 			MaxRequests: rateLimitMax,
 			Window:      rateLimitWindow,
 		},
+		Scheduler: SchedulerConfig{
+			RecurringSplitInterval: recurringSplitInterval,
+		},
+		ExchangeRate: ExchangeRateConfig{
+			Provider: exchangeRateProvider,
+			FilePath: exchangeRateFile,
+		},
+		Events: EventsConfig{
+			HistorySize:       eventHistorySize,
+			HeartbeatInterval: eventHeartbeatInterval,
+		},
+		Webhook: WebhookConfig{
+			DispatchInterval: webhookDispatchInterval,
+			Timeout:          webhookTimeout,
+			MaxAttempts:      webhookMaxAttempts,
+		},
+		Notification: NotificationConfig{
+			Sender:           notificationSender,
+			FilePath:         notificationFile,
+			DispatchInterval: notificationDispatchInterval,
+			DigestHour:       notificationDigestHour,
+			SMTP: SMTPConfig{
+				Host:     smtpHost,
+				Port:     smtpPort,
+				Username: smtpUsername,
+				Password: smtpPassword,
+				From:     smtpFrom,
+				Timeout:  smtpTimeout,
+			},
+		},
+		Reminder: ReminderConfig{
+			AutoAfterDays: reminderAutoAfterDays,
+			Cooldown:      reminderCooldown,
+			Interval:      reminderInterval,
+		},
+		Storage: StorageConfig{
+			Provider:          storageProvider,
+			LocalPath:         storageLocalPath,
+			MaxAttachmentSize: attachmentMaxSize,
+			S3: S3Config{
+				Endpoint:  s3Endpoint,
+				Region:    s3Region,
+				Bucket:    s3Bucket,
+				AccessKey: s3AccessKey,
+				SecretKey: s3SecretKey,
+				Timeout:   s3Timeout,
+			},
+		},
+		Admin: AdminConfig{
+			UserIDs: adminUserIds,
+		},
 		LogLevel: logLevel,
 	}
 }
@@ -30,13 +30,84 @@ type RateLimitConfig struct {
 	Window      time.Duration
 }
 
+type SchedulerConfig struct {
+	RecurringSplitInterval time.Duration
+}
+
+type ExchangeRateConfig struct {
+	Provider string
+	FilePath string
+}
+
+type EventsConfig struct {
+	HistorySize       int
+	HeartbeatInterval time.Duration
+}
+
+type WebhookConfig struct {
+	DispatchInterval time.Duration
+	Timeout          time.Duration
+	MaxAttempts      int
+}
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+type NotificationConfig struct {
+	Sender           string
+	FilePath         string
+	DispatchInterval time.Duration
+	DigestHour       int
+	SMTP             SMTPConfig
+}
+
+type ReminderConfig struct {
+	AutoAfterDays int
+	Cooldown      time.Duration
+	Interval      time.Duration
+}
+
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	Timeout   time.Duration
+}
+
+type StorageConfig struct {
+	Provider          string
+	LocalPath         string
+	MaxAttachmentSize int
+	S3                S3Config
+}
+
+type AdminConfig struct {
+	UserIDs []string
+}
+
 type Config struct {
-	Environment Environment
-	Database    DatabaseConfig
-	Server      ServerConfig
-	JWT         JWTConfig
-	RateLimit   RateLimitConfig
-	LogLevel    string
+	Environment  Environment
+	Database     DatabaseConfig
+	Server       ServerConfig
+	JWT          JWTConfig
+	RateLimit    RateLimitConfig
+	Scheduler    SchedulerConfig
+	ExchangeRate ExchangeRateConfig
+	Events       EventsConfig
+	Webhook      WebhookConfig
+	Notification NotificationConfig
+	Reminder     ReminderConfig
+	Storage      StorageConfig
+	Admin        AdminConfig
+	LogLevel     string
 }
 
 type Environment string
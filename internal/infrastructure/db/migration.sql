@@ -10,7 +10,17 @@ CREATE TABLE IF NOT EXISTS users (
   status varchar(20) NOT NULL
 );
 
-CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS notify_frequency varchar(20) NOT NULL DEFAULT 'INSTANT';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS notify_splits boolean NOT NULL DEFAULT true;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS notify_settlements boolean NOT NULL DEFAULT true;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS notify_reminders boolean NOT NULL DEFAULT true;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS notify_mentions boolean NOT NULL DEFAULT true;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS merged_into_id uuid REFERENCES users(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_users_merged_into_id ON users (merged_into_id);
+
+DROP INDEX IF EXISTS idx_users_email;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_registered_email ON users (email) WHERE status <> 'GUEST' AND merged_into_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_users_guest_email ON users (LOWER(email)) WHERE status = 'GUEST';
 
 CREATE TABLE IF NOT EXISTS credentials (
   id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -85,6 +95,8 @@ CREATE TABLE IF NOT EXISTS groups (
 
 CREATE INDEX IF NOT EXISTS idx_groups_deleted_at ON groups (deleted_at);
 
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS settlement_currency varchar(10);
+
 CREATE TABLE IF NOT EXISTS group_memberships (
   id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
   created_at timestamptz NOT NULL DEFAULT now(),
@@ -119,10 +131,15 @@ CREATE TABLE IF NOT EXISTS splits (
   CONSTRAINT fk_splits_created_by FOREIGN KEY (created_by_id) REFERENCES users(id) ON DELETE CASCADE
 );
 
-CREATE UNIQUE INDEX IF NOT EXISTS idx_splits_idempotency_key ON splits (idempotency_key);
+DROP INDEX IF EXISTS idx_splits_idempotency_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_splits_active_idempotency_key ON splits (idempotency_key) WHERE deleted_at IS NULL;
 CREATE INDEX IF NOT EXISTS idx_splits_group_id ON splits (group_id);
 CREATE INDEX IF NOT EXISTS idx_splits_created_by_id ON splits (created_by_id);
 
+ALTER TABLE splits ADD COLUMN IF NOT EXISTS tax_amount bigint NOT NULL DEFAULT 0;
+ALTER TABLE splits ADD COLUMN IF NOT EXISTS tip_amount bigint NOT NULL DEFAULT 0;
+ALTER TABLE splits ADD COLUMN IF NOT EXISTS service_charge_amount bigint NOT NULL DEFAULT 0;
+
 CREATE TABLE IF NOT EXISTS split_participants (
   id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
   created_at timestamptz NOT NULL DEFAULT now(),
@@ -138,10 +155,109 @@ CREATE TABLE IF NOT EXISTS split_participants (
   CONSTRAINT fk_split_participants_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
 );
 
+ALTER TABLE split_participants ADD COLUMN IF NOT EXISTS percentage double precision NOT NULL DEFAULT 0;
+ALTER TABLE split_participants ADD COLUMN IF NOT EXISTS shares bigint NOT NULL DEFAULT 0;
+
 CREATE UNIQUE INDEX IF NOT EXISTS idx_split_user ON split_participants (split_id, user_id);
 CREATE INDEX IF NOT EXISTS idx_split_participants_split_id ON split_participants (split_id);
 CREATE INDEX IF NOT EXISTS idx_split_participants_user_id ON split_participants (user_id);
 
+CREATE TABLE IF NOT EXISTS split_items (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  split_id uuid NOT NULL,
+  name varchar(200) NOT NULL,
+  amount bigint NOT NULL,
+  quantity bigint NOT NULL DEFAULT 1,
+  CONSTRAINT fk_split_items_split FOREIGN KEY (split_id) REFERENCES splits(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_split_items_split_id ON split_items (split_id);
+
+CREATE TABLE IF NOT EXISTS split_item_assignees (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  split_item_id uuid NOT NULL,
+  user_id uuid NOT NULL,
+  CONSTRAINT fk_split_item_assignees_item FOREIGN KEY (split_item_id) REFERENCES split_items(id) ON DELETE CASCADE,
+  CONSTRAINT fk_split_item_assignees_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_split_item_user ON split_item_assignees (split_item_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_split_item_assignees_user_id ON split_item_assignees (user_id);
+
+CREATE TABLE IF NOT EXISTS split_payers (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  split_id uuid NOT NULL,
+  user_id uuid NOT NULL,
+  paid_amount bigint NOT NULL,
+  currency varchar(10) NOT NULL,
+  CONSTRAINT fk_split_payers_split FOREIGN KEY (split_id) REFERENCES splits(id) ON DELETE CASCADE,
+  CONSTRAINT fk_split_payers_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_split_payer ON split_payers (split_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_split_payers_split_id ON split_payers (split_id);
+CREATE INDEX IF NOT EXISTS idx_split_payers_user_id ON split_payers (user_id);
+
+INSERT INTO split_payers (split_id, user_id, paid_amount, currency)
+SELECT splits.id, splits.created_by_id, splits.total_amount, splits.currency
+FROM splits
+WHERE NOT EXISTS (SELECT 1 FROM split_payers WHERE split_payers.split_id = splits.id);
+
+CREATE TABLE IF NOT EXISTS recurring_splits (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  type varchar(20) NOT NULL,
+  division_type varchar(20) NOT NULL,
+  total_amount bigint NOT NULL,
+  currency varchar(10) NOT NULL,
+  description varchar(500),
+  simplify_debts boolean DEFAULT NULL,
+  cadence varchar(20) NOT NULL,
+  cron_expression varchar(100),
+  start_date timestamptz NOT NULL,
+  end_date timestamptz DEFAULT NULL,
+  next_run_at timestamptz NOT NULL,
+  last_run_at timestamptz DEFAULT NULL,
+  is_active boolean NOT NULL DEFAULT true,
+  group_id uuid,
+  created_by_id uuid NOT NULL,
+  CONSTRAINT fk_recurring_splits_group FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE,
+  CONSTRAINT fk_recurring_splits_created_by FOREIGN KEY (created_by_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurring_splits_next_run_at ON recurring_splits (next_run_at);
+CREATE INDEX IF NOT EXISTS idx_recurring_splits_group_id ON recurring_splits (group_id);
+CREATE INDEX IF NOT EXISTS idx_recurring_splits_created_by_id ON recurring_splits (created_by_id);
+CREATE INDEX IF NOT EXISTS idx_recurring_splits_deleted_at ON recurring_splits (deleted_at);
+
+CREATE TABLE IF NOT EXISTS recurring_split_participants (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  recurring_split_id uuid NOT NULL,
+  user_id uuid NOT NULL,
+  share_amount bigint NOT NULL DEFAULT 0,
+  percentage double precision NOT NULL DEFAULT 0,
+  shares bigint NOT NULL DEFAULT 0,
+  CONSTRAINT fk_recurring_split_participants_split FOREIGN KEY (recurring_split_id) REFERENCES recurring_splits(id) ON DELETE CASCADE,
+  CONSTRAINT fk_recurring_split_participants_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_recurring_split_user ON recurring_split_participants (recurring_split_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_recurring_split_participants_user_id ON recurring_split_participants (user_id);
+
 CREATE TABLE IF NOT EXISTS settlements (
   id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
   created_at timestamptz NOT NULL DEFAULT now(),
@@ -165,6 +281,35 @@ CREATE INDEX IF NOT EXISTS idx_settlements_split_id ON settlements (split_id);
 CREATE INDEX IF NOT EXISTS idx_settlements_payer_id ON settlements (payer_id);
 CREATE INDEX IF NOT EXISTS idx_settlements_payee_id ON settlements (payee_id);
 
+ALTER TABLE settlements ADD COLUMN IF NOT EXISTS settle_up_id uuid;
+CREATE INDEX IF NOT EXISTS idx_settlements_settle_up_id ON settlements (settle_up_id);
+
+ALTER TABLE settlements ALTER COLUMN split_id DROP NOT NULL;
+ALTER TABLE settlements ADD COLUMN IF NOT EXISTS group_id uuid REFERENCES groups(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_settlements_group_id ON settlements (group_id);
+
+ALTER TABLE settlements ADD COLUMN IF NOT EXISTS status varchar(20) NOT NULL DEFAULT 'PENDING';
+ALTER TABLE settlements ADD COLUMN IF NOT EXISTS status_reason varchar(500);
+UPDATE settlements SET status = 'CONFIRMED' WHERE confirmed = true AND status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_settlements_status ON settlements (status);
+
+CREATE TABLE IF NOT EXISTS settlement_events (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  settlement_id uuid NOT NULL,
+  actor_id uuid NOT NULL,
+  from_status varchar(20) NOT NULL,
+  to_status varchar(20) NOT NULL,
+  reason varchar(500),
+  CONSTRAINT fk_settlement_events_settlement FOREIGN KEY (settlement_id) REFERENCES settlements(id) ON DELETE CASCADE,
+  CONSTRAINT fk_settlement_events_actor FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_settlement_events_settlement_id ON settlement_events (settlement_id);
+CREATE INDEX IF NOT EXISTS idx_settlement_events_actor_id ON settlement_events (actor_id);
+
 CREATE TABLE IF NOT EXISTS user_balances (
   id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
   created_at timestamptz NOT NULL DEFAULT now(),
@@ -196,3 +341,307 @@ CREATE TABLE IF NOT EXISTS group_balances (
 
 CREATE INDEX IF NOT EXISTS idx_group_balances_user_id ON group_balances (user_id);
 CREATE INDEX IF NOT EXISTS idx_group_balances_group_id ON group_balances (group_id);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  transaction_id uuid NOT NULL,
+  source_type varchar(30) NOT NULL,
+  source_id uuid NOT NULL,
+  account varchar(10) NOT NULL,
+  user_id uuid NOT NULL,
+  counterparty_id uuid,
+  group_id uuid,
+  amount bigint NOT NULL,
+  currency varchar(10) NOT NULL,
+  CONSTRAINT fk_ledger_entries_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT fk_ledger_entries_counterparty FOREIGN KEY (counterparty_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT fk_ledger_entries_group FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE,
+  CONSTRAINT chk_ledger_entries_account CHECK (
+    (account = 'USER' AND counterparty_id IS NOT NULL) OR (account = 'GROUP' AND group_id IS NOT NULL)
+  )
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_transaction_id ON ledger_entries (transaction_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_source ON ledger_entries (source_type, source_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_user_id ON ledger_entries (user_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_counterparty_id ON ledger_entries (counterparty_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_group_id ON ledger_entries (group_id);
+
+INSERT INTO ledger_entries (transaction_id, source_type, source_id, account, user_id, counterparty_id, amount, currency)
+SELECT md5('USER' || LEAST(ub.user_id, ub.other_user_id)::text || GREATEST(ub.user_id, ub.other_user_id)::text || ub.currency)::uuid,
+  'OPENING_BALANCE',
+  md5('USER' || LEAST(ub.user_id, ub.other_user_id)::text || GREATEST(ub.user_id, ub.other_user_id)::text || ub.currency)::uuid,
+  'USER', ub.user_id, ub.other_user_id, SUM(ub.net_amount), ub.currency
+FROM user_balances ub
+WHERE ub.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM ledger_entries le
+    WHERE le.account = 'USER' AND le.user_id = ub.user_id AND le.counterparty_id = ub.other_user_id AND le.currency = ub.currency
+  )
+GROUP BY ub.user_id, ub.other_user_id, ub.currency
+HAVING SUM(ub.net_amount) <> 0;
+
+INSERT INTO ledger_entries (transaction_id, source_type, source_id, account, user_id, group_id, amount, currency)
+SELECT md5('GROUP' || gb.group_id::text || gb.currency)::uuid,
+  'OPENING_BALANCE',
+  gb.group_id,
+  'GROUP', gb.user_id, gb.group_id, SUM(gb.net_amount), gb.currency
+FROM group_balances gb
+WHERE gb.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM ledger_entries le
+    WHERE le.account = 'GROUP' AND le.group_id = gb.group_id AND le.user_id = gb.user_id AND le.currency = gb.currency
+  )
+GROUP BY gb.group_id, gb.user_id, gb.currency
+HAVING SUM(gb.net_amount) <> 0;
+
+CREATE TABLE IF NOT EXISTS activity_events (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  actor_id uuid NOT NULL,
+  action varchar(40) NOT NULL,
+  target_type varchar(20) NOT NULL,
+  target_id uuid NOT NULL,
+  group_id uuid,
+  before jsonb,
+  after jsonb,
+  CONSTRAINT fk_activity_events_actor FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_activity_events_actor_id ON activity_events (actor_id);
+CREATE INDEX IF NOT EXISTS idx_activity_events_target_id ON activity_events (target_id);
+CREATE INDEX IF NOT EXISTS idx_activity_events_group_created ON activity_events (group_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS activity_event_users (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  activity_event_id uuid NOT NULL,
+  user_id uuid NOT NULL,
+  CONSTRAINT fk_activity_event_users_event FOREIGN KEY (activity_event_id) REFERENCES activity_events(id) ON DELETE CASCADE,
+  CONSTRAINT fk_activity_event_users_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_activity_event_user ON activity_event_users (activity_event_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_activity_event_users_user_id ON activity_event_users (user_id);
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  owner_id uuid NOT NULL,
+  group_id uuid,
+  url varchar(2048) NOT NULL,
+  secret varchar(100) NOT NULL,
+  events varchar(1000) NOT NULL DEFAULT '',
+  description varchar(500),
+  active boolean NOT NULL DEFAULT true,
+  CONSTRAINT fk_webhook_subscriptions_owner FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT fk_webhook_subscriptions_group FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_owner_id ON webhook_subscriptions (owner_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_group_id ON webhook_subscriptions (group_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_deleted_at ON webhook_subscriptions (deleted_at);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  subscription_id uuid NOT NULL,
+  activity_event_id uuid NOT NULL,
+  event_type varchar(40) NOT NULL,
+  payload jsonb NOT NULL,
+  status varchar(20) NOT NULL DEFAULT 'PENDING',
+  attempts integer NOT NULL DEFAULT 0,
+  next_attempt_at timestamptz NOT NULL,
+  last_attempt_at timestamptz DEFAULT NULL,
+  last_status_code integer DEFAULT NULL,
+  last_error varchar(1000),
+  delivered_at timestamptz DEFAULT NULL,
+  CONSTRAINT fk_webhook_deliveries_subscription FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+  CONSTRAINT chk_webhook_deliveries_status CHECK (status IN ('PENDING', 'DELIVERED', 'DEAD'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_delivery_event ON webhook_deliveries (subscription_id, activity_event_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'PENDING' AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_deleted_at ON webhook_deliveries (deleted_at);
+
+CREATE TABLE IF NOT EXISTS notifications (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  user_id uuid NOT NULL,
+  activity_event_id uuid NOT NULL,
+  digest boolean NOT NULL DEFAULT false,
+  status varchar(20) NOT NULL DEFAULT 'PENDING',
+  attempts integer NOT NULL DEFAULT 0,
+  next_attempt_at timestamptz NOT NULL,
+  last_error varchar(1000),
+  sent_at timestamptz DEFAULT NULL,
+  CONSTRAINT fk_notifications_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT fk_notifications_activity_event FOREIGN KEY (activity_event_id) REFERENCES activity_events(id) ON DELETE CASCADE,
+  CONSTRAINT chk_notifications_status CHECK (status IN ('PENDING', 'SENT', 'FAILED'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_user_event ON notifications (user_id, activity_event_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_due ON notifications (digest, next_attempt_at) WHERE status = 'PENDING' AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_notifications_deleted_at ON notifications (deleted_at);
+
+CREATE TABLE IF NOT EXISTS payment_reminders (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  split_id uuid NOT NULL,
+  sender_id uuid,
+  debtor_id uuid NOT NULL,
+  amount bigint NOT NULL,
+  currency varchar(10) NOT NULL,
+  automatic boolean NOT NULL DEFAULT false,
+  CONSTRAINT fk_payment_reminders_split FOREIGN KEY (split_id) REFERENCES splits(id) ON DELETE CASCADE,
+  CONSTRAINT fk_payment_reminders_sender FOREIGN KEY (sender_id) REFERENCES users(id) ON DELETE SET NULL,
+  CONSTRAINT fk_payment_reminders_debtor FOREIGN KEY (debtor_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_reminders_split_debtor ON payment_reminders (split_id, debtor_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_payment_reminders_sender_debtor ON payment_reminders (sender_id, debtor_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_payment_reminders_debtor_id ON payment_reminders (debtor_id);
+CREATE INDEX IF NOT EXISTS idx_payment_reminders_deleted_at ON payment_reminders (deleted_at);
+
+CREATE TABLE IF NOT EXISTS group_invites (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  group_id uuid NOT NULL,
+  created_by_id uuid NOT NULL,
+  token_hash varchar(64) NOT NULL,
+  email varchar(255),
+  role varchar(20) NOT NULL DEFAULT 'MEMBER',
+  max_uses integer NOT NULL DEFAULT 1,
+  uses integer NOT NULL DEFAULT 0,
+  expires_at timestamptz NOT NULL,
+  revoked_at timestamptz,
+  CONSTRAINT fk_group_invites_group FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE,
+  CONSTRAINT fk_group_invites_created_by FOREIGN KEY (created_by_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_group_invites_token_hash ON group_invites (token_hash);
+CREATE INDEX IF NOT EXISTS idx_group_invites_group_id ON group_invites (group_id);
+CREATE INDEX IF NOT EXISTS idx_group_invites_created_by_id ON group_invites (created_by_id);
+CREATE INDEX IF NOT EXISTS idx_group_invites_email ON group_invites (LOWER(email)) WHERE email IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_group_invites_expires_at ON group_invites (expires_at);
+CREATE INDEX IF NOT EXISTS idx_group_invites_deleted_at ON group_invites (deleted_at);
+
+ALTER TABLE group_invites ADD COLUMN IF NOT EXISTS guest_id uuid REFERENCES users(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_group_invites_guest_id ON group_invites (guest_id);
+
+CREATE TABLE IF NOT EXISTS categories (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  name varchar(50) NOT NULL,
+  group_id uuid,
+  created_by_id uuid,
+  CONSTRAINT fk_categories_group FOREIGN KEY (group_id) REFERENCES groups(id) ON DELETE CASCADE,
+  CONSTRAINT fk_categories_created_by FOREIGN KEY (created_by_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_system_name ON categories (LOWER(name)) WHERE group_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_group_name ON categories (group_id, LOWER(name)) WHERE group_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_categories_group_id ON categories (group_id);
+CREATE INDEX IF NOT EXISTS idx_categories_deleted_at ON categories (deleted_at);
+
+INSERT INTO categories (name)
+VALUES ('Food & Drink'), ('Groceries'), ('Transport'), ('Accommodation'), ('Entertainment'),
+  ('Utilities'), ('Rent'), ('Shopping'), ('Health'), ('Other')
+ON CONFLICT (LOWER(name)) WHERE group_id IS NULL DO NOTHING;
+
+ALTER TABLE splits ADD COLUMN IF NOT EXISTS category_id uuid REFERENCES categories(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_splits_category_id ON splits (category_id);
+CREATE INDEX IF NOT EXISTS idx_splits_group_id_created_at ON splits (group_id, created_at);
+
+CREATE TABLE IF NOT EXISTS split_attachments (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  split_id uuid NOT NULL,
+  uploaded_by_id uuid NOT NULL,
+  file_name varchar(255) NOT NULL,
+  content_type varchar(100) NOT NULL,
+  size bigint NOT NULL,
+  checksum varchar(64) NOT NULL,
+  storage_key varchar(255) NOT NULL,
+  CONSTRAINT fk_split_attachments_split FOREIGN KEY (split_id) REFERENCES splits(id) ON DELETE CASCADE,
+  CONSTRAINT fk_split_attachments_uploaded_by FOREIGN KEY (uploaded_by_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_split_attachments_storage_key ON split_attachments (storage_key);
+CREATE INDEX IF NOT EXISTS idx_split_attachments_split_id ON split_attachments (split_id);
+CREATE INDEX IF NOT EXISTS idx_split_attachments_uploaded_by_id ON split_attachments (uploaded_by_id);
+CREATE INDEX IF NOT EXISTS idx_split_attachments_deleted_at ON split_attachments (deleted_at);
+
+CREATE TABLE IF NOT EXISTS comments (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  split_id uuid,
+  settlement_id uuid,
+  parent_id uuid,
+  author_id uuid NOT NULL,
+  body varchar(2000) NOT NULL,
+  edited_at timestamptz,
+  CONSTRAINT fk_comments_split FOREIGN KEY (split_id) REFERENCES splits(id) ON DELETE CASCADE,
+  CONSTRAINT fk_comments_settlement FOREIGN KEY (settlement_id) REFERENCES settlements(id) ON DELETE CASCADE,
+  CONSTRAINT fk_comments_parent FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE CASCADE,
+  CONSTRAINT fk_comments_author FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT chk_comments_target CHECK ((split_id IS NULL) <> (settlement_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_split_id_created_at ON comments (split_id, created_at) WHERE parent_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_settlement_id_created_at ON comments (settlement_id, created_at) WHERE parent_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+CREATE INDEX IF NOT EXISTS idx_comments_author_id ON comments (author_id);
+CREATE INDEX IF NOT EXISTS idx_comments_deleted_at ON comments (deleted_at);
+
+CREATE TABLE IF NOT EXISTS comment_mentions (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  comment_id uuid NOT NULL,
+  user_id uuid NOT NULL,
+  CONSTRAINT fk_comment_mentions_comment FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE,
+  CONSTRAINT fk_comment_mentions_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_comment_mention ON comment_mentions (comment_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_comment_mentions_user_id ON comment_mentions (user_id);
+CREATE INDEX IF NOT EXISTS idx_comment_mentions_deleted_at ON comment_mentions (deleted_at);
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  deleted_at timestamptz,
+  base_currency varchar(10) NOT NULL,
+  quote_currency varchar(10) NOT NULL,
+  rate numeric(24,12) NOT NULL,
+  effective_date date NOT NULL,
+  source varchar(100)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_exchange_rate_pair_date ON exchange_rates (base_currency, quote_currency, effective_date);
@@ -0,0 +1,14 @@
+package HttpPorts
+
+import (
+	Dtos "autobill-service/internal/application/activity/dtos"
+	Helpers "autobill-service/pkg/helpers"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ActivityUseCase interface {
+	GetMyActivity(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.ActivityListResult, error)
+	GetGroupActivity(ctx context.Context, userId, groupId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.ActivityListResult, error)
+}
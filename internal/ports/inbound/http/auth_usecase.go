@@ -16,4 +16,5 @@ type AuthUseCase interface {
 	LogoutAll(ctx context.Context, userId uuid.UUID) error
 	DeactivateUser(ctx context.Context, id uuid.UUID, password string) error
 	ReactivateUser(ctx context.Context, email, password string) error
+	MergeAccount(ctx context.Context, id uuid.UUID, input Dtos.MergeAccountInput) error
 }
@@ -8,10 +8,14 @@ import (
 )
 
 type BalanceUseCase interface {
-	GetMyBalance(ctx context.Context, userId uuid.UUID) (*Dtos.UserBalanceResult, error)
-	GetBalanceWithUser(ctx context.Context, userId, otherUserId uuid.UUID) (*Dtos.UserBalanceResult, error)
+	GetMyBalance(ctx context.Context, userId uuid.UUID, convertTo string) (*Dtos.UserBalanceResult, error)
+	GetBalanceWithUser(ctx context.Context, userId, otherUserId uuid.UUID, convertTo string) (*Dtos.UserBalanceResult, error)
+	RecalculateMyBalance(ctx context.Context, userId uuid.UUID) (*Dtos.UserBalanceResult, error)
+	VerifyMyBalance(ctx context.Context, userId uuid.UUID) (*Dtos.LedgerVerificationResult, error)
+	GetOverdueReceivables(ctx context.Context, userId uuid.UUID, minDays int) (*Dtos.OverdueReceivablesResult, error)
 
-	GetGroupBalance(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.GroupBalanceResult, error)
+	GetGroupBalance(ctx context.Context, userId, groupId uuid.UUID, convertTo string) (*Dtos.GroupBalanceResult, error)
 	RecalculateGroupBalance(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.GroupBalanceResult, error)
+	VerifyGroupBalance(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.LedgerVerificationResult, error)
 	GetSimplifiedDebts(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.SimplifiedDebtsResult, error)
 }
@@ -0,0 +1,16 @@
+package HttpPorts
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/category/dtos"
+
+	"github.com/google/uuid"
+)
+
+type CategoryUseCase interface {
+	GetSystemCategories(ctx context.Context) (*Dtos.CategoryListResult, error)
+	GetGroupCategories(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.CategoryListResult, error)
+	CreateCategory(ctx context.Context, userId, groupId uuid.UUID, input Dtos.CreateCategoryInput) (*Dtos.CategoryResult, error)
+	DeleteCategory(ctx context.Context, userId, groupId, categoryId uuid.UUID) error
+}
@@ -0,0 +1,19 @@
+package HttpPorts
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/comment/dtos"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/google/uuid"
+)
+
+// CommentUseCase is implemented once per kind of thread; targetId is the
+// split or settlement the comments belong to.
+type CommentUseCase interface {
+	GetComments(ctx context.Context, userId, targetId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.CommentListResult, error)
+	CreateComment(ctx context.Context, userId, targetId uuid.UUID, input Dtos.CreateCommentInput) (*Dtos.CommentResult, error)
+	UpdateComment(ctx context.Context, userId, targetId, commentId uuid.UUID, input Dtos.UpdateCommentInput) (*Dtos.CommentResult, error)
+	DeleteComment(ctx context.Context, userId, targetId, commentId uuid.UUID) error
+}
@@ -0,0 +1,11 @@
+package HttpPorts
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/currency/dtos"
+)
+
+type CurrencyUseCase interface {
+	GetCurrencies(ctx context.Context) (*Dtos.CurrencyListResult, error)
+}
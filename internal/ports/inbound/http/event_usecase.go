@@ -0,0 +1,12 @@
+package HttpPorts
+
+import (
+	Dtos "autobill-service/internal/application/events/dtos"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type EventUseCase interface {
+	Subscribe(ctx context.Context, userId uuid.UUID, lastEventId string) (*Dtos.EventSubscription, error)
+}
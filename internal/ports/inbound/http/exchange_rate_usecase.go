@@ -0,0 +1,15 @@
+package HttpPorts
+
+import (
+	"context"
+	"time"
+
+	Dtos "autobill-service/internal/application/exchange/dtos"
+
+	"github.com/google/uuid"
+)
+
+type ExchangeRateUseCase interface {
+	GetExchangeRates(ctx context.Context, asOf *time.Time) (*Dtos.ExchangeRateListResult, error)
+	UploadExchangeRates(ctx context.Context, userId uuid.UUID, input Dtos.UploadExchangeRatesInput) (*Dtos.ExchangeRateListResult, error)
+}
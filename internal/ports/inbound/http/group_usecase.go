@@ -16,8 +16,10 @@ type GroupUseCase interface {
 	GetGroup(ctx context.Context, userId, groupId uuid.UUID) (*Dtos.GroupDetailResult, error)
 	DeleteGroup(ctx context.Context, userId, groupId uuid.UUID) error
 	AddMember(ctx context.Context, userId, groupId uuid.UUID, input Dtos.AddMemberInput) (*Dtos.MemberResult, error)
+	AddGuest(ctx context.Context, userId, groupId uuid.UUID, input Dtos.AddGuestInput) (*Dtos.MemberResult, error)
 	UpdateMemberRole(ctx context.Context, userId, groupId, memberId uuid.UUID, role string) error
 	TransferOwnership(ctx context.Context, userId, groupId, newOwnerId uuid.UUID) error
 	RemoveMember(ctx context.Context, userId, groupId, memberId uuid.UUID) error
 	LeaveGroup(ctx context.Context, userId, groupId uuid.UUID) error
+	GetGroupAnalytics(ctx context.Context, userId, groupId uuid.UUID, input Dtos.GroupAnalyticsInput) (*Dtos.GroupAnalyticsResult, error)
 }
@@ -0,0 +1,17 @@
+package HttpPorts
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/invite/dtos"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/google/uuid"
+)
+
+type InviteUseCase interface {
+	CreateInvite(ctx context.Context, userId, groupId uuid.UUID, input Dtos.CreateInviteInput) (*Dtos.InviteResult, error)
+	GetInvites(ctx context.Context, userId, groupId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.InviteListResult, error)
+	RevokeInvite(ctx context.Context, userId, groupId, inviteId uuid.UUID) error
+	JoinGroup(ctx context.Context, userId uuid.UUID, token string) (*Dtos.JoinGroupResult, error)
+}
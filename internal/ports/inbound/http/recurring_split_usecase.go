@@ -0,0 +1,18 @@
+package HttpPorts
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+	Helpers "autobill-service/pkg/helpers"
+
+	"github.com/google/uuid"
+)
+
+type RecurringSplitUseCase interface {
+	CreateRecurringSplit(ctx context.Context, userId uuid.UUID, input Dtos.CreateRecurringSplitInput) (*Dtos.RecurringSplitResult, error)
+	GetRecurringSplit(ctx context.Context, userId, recurringSplitId uuid.UUID) (*Dtos.RecurringSplitResult, error)
+	GetMyRecurringSplits(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.RecurringSplitListResult, error)
+	UpdateRecurringSplit(ctx context.Context, userId, recurringSplitId uuid.UUID, input Dtos.UpdateRecurringSplitInput) (*Dtos.RecurringSplitResult, error)
+	DeleteRecurringSplit(ctx context.Context, userId, recurringSplitId uuid.UUID) error
+}
@@ -0,0 +1,14 @@
+package HttpPorts
+
+import (
+	Dtos "autobill-service/internal/application/reminder/dtos"
+	Helpers "autobill-service/pkg/helpers"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ReminderUseCase interface {
+	SendReminder(ctx context.Context, userId uuid.UUID, input Dtos.SendReminderInput) (*Dtos.ReminderResult, error)
+	GetReminders(ctx context.Context, userId uuid.UUID, reminderType Dtos.ReminderType, pagination Helpers.PaginationParams) (*Dtos.ReminderListResult, error)
+}
@@ -15,4 +15,10 @@ type SettlementUseCase interface {
 	GetSettlementHistory(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.SettlementListResult, error)
 	ConfirmSettlement(ctx context.Context, userId, settlementId uuid.UUID) error
 	DeleteSettlement(ctx context.Context, userId, settlementId uuid.UUID) error
+	RejectSettlement(ctx context.Context, userId, settlementId uuid.UUID, input Dtos.SettlementStatusChangeInput) error
+	DisputeSettlement(ctx context.Context, userId, settlementId uuid.UUID, input Dtos.SettlementStatusChangeInput) error
+	GetSettlementEvents(ctx context.Context, userId, settlementId uuid.UUID) (*Dtos.SettlementEventListResult, error)
+	SettleUp(ctx context.Context, userId, groupId uuid.UUID, input Dtos.SettleUpInput) (*Dtos.SettleUpResult, error)
+	GetSettleUp(ctx context.Context, userId, settleUpId uuid.UUID) (*Dtos.SettleUpResult, error)
+	ConfirmSettleUp(ctx context.Context, userId, settleUpId uuid.UUID) error
 }
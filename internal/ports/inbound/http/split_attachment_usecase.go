@@ -0,0 +1,16 @@
+package HttpPorts
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+
+	"github.com/google/uuid"
+)
+
+type SplitAttachmentUseCase interface {
+	UploadAttachment(ctx context.Context, userId, splitId uuid.UUID, input Dtos.UploadAttachmentInput) (*Dtos.AttachmentResult, error)
+	GetAttachments(ctx context.Context, userId, splitId uuid.UUID) (*Dtos.AttachmentListResult, error)
+	GetAttachmentContent(ctx context.Context, userId, splitId, attachmentId uuid.UUID) (*Dtos.AttachmentContent, error)
+	DeleteAttachment(ctx context.Context, userId, splitId, attachmentId uuid.UUID) error
+}
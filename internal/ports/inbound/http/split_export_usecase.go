@@ -0,0 +1,14 @@
+package HttpPorts
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+
+	"github.com/google/uuid"
+)
+
+type SplitExportUseCase interface {
+	ExportUserData(ctx context.Context, userId uuid.UUID, input Dtos.ExportInput) (*Dtos.ExportStream, error)
+	ExportGroupData(ctx context.Context, userId, groupId uuid.UUID, input Dtos.ExportInput) (*Dtos.ExportStream, error)
+}
@@ -0,0 +1,13 @@
+package HttpPorts
+
+import (
+	"context"
+
+	Dtos "autobill-service/internal/application/split/dtos"
+
+	"github.com/google/uuid"
+)
+
+type SplitImportUseCase interface {
+	ImportSplits(ctx context.Context, userId, groupId uuid.UUID, input Dtos.ImportSplitsInput) (*Dtos.ImportResult, error)
+}
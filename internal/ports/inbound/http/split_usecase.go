@@ -14,5 +14,6 @@ type SplitUseCase interface {
 	GetSplit(ctx context.Context, userId, splitId uuid.UUID) (*Dtos.SplitResult, error)
 	GetGroupSplits(ctx context.Context, userId, groupId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.SplitListResult, error)
 	GetMySplits(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.SplitListResult, error)
+	UpdateSplit(ctx context.Context, userId, splitId uuid.UUID, input Dtos.UpdateSplitInput) (*Dtos.SplitResult, error)
 	ReverseSplit(ctx context.Context, userId, splitId uuid.UUID) (*Dtos.SplitResult, error)
 }
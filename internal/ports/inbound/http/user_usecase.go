@@ -12,4 +12,7 @@ type UserUseCase interface {
 	FindUserByEmail(ctx context.Context, email string) (*Dtos.UserResult, error)
 
 	UpdateUser(ctx context.Context, id uuid.UUID, input Dtos.UpdateUserInput) (*Dtos.UserResult, error)
+
+	GetNotificationPreferences(ctx context.Context, id uuid.UUID) (*Dtos.NotificationPreferencesResult, error)
+	UpdateNotificationPreferences(ctx context.Context, id uuid.UUID, input Dtos.UpdateNotificationPreferencesInput) (*Dtos.NotificationPreferencesResult, error)
 }
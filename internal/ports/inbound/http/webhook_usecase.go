@@ -0,0 +1,21 @@
+package HttpPorts
+
+import (
+	Dtos "autobill-service/internal/application/webhook/dtos"
+	Helpers "autobill-service/pkg/helpers"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type WebhookUseCase interface {
+	CreateWebhook(ctx context.Context, userId uuid.UUID, input Dtos.CreateWebhookInput) (*Dtos.WebhookResult, error)
+	GetWebhooks(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.WebhookListResult, error)
+	GetWebhook(ctx context.Context, userId, webhookId uuid.UUID) (*Dtos.WebhookResult, error)
+	UpdateWebhook(ctx context.Context, userId, webhookId uuid.UUID, input Dtos.UpdateWebhookInput) (*Dtos.WebhookResult, error)
+	DeleteWebhook(ctx context.Context, userId, webhookId uuid.UUID) error
+
+	GetDeliveries(ctx context.Context, userId, webhookId uuid.UUID, status string, pagination Helpers.PaginationParams) (*Dtos.WebhookDeliveryListResult, error)
+	GetDeadLetters(ctx context.Context, userId uuid.UUID, pagination Helpers.PaginationParams) (*Dtos.WebhookDeliveryListResult, error)
+	RetryDelivery(ctx context.Context, userId, deliveryId uuid.UUID) (*Dtos.WebhookDeliveryResult, error)
+}
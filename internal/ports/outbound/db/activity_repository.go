@@ -0,0 +1,14 @@
+package RepositoryPorts
+
+import (
+	"context"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type ActivityRepositoryPort interface {
+	GetUserActivity(ctx context.Context, userId uuid.UUID, limit, offset int) ([]Domain.ActivityEvent, int64, error)
+	GetGroupActivity(ctx context.Context, groupId uuid.UUID, limit, offset int) ([]Domain.ActivityEvent, int64, error)
+}
@@ -18,6 +18,9 @@ type AuthRepositoryPort interface {
 	DeactivateUser(ctx context.Context, userId uuid.UUID, password string) error
 	ReactivateUser(ctx context.Context, email, password string) error
 
+	VerifyPassword(ctx context.Context, userId uuid.UUID, password string) error
+	MergeUsers(ctx context.Context, sourceId, targetId uuid.UUID) error
+
 	CreateRefreshToken(ctx context.Context, userId uuid.UUID, token string, expiresAt time.Time) (*Domain.RefreshToken, error)
 	GetRefreshToken(ctx context.Context, token string) (*Domain.RefreshToken, error)
 	RevokeRefreshToken(ctx context.Context, token string) error
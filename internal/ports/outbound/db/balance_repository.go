@@ -2,22 +2,55 @@ package RepositoryPorts
 
 import (
 	"context"
+	"time"
 
 	Domain "autobill-service/internal/domain"
 
 	"github.com/google/uuid"
 )
 
+type BalanceMismatch struct {
+	UserID          uuid.UUID
+	OtherUserID     *uuid.UUID
+	GroupID         *uuid.UUID
+	Currency        Domain.Currency
+	LedgerAmount    int64
+	ProjectedAmount int64
+}
+
+type UnbalancedTransaction struct {
+	TransactionID uuid.UUID
+	Account       Domain.LedgerAccount
+	Currency      Domain.Currency
+	Total         int64
+}
+
+// Receivable is what one debtor still owes a creditor for a split.
+type Receivable struct {
+	SplitID        uuid.UUID
+	Description    string
+	GroupID        *uuid.UUID
+	DebtorID       uuid.UUID
+	DebtorName     string
+	Amount         int64
+	Currency       Domain.Currency
+	SplitCreatedAt time.Time
+}
+
 type BalanceRepositoryPort interface {
 	GetUserBalances(ctx context.Context, userId uuid.UUID) ([]Domain.UserBalance, error)
 	GetUserBalancesWithOtherUser(ctx context.Context, userId, otherUserId uuid.UUID) ([]Domain.UserBalance, error)
 	UpdateBalancesForSplit(ctx context.Context, split *Domain.Split, participants []Domain.SplitParticipant) error
 
 	GetGroupBalances(ctx context.Context, groupId uuid.UUID) ([]Domain.GroupBalance, error)
-	GetSimplifiedDebts(ctx context.Context, groupId uuid.UUID) ([]Domain.SimplifiedDebt, error)
-
-	GetSplitsWithParticipants(ctx context.Context, groupId uuid.UUID) ([]Domain.Split, error)
-	GetSettlementsForSplits(ctx context.Context, splitIDs []uuid.UUID) ([]Domain.Settlement, error)
 	GetSettledParticipants(ctx context.Context, splitId uuid.UUID, userId uuid.UUID) (bool, error)
-	ReplaceGroupBalances(ctx context.Context, groupId uuid.UUID, balances []Domain.GroupBalance) ([]Domain.GroupBalance, error)
+
+	RebuildUserBalances(ctx context.Context, userId uuid.UUID) ([]Domain.UserBalance, error)
+	RebuildGroupBalances(ctx context.Context, groupId uuid.UUID) ([]Domain.GroupBalance, error)
+	GetUserBalanceMismatches(ctx context.Context, userId uuid.UUID) ([]BalanceMismatch, error)
+	GetGroupBalanceMismatches(ctx context.Context, groupId uuid.UUID) ([]BalanceMismatch, error)
+	GetUnbalancedTransactionsForUser(ctx context.Context, userId uuid.UUID) ([]UnbalancedTransaction, error)
+	GetUnbalancedTransactionsForGroup(ctx context.Context, groupId uuid.UUID) ([]UnbalancedTransaction, error)
+
+	GetOutstandingReceivables(ctx context.Context, creditorId uuid.UUID) ([]Receivable, error)
 }
@@ -0,0 +1,16 @@
+package RepositoryPorts
+
+import (
+	"context"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type CategoryRepositoryPort interface {
+	CreateCategory(ctx context.Context, category *Domain.Category) (*Domain.Category, error)
+	GetCategoryById(ctx context.Context, categoryId uuid.UUID) (*Domain.Category, error)
+	GetCategories(ctx context.Context, groupId *uuid.UUID) ([]Domain.Category, error)
+	DeleteCategory(ctx context.Context, groupId, categoryId uuid.UUID) error
+}
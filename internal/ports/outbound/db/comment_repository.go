@@ -0,0 +1,17 @@
+package RepositoryPorts
+
+import (
+	"context"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type CommentRepositoryPort interface {
+	CreateComment(ctx context.Context, comment *Domain.Comment, mentionIds []uuid.UUID, activity *Domain.ActivityEvent) (*Domain.Comment, error)
+	GetCommentById(ctx context.Context, commentId uuid.UUID) (*Domain.Comment, error)
+	GetComments(ctx context.Context, targetType Domain.ActivityTargetType, targetId uuid.UUID, limit, offset int) ([]Domain.Comment, int64, error)
+	UpdateComment(ctx context.Context, comment *Domain.Comment, mentionIds []uuid.UUID, activity *Domain.ActivityEvent) (*Domain.Comment, error)
+	DeleteComment(ctx context.Context, commentId uuid.UUID) error
+}
@@ -0,0 +1,83 @@
+package RepositoryPorts
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// ExportFilter selects the data of either a user or a group. Splits are
+// filtered on their creation time and settlements on their date, both within
+// [From, To). Either bound may be nil.
+type ExportFilter struct {
+	UserID  *uuid.UUID
+	GroupID *uuid.UUID
+	From    *time.Time
+	To      *time.Time
+}
+
+// ExportShareRow is one member of a split, whether they paid, owe a share or
+// both.
+type ExportShareRow struct {
+	SplitID       uuid.UUID
+	CreatedAt     time.Time
+	GroupID       *uuid.UUID
+	GroupName     *string
+	Description   string
+	CategoryName  *string
+	Currency      Domain.Currency
+	TotalAmount   int64
+	UserID        uuid.UUID
+	UserName      string
+	UserEmail     string
+	PaidAmount    int64
+	ShareAmount   int64
+	SettledAmount int64
+	IsSettled     bool
+}
+
+type ExportSettlementRow struct {
+	SettlementID uuid.UUID
+	SplitID      *uuid.UUID
+	Date         time.Time
+	GroupID      *uuid.UUID
+	GroupName    *string
+	Description  *string
+	Currency     Domain.Currency
+	Amount       int64
+	PayerID      uuid.UUID
+	PayerName    string
+	PayerEmail   string
+	PayeeID      uuid.UUID
+	PayeeName    string
+	PayeeEmail   string
+	Status       Domain.SettlementStatus
+	Confirmed    bool
+	ConfirmedAt  *time.Time
+}
+
+// ExportBalanceRow is a current balance. User exports list balances with each
+// counterparty, group exports the net balance of each member.
+type ExportBalanceRow struct {
+	GroupID           *uuid.UUID
+	GroupName         *string
+	UserID            uuid.UUID
+	UserName          string
+	UserEmail         string
+	CounterpartyID    *uuid.UUID
+	CounterpartyName  *string
+	CounterpartyEmail *string
+	Currency          Domain.Currency
+	NetAmount         int64
+}
+
+// ExportRepositoryPort reads export rows through a database cursor and hands
+// them to fn one at a time. An error from fn stops the stream and is returned.
+type ExportRepositoryPort interface {
+	StreamSplitShares(ctx context.Context, filter ExportFilter, fn func(*ExportShareRow) error) error
+	StreamSettlements(ctx context.Context, filter ExportFilter, fn func(*ExportSettlementRow) error) error
+	StreamBalances(ctx context.Context, filter ExportFilter, fn func(*ExportBalanceRow) error) error
+}
@@ -9,18 +9,22 @@ import (
 )
 
 type GroupRepositoryPort interface {
-	CreateGroup(ctx context.Context, name string, ownerId uuid.UUID, simplifyDebts bool) (*Domain.Group, error)
-	UpdateGroup(ctx context.Context, groupId uuid.UUID, updates map[string]any) (*Domain.Group, error)
+	CreateGroup(ctx context.Context, name string, ownerId uuid.UUID, simplifyDebts bool, settlementCurrency *Domain.Currency, activity *Domain.ActivityEvent) (*Domain.Group, error)
+	UpdateGroup(ctx context.Context, groupId uuid.UUID, updates map[string]any, activity *Domain.ActivityEvent) (*Domain.Group, error)
 	GetGroupsByUserId(ctx context.Context, userId uuid.UUID, limit, offset int) ([]Domain.Group, int64, error)
 	GetGroupById(ctx context.Context, groupId uuid.UUID) (*Domain.Group, error)
 	GetGroupWithMembers(ctx context.Context, groupId uuid.UUID) (*Domain.Group, error)
-	DeleteGroup(ctx context.Context, groupId uuid.UUID) error
+	DeleteGroup(ctx context.Context, groupId uuid.UUID, activity *Domain.ActivityEvent) error
 
-	AddMember(ctx context.Context, groupId, userId uuid.UUID, role Domain.GroupRole) (*Domain.GroupMembership, error)
+	AddMember(ctx context.Context, groupId, userId uuid.UUID, role Domain.GroupRole, activity *Domain.ActivityEvent) (*Domain.GroupMembership, error)
 	GetMembership(ctx context.Context, groupId, userId uuid.UUID) (*Domain.GroupMembership, error)
-	UpdateMemberRole(ctx context.Context, groupId, userId uuid.UUID, role Domain.GroupRole) error
-	TransferOwnership(ctx context.Context, groupId, currentOwnerId, newOwnerId uuid.UUID) error
-	RemoveMember(ctx context.Context, groupId, userId uuid.UUID) error
+	UpdateMemberRole(ctx context.Context, groupId, userId uuid.UUID, role Domain.GroupRole, activity *Domain.ActivityEvent) error
+	TransferOwnership(ctx context.Context, groupId, currentOwnerId, newOwnerId uuid.UUID, activity *Domain.ActivityEvent) error
+	RemoveMember(ctx context.Context, groupId, userId uuid.UUID, activity *Domain.ActivityEvent) error
 	IsGroupAdmin(ctx context.Context, groupId, userId uuid.UUID) (bool, error)
 	IsGroupOwner(ctx context.Context, groupId, userId uuid.UUID) (bool, error)
+
+	AddGuest(ctx context.Context, groupId uuid.UUID, guest *Domain.User, activity *Domain.ActivityEvent) (*Domain.GroupMembership, error)
+	GetGuestsByEmail(ctx context.Context, email string) ([]Domain.User, error)
+	ClaimGuest(ctx context.Context, guestId, userId uuid.UUID, activity *Domain.ActivityEvent) error
 }
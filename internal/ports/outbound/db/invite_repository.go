@@ -0,0 +1,21 @@
+package RepositoryPorts
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type InviteRepositoryPort interface {
+	CreateInvite(ctx context.Context, invite *Domain.GroupInvite) (*Domain.GroupInvite, error)
+	GetInviteById(ctx context.Context, groupId, inviteId uuid.UUID) (*Domain.GroupInvite, error)
+	GetInviteByTokenHash(ctx context.Context, tokenHash string) (*Domain.GroupInvite, error)
+	GetPendingInvites(ctx context.Context, groupId uuid.UUID, now time.Time, limit, offset int) ([]Domain.GroupInvite, int64, error)
+	GetPendingEmailInvites(ctx context.Context, email string, now time.Time) ([]Domain.GroupInvite, error)
+	RevokeInvite(ctx context.Context, groupId, inviteId uuid.UUID, now time.Time) error
+
+	AcceptInvite(ctx context.Context, inviteId uuid.UUID, user *Domain.User, activity *Domain.ActivityEvent) (*Domain.GroupMembership, error)
+}
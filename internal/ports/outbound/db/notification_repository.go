@@ -0,0 +1,16 @@
+package RepositoryPorts
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+)
+
+type NotificationRepositoryPort interface {
+	// ClaimDueNotifications returns pending instant or digest notifications
+	// that are due, ordered by recipient, and pushes their next attempt out
+	// by lease so other dispatchers skip them.
+	ClaimDueNotifications(ctx context.Context, digest bool, now time.Time, lease time.Duration, limit int) ([]Domain.Notification, error)
+	SaveNotificationAttempt(ctx context.Context, notification *Domain.Notification) error
+}
@@ -0,0 +1,21 @@
+package RepositoryPorts
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type RecurringSplitRepositoryPort interface {
+	CreateRecurringSplit(ctx context.Context, recurringSplit *Domain.RecurringSplit, participants []Domain.RecurringSplitParticipant) (*Domain.RecurringSplit, error)
+	GetRecurringSplitById(ctx context.Context, recurringSplitId uuid.UUID) (*Domain.RecurringSplit, error)
+	GetRecurringSplitsByUserId(ctx context.Context, userId uuid.UUID, limit, offset int) ([]Domain.RecurringSplit, int64, error)
+	UpdateRecurringSplit(ctx context.Context, recurringSplitId uuid.UUID, updates map[string]any, participants []Domain.RecurringSplitParticipant) (*Domain.RecurringSplit, error)
+	DeleteRecurringSplit(ctx context.Context, recurringSplitId uuid.UUID) error
+
+	GetDueRecurringSplits(ctx context.Context, now time.Time, limit int) ([]Domain.RecurringSplit, error)
+	UpdateRecurringSplitSchedule(ctx context.Context, recurringSplitId uuid.UUID, nextRunAt time.Time, lastRunAt *time.Time, isActive bool) error
+}
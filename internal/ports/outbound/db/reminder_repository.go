@@ -0,0 +1,24 @@
+package RepositoryPorts
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type ReminderRepositoryPort interface {
+	CreateReminder(ctx context.Context, reminder *Domain.PaymentReminder, activity *Domain.ActivityEvent) (*Domain.PaymentReminder, error)
+	// CreateReminderWithCooldown fails with 429 when the reminder's sender
+	// already reminded its debtor less than cooldown ago.
+	CreateReminderWithCooldown(ctx context.Context, reminder *Domain.PaymentReminder, activity *Domain.ActivityEvent, cooldown time.Duration) (*Domain.PaymentReminder, error)
+	GetSentReminders(ctx context.Context, senderId uuid.UUID, limit, offset int) ([]Domain.PaymentReminder, int64, error)
+	GetReceivedReminders(ctx context.Context, debtorId uuid.UUID, limit, offset int) ([]Domain.PaymentReminder, int64, error)
+
+	// GetParticipantsDueForReminder returns participants with an outstanding
+	// share on splits created before createdBefore who have not been reminded
+	// about that split since remindedSince.
+	GetParticipantsDueForReminder(ctx context.Context, createdBefore, remindedSince time.Time, limit int) ([]Domain.SplitParticipant, error)
+}
@@ -9,12 +9,22 @@ import (
 )
 
 type SettlementRepositoryPort interface {
-	CreateSettlement(ctx context.Context, settlement *Domain.Settlement) (*Domain.Settlement, error)
+	CreateSettlement(ctx context.Context, settlement *Domain.Settlement, activity *Domain.ActivityEvent) (*Domain.Settlement, error)
+	CreateSettlements(ctx context.Context, settlements []Domain.Settlement, activity *Domain.ActivityEvent) ([]Domain.Settlement, error)
 	GetSettlementById(ctx context.Context, settlementId uuid.UUID) (*Domain.Settlement, error)
 	GetSettlementByIdempotencyKey(ctx context.Context, idempotencyKey string) (*Domain.Settlement, error)
+	GetSettlementsBySettleUpId(ctx context.Context, settleUpId uuid.UUID) ([]Domain.Settlement, error)
 	GetPendingSettlementsByUserId(ctx context.Context, userId uuid.UUID, limit, offset int) ([]Domain.Settlement, int64, error)
 	GetSettlementHistoryWithConfirmation(ctx context.Context, userId uuid.UUID, limit, offset int) ([]Domain.Settlement, map[uuid.UUID]bool, int64, error)
-	ConfirmSettlement(ctx context.Context, settlementId uuid.UUID) error
+	ConfirmSettlement(ctx context.Context, settlementId uuid.UUID, activity *Domain.ActivityEvent) error
+	ConfirmSettleUp(ctx context.Context, settleUpId uuid.UUID, activity *Domain.ActivityEvent) error
+	RejectSettlement(ctx context.Context, settlementId, actorId uuid.UUID, reason string, activity *Domain.ActivityEvent) error
+	DisputeSettlement(ctx context.Context, settlementId, actorId uuid.UUID, reason string, activity *Domain.ActivityEvent) error
+	GetSettlementEvents(ctx context.Context, settlementId uuid.UUID) ([]Domain.SettlementEvent, error)
 	IsSettlementConfirmed(ctx context.Context, settlementId uuid.UUID) (bool, error)
-	DeleteSettlement(ctx context.Context, settlementId uuid.UUID) error
+	GetConfirmedSettlementTotal(ctx context.Context, splitId, payerId, payeeId uuid.UUID) (int64, error)
+	GetPendingSettlementTotalsBySplit(ctx context.Context, payerId uuid.UUID, splitIds []uuid.UUID) (map[uuid.UUID]int64, error)
+	GetOpenSettlementTotalsToPayeeBySplit(ctx context.Context, payerId, payeeId uuid.UUID, splitIds []uuid.UUID) (map[uuid.UUID]int64, error)
+	DeleteSettlement(ctx context.Context, settlementId uuid.UUID, activity *Domain.ActivityEvent) error
+	DeleteSettleUp(ctx context.Context, settleUpId uuid.UUID, activity *Domain.ActivityEvent) error
 }
@@ -18,13 +18,13 @@ const (
 type SocialRepositoryPort interface {
 	GetFriendRequestsList(ctx context.Context, userId uuid.UUID, requestType FriendRequestType, limit, offset int) ([]*Domain.FriendRequest, int64, error)
 	GetFriendRequestByIdempotencyKey(ctx context.Context, idempotencyKey string) (*Domain.FriendRequest, error)
-	CreateFriendRequest(ctx context.Context, senderId uuid.UUID, receiverId uuid.UUID, idempotencyKey *string) (*Domain.FriendRequest, error)
-	AcceptFriendRequest(ctx context.Context, receiverId uuid.UUID, requestId uuid.UUID) error
-	RejectFriendRequest(ctx context.Context, receiverId uuid.UUID, requestId uuid.UUID) error
-	CancelFriendRequest(ctx context.Context, senderId uuid.UUID, requestId uuid.UUID) error
+	CreateFriendRequest(ctx context.Context, senderId uuid.UUID, receiverId uuid.UUID, idempotencyKey *string, activity *Domain.ActivityEvent) (*Domain.FriendRequest, error)
+	AcceptFriendRequest(ctx context.Context, receiverId uuid.UUID, requestId uuid.UUID, activity *Domain.ActivityEvent) error
+	RejectFriendRequest(ctx context.Context, receiverId uuid.UUID, requestId uuid.UUID, activity *Domain.ActivityEvent) error
+	CancelFriendRequest(ctx context.Context, senderId uuid.UUID, requestId uuid.UUID, activity *Domain.ActivityEvent) error
 	CheckExistingRequest(ctx context.Context, senderId, receiverId uuid.UUID) (bool, error)
 	CheckFriendship(ctx context.Context, userId, friendId uuid.UUID) (bool, error)
 
 	GetFriendsList(ctx context.Context, userId uuid.UUID, limit, offset int) ([]*Domain.User, int64, error)
-	RemoveFriend(ctx context.Context, userId uuid.UUID, friendId uuid.UUID) error
+	RemoveFriend(ctx context.Context, userId uuid.UUID, friendId uuid.UUID, activity *Domain.ActivityEvent) error
 }
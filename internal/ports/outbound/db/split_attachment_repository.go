@@ -0,0 +1,16 @@
+package RepositoryPorts
+
+import (
+	"context"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type SplitAttachmentRepositoryPort interface {
+	CreateAttachment(ctx context.Context, attachment *Domain.SplitAttachment, maxPerSplit int) (*Domain.SplitAttachment, error)
+	GetAttachmentById(ctx context.Context, splitId, attachmentId uuid.UUID) (*Domain.SplitAttachment, error)
+	GetAttachments(ctx context.Context, splitId uuid.UUID) ([]Domain.SplitAttachment, error)
+	DeleteAttachment(ctx context.Context, splitId, attachmentId uuid.UUID) error
+}
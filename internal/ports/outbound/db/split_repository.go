@@ -2,22 +2,66 @@ package RepositoryPorts
 
 import (
 	"context"
+	"time"
 
 	Domain "autobill-service/internal/domain"
 
 	"github.com/google/uuid"
 )
 
+type SettlementTotal struct {
+	PayerID uuid.UUID
+	PayeeID uuid.UUID
+	Total   int64
+}
+
+// SpendingFilter selects the splits of a group created in [From, To). Either
+// bound may be nil.
+type SpendingFilter struct {
+	GroupID uuid.UUID
+	From    *time.Time
+	To      *time.Time
+}
+
+type CategorySpend struct {
+	CategoryID   *uuid.UUID
+	CategoryName *string
+	Currency     Domain.Currency
+	TotalAmount  int64
+	SplitCount   int64
+}
+
+type MemberSpend struct {
+	UserID      uuid.UUID
+	UserName    string
+	Currency    Domain.Currency
+	PaidAmount  int64
+	ShareAmount int64
+}
+
+type MonthlySpend struct {
+	Month       time.Time
+	Currency    Domain.Currency
+	TotalAmount int64
+	SplitCount  int64
+}
+
 type SplitRepositoryPort interface {
-	CreateSplitWithParticipants(ctx context.Context, split *Domain.Split, participants []Domain.SplitParticipant) (*Domain.Split, []Domain.SplitParticipant, error)
+	CreateSplitWithParticipants(ctx context.Context, split *Domain.Split, payers []Domain.SplitPayer, participants []Domain.SplitParticipant, activity *Domain.ActivityEvent) (*Domain.Split, []Domain.SplitParticipant, error)
 	GetSplitById(ctx context.Context, splitId uuid.UUID) (*Domain.Split, error)
 	GetSplitByIdempotencyKey(ctx context.Context, idempotencyKey string) (*Domain.Split, error)
+	GetSplitByIdempotencyKeyUnscoped(ctx context.Context, idempotencyKey string) (*Domain.Split, error)
 	GetSplitWithParticipants(ctx context.Context, splitId uuid.UUID) (*Domain.Split, error)
 	GetSplitsByGroupId(ctx context.Context, groupId uuid.UUID, limit, offset int) ([]Domain.Split, int64, error)
 	GetSplitsByUserId(ctx context.Context, userId uuid.UUID, limit, offset int) ([]Domain.Split, int64, error)
 	GetParticipant(ctx context.Context, splitId, userId uuid.UUID) (*Domain.SplitParticipant, error)
 	GetPendingSettlementCountBySplitId(ctx context.Context, splitId uuid.UUID) (int64, error)
-	GetConfirmedSettlementTotalsByPayer(ctx context.Context, splitId uuid.UUID) (map[uuid.UUID]int64, error)
-	DeleteSplitWithBalanceRollback(ctx context.Context, split *Domain.Split, participants []Domain.SplitParticipant) error
+	GetConfirmedSettlementTotals(ctx context.Context, splitId uuid.UUID) ([]SettlementTotal, error)
+	UpdateSplitWithParticipants(ctx context.Context, split *Domain.Split, payers []Domain.SplitPayer, participants []Domain.SplitParticipant, activity *Domain.ActivityEvent) (*Domain.Split, error)
+	DeleteSplitWithBalanceRollback(ctx context.Context, split *Domain.Split, participants []Domain.SplitParticipant, activity *Domain.ActivityEvent) error
 	HasPendingSplitsInGroup(ctx context.Context, userId, groupId uuid.UUID) (bool, error)
+	GetOutstandingParticipantsInGroup(ctx context.Context, groupId, userId, payeeId uuid.UUID, currency Domain.Currency) ([]Domain.SplitParticipant, error)
+	GetGroupSpendByCategory(ctx context.Context, filter SpendingFilter) ([]CategorySpend, error)
+	GetGroupSpendByMember(ctx context.Context, filter SpendingFilter) ([]MemberSpend, error)
+	GetGroupSpendByMonth(ctx context.Context, filter SpendingFilter) ([]MonthlySpend, error)
 }
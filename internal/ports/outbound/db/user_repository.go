@@ -18,4 +18,5 @@ type UserRepositoryPort interface {
 	FindUserByEmail(ctx context.Context, email string) (*Domain.User, error)
 
 	UpdateUser(ctx context.Context, id uuid.UUID, updatedUser UpdateUserData) (*Domain.User, error)
+	UpdateNotificationPreferences(ctx context.Context, id uuid.UUID, updates map[string]any) (*Domain.User, error)
 }
@@ -0,0 +1,28 @@
+package RepositoryPorts
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type WebhookRepositoryPort interface {
+	CreateSubscription(ctx context.Context, subscription *Domain.WebhookSubscription) (*Domain.WebhookSubscription, error)
+	GetSubscriptionById(ctx context.Context, subscriptionId uuid.UUID) (*Domain.WebhookSubscription, error)
+	GetSubscriptionsByOwnerId(ctx context.Context, ownerId uuid.UUID, limit, offset int) ([]Domain.WebhookSubscription, int64, error)
+	UpdateSubscription(ctx context.Context, subscriptionId uuid.UUID, updates map[string]any) (*Domain.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, subscriptionId uuid.UUID) error
+
+	GetDeliveries(ctx context.Context, subscriptionId uuid.UUID, status *Domain.WebhookDeliveryStatus, limit, offset int) ([]Domain.WebhookDelivery, int64, error)
+	GetDeadDeliveriesByOwnerId(ctx context.Context, ownerId uuid.UUID, limit, offset int) ([]Domain.WebhookDelivery, int64, error)
+	GetDeliveryById(ctx context.Context, deliveryId uuid.UUID) (*Domain.WebhookDelivery, error)
+	RequeueDelivery(ctx context.Context, deliveryId uuid.UUID, now time.Time) (*Domain.WebhookDelivery, error)
+
+	// ClaimDueDeliveries returns pending deliveries that are due and pushes
+	// their next attempt out by lease so other dispatchers skip them.
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Domain.WebhookDelivery, error)
+	SaveDeliveryAttempt(ctx context.Context, delivery *Domain.WebhookDelivery) error
+}
@@ -0,0 +1,24 @@
+package EventPorts
+
+import (
+	Domain "autobill-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type EventPublisher interface {
+	Publish(event Domain.Event)
+}
+
+type EventSubscriber interface {
+	// Subscribe returns the retained events for userId published after
+	// lastEventId, followed by a channel of live events. The channel is closed
+	// when the subscriber falls behind or the bus shuts down.
+	Subscribe(userId uuid.UUID, lastEventId uint64) (backlog []Domain.Event, events <-chan Domain.Event, unsubscribe func())
+}
+
+type EventBus interface {
+	EventPublisher
+	EventSubscriber
+	Close()
+}
@@ -0,0 +1,14 @@
+package ExchangePorts
+
+import (
+	"context"
+	"time"
+
+	Domain "autobill-service/internal/domain"
+)
+
+type ExchangeRateProvider interface {
+	GetRate(ctx context.Context, base, quote Domain.Currency, asOf time.Time) (*Domain.ExchangeRate, error)
+	GetRates(ctx context.Context, asOf time.Time) ([]Domain.ExchangeRate, error)
+	SaveRates(ctx context.Context, rates []Domain.ExchangeRate) ([]Domain.ExchangeRate, error)
+}
@@ -0,0 +1,14 @@
+package NotificationPorts
+
+import "context"
+
+type Message struct {
+	To      string
+	ToName  string
+	Subject string
+	Body    string
+}
+
+type NotificationSender interface {
+	Send(ctx context.Context, message Message) error
+}
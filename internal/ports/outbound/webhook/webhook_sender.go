@@ -0,0 +1,21 @@
+package WebhookPorts
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	DeliveryID uuid.UUID
+	EventType  string
+	Payload    []byte
+}
+
+type WebhookSender interface {
+	// Send POSTs the signed payload and returns the response status code. A
+	// non-2xx response is reported as an error alongside its status code.
+	Send(ctx context.Context, request WebhookRequest) (int, error)
+}
@@ -40,25 +40,53 @@ const (
 	ErrNoFieldsToUpdate                = "no fields to update"
 	ErrSplitNotFound                   = "split not found"
 	ErrSplitHasPendingSettlements      = "cannot reverse split with pending settlements"
+	ErrSplitEditHasPendingSettlements  = "cannot edit split with pending settlements"
+	ErrSplitEditBelowSettled           = "edit would leave a participant with more settled than their share"
 	ErrInvalidSplitAmount              = "invalid split amount"
 	ErrParticipantNotFound             = "participant not found in split"
 	ErrNoParticipants                  = "at least one participant is required"
+	ErrInvalidSplitPercentages         = "participant percentages must add up to 100"
+	ErrInvalidSplitShares              = "participant shares must be greater than zero"
 	ErrInvalidParticipantId            = "invalid participant user ID"
+	ErrInvalidPayerId                  = "invalid payer user ID"
+	ErrInvalidPaidAmount               = "payer amounts must be positive, unique per payer and add up to the split total"
+	ErrPayerNotGroupMember             = "all split payers must be members of the group"
+	ErrInvalidSplitItem                = "split items need a name, a positive amount and quantity, and at least one assignee"
+	ErrItemAssigneeNotParticipant      = "item assignees must be split participants"
+	ErrItemsRequireItemizedDivision    = "items and charges are only allowed on ITEMIZED splits"
+	ErrInvalidItemizedTotal            = "items plus tax, tip and service charge must add up to the split total"
+	ErrRecurringSplitNotFound          = "recurring split not found"
+	ErrInvalidRecurrenceCadence        = "invalid recurrence cadence"
+	ErrInvalidCronExpression           = "invalid cron expression"
+	ErrInvalidRecurrenceDates          = "end date must be after start date"
+	ErrRecurringItemizedNotSupported   = "ITEMIZED splits cannot be recurring"
 	ErrGroupIdRequired                 = "group ID is required for GROUP type splits"
 	ErrSettlementNotFound              = "settlement not found"
 	ErrSettlementAlreadyConfirmed      = "settlement already confirmed"
 	ErrInvalidSettlementAmount         = "invalid settlement amount"
 	ErrPayeeNotParticipant             = "payee is not a participant in this split"
 	ErrCannotSettleWithSelf            = "cannot create settlement with self as payee"
-	ErrSettlementPayeeMustBeSplitOwner = "payee must be the split creator"
+	ErrSettlementPayeeMustBePayer      = "payee must be one of the split payers"
 	ErrParticipantNotGroupMember       = "all split participants must be members of the group"
 	ErrCurrencyMismatch                = "currency does not match split currency"
+	ErrExchangeRateNotFound            = "no exchange rate available for the requested currency pair"
+	ErrExchangeRateStoreFailure        = "exchange rate store operation failed"
+	ErrInvalidExchangeRate             = "exchange rates need two different valid currencies, a positive rate and an effective date"
+	ErrNoExchangeRates                 = "at least one exchange rate is required"
+	ErrInvalidAsOfDate                 = "as_of must be a date in YYYY-MM-DD format"
 	ErrBalanceNotFound                 = "balance not found"
 	ErrAuditLogNotFound                = "audit log not found"
 	ErrMissingQueryParam               = "query param 'type' is required (sent or received)"
 	ErrInvalidQueryParam               = "query param 'type' must be 'sent' or 'received'"
 	ErrCannotDeleteConfirmedSettlement = "cannot delete a confirmed settlement"
 	ErrNotSettlementPayer              = "only the payer can delete this settlement"
+	ErrSettlementNotPending            = "settlement is no longer pending"
+	ErrCannotDeleteDisputedSettlement  = "cannot delete a disputed settlement"
+	ErrSettlementNotConfirmed          = "only confirmed settlements can be disputed"
+	ErrSettlementTargetConflict        = "provide either split_id or group_id, not both"
+	ErrSettleUpNotFound                = "settle-up not found"
+	ErrSettleUpExceedsOutstanding      = "settle-up amount exceeds the debtor's outstanding shares"
+	ErrSettleUpPayerMismatch           = "settle-up must be created by the debtor"
 	ErrOwnerCannotLeaveGroup           = "group owner cannot leave. Transfer ownership or delete the group"
 	ErrHasPendingSplits                = "cannot leave group with pending splits. Settle all balances first"
 	ErrGroupHasActiveSplits            = "cannot delete group with active splits"
@@ -66,4 +94,58 @@ const (
 	ErrInternal                        = "Internal server error"
 	ErrInvalidRefreshToken             = "invalid or expired refresh token"
 	ErrRefreshTokenRevoked             = "refresh token has been revoked"
+	ErrInvalidLastEventId              = "invalid last event id"
+	ErrWebhookNotFound                 = "webhook not found"
+	ErrWebhookDeliveryNotFound         = "webhook delivery not found"
+	ErrInvalidWebhookURL               = "webhook url must be an absolute https URL"
+	ErrWebhookHostUnresolvable         = "webhook url host could not be resolved"
+	ErrWebhookAddressNotAllowed        = "webhook url must point to a public address"
+	ErrInvalidWebhookEvent             = "invalid webhook event"
+	ErrInvalidWebhookDeliveryStatus    = "status must be PENDING, DELIVERED or DEAD"
+	ErrWebhookDeliveryNotDead          = "only dead-lettered deliveries can be retried"
+	ErrInvalidNotificationFrequency    = "frequency must be OFF, INSTANT or DAILY_DIGEST"
+	ErrNotAllowedToRemind              = "only the split creator or a group admin can send reminders"
+	ErrCannotRemindSelf                = "you cannot remind yourself"
+	ErrNothingOutstanding              = "participant has no outstanding share on this split"
+	ErrReminderRateLimited             = "this user was reminded recently. Try again later"
+	ErrInvalidMinDays                  = "min_days must be a non-negative integer"
+	ErrInviteNotFound                  = "invite not found"
+	ErrInviteNoLongerValid             = "invite has expired, been revoked or used up"
+	ErrInviteEmailMismatch             = "this invite was sent to a different email address"
+	ErrInviteeAlreadyRegistered        = "a user with this email already exists. Add them as a member instead"
+	ErrInvalidInviteExpiry             = "expires_in_hours must be between 1 and 720"
+	ErrGuestNotFound                   = "guest not found or already claimed"
+	ErrGuestNameRequired               = "guest name is required"
+	ErrGuestCannotHoldRole             = "a guest cannot be given a role or ownership. Invite them to claim the guest first"
+	ErrCannotMergeSameAccount          = "cannot merge an account into itself"
+	ErrCategoryNotFound                = "category not found"
+	ErrCategoryAlreadyExists           = "a category with this name already exists"
+	ErrInvalidCategory                 = "category does not exist or is not available for this split"
+	ErrInvalidDateRange                = "from and to must be dates in YYYY-MM-DD format, with from not after to"
+	ErrAttachmentNotFound              = "attachment not found"
+	ErrAttachmentMissing               = "a file must be uploaded in the file form field"
+	ErrAttachmentTooLarge              = "attachment exceeds the maximum upload size"
+	ErrUnsupportedAttachmentType       = "attachments must be JPEG, PNG, GIF, WebP or PDF files"
+	ErrTooManyAttachments              = "split already has the maximum number of attachments"
+	ErrNotAllowedToDeleteAttachment    = "only the uploader or the split creator can delete an attachment"
+	ErrStorageFailure                  = "failed to access file storage"
+	ErrCommentNotFound                 = "comment not found"
+	ErrEmptyComment                    = "comment body cannot be empty"
+	ErrNotCommentAuthor                = "only the author can edit a comment"
+	ErrNotAllowedToDeleteComment       = "only the author or a group admin can delete a comment"
+	ErrInvalidExportFormat             = "format must be csv or jsonl"
+	ErrImportFileMissing               = "a CSV file must be uploaded in the file form field"
+	ErrInvalidImportFile               = "the file could not be read as CSV"
+	ErrUnrecognizedImportFormat        = "the file is neither a Splitwise export nor a CSV with date, description, amount, currency, paid_by and split_with columns"
+	ErrInvalidImportMembers            = "members must be a JSON object mapping column names to email addresses"
+	ErrTooManyImportRows               = "an import can contain at most 1000 rows"
+	ErrImportColumnCount               = "row does not have the same number of columns as the header"
+	ErrInvalidImportDate               = "date must be in YYYY-MM-DD format"
+	ErrInvalidImportAmount             = "amount is not valid for the currency"
+	ErrImportMemberNotMapped           = "column is not mapped to an email address"
+	ErrImportMemberNotFound            = "no group member has this email address"
+	ErrImportUnbalancedRow             = "member columns must add up to zero, show at least one payer and not exceed the cost"
+	ErrImportPayerAmounts              = "each of several payers needs an amount, and the amounts must add up to the total"
+	ErrImportMixedShares               = "either every split_with entry has an amount or none has"
+	ErrImportDuplicateMember           = "member is listed more than once"
 )
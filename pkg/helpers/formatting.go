@@ -0,0 +1,9 @@
+package Helpers
+
+import "github.com/gofiber/fiber/v2"
+
+const FormattedAmountsQueryParam = "formatted"
+
+func WantsFormattedAmounts(c *fiber.Ctx) bool {
+	return c.QueryBool(FormattedAmountsQueryParam)
+}